@@ -135,6 +135,7 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 		petrichormoduleclient.CreatePetrichorProposalHandler,
 		petrichormoduleclient.UpdatePetrichorProposalHandler,
 		petrichormoduleclient.DeletePetrichorProposalHandler,
+		petrichormoduleclient.DelistPetrichorProposalHandler,
 		// this line is used by starport scaffolding # stargate/app/govProposalHandler
 	)
 
@@ -175,16 +176,19 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:          nil,
-		distrtypes.ModuleName:               nil,
-		icatypes.ModuleName:                 nil,
-		minttypes.ModuleName:                {authtypes.Minter},
-		stakingtypes.BondedPoolName:         {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:      {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:                 {authtypes.Burner},
-		ibctransfertypes.ModuleName:         {authtypes.Minter, authtypes.Burner},
-		petrichormoduletypes.ModuleName:      {authtypes.Minter, authtypes.Burner},
-		petrichormoduletypes.RewardsPoolName: nil,
+		authtypes.FeeCollectorName:                 nil,
+		distrtypes.ModuleName:                      nil,
+		icatypes.ModuleName:                        nil,
+		minttypes.ModuleName:                       {authtypes.Minter},
+		stakingtypes.BondedPoolName:                {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:             {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                        {authtypes.Burner},
+		ibctransfertypes.ModuleName:                {authtypes.Minter, authtypes.Burner},
+		petrichormoduletypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
+		petrichormoduletypes.RewardsPoolName:       nil,
+		petrichormoduletypes.LiquidStakingPoolName: nil,
+		petrichormoduletypes.BuybackPoolName:       nil,
+		petrichormoduletypes.InsuranceFundName:     nil,
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 )
@@ -426,7 +430,17 @@ func New(
 		app.BankKeeper,
 		&stakingKeeper,
 		app.DistrKeeper,
+		app.SlashingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// register the petrichor hooks before the keeper is copied into other modules
+	app.PetrichorKeeper.SetHooks(
+		petrichormoduletypes.NewMultiPetrichorHooks(
+		// insert petrichor hooks receivers here
+		),
 	)
+	// No price source is set, so assets with oracle priced reward weights are rejected with ErrNoPriceSource.
+	// Chains running an oracle or TWAP module pass it to app.PetrichorKeeper.SetPriceSource here
 
 	app.BankKeeper.RegisterKeepers(app.PetrichorKeeper, &stakingKeeper)
 
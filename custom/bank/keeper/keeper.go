@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -20,7 +22,9 @@ import (
 type Keeper struct {
 	bankkeeper.BaseKeeper
 
-	ak   petrichorkeeper.Keeper
+	// ak is shared by every copy of the keeper so that keepers handed out before RegisterKeepers also settle
+	// liquid receipt rewards on transfers
+	ak   *petrichorkeeper.Keeper
 	sk   banktypes.StakingKeeper
 	acck accountkeeper.AccountKeeper
 }
@@ -38,7 +42,7 @@ func NewBaseKeeper(
 ) Keeper {
 	keeper := Keeper{
 		BaseKeeper: bankkeeper.NewBaseKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
-		ak:         petrichorkeeper.Keeper{},
+		ak:         &petrichorkeeper.Keeper{},
 		sk:         stakingkeeper.Keeper{},
 		acck:       ak,
 	}
@@ -46,10 +50,90 @@ func NewBaseKeeper(
 }
 
 func (k *Keeper) RegisterKeepers(ak petrichorkeeper.Keeper, sk banktypes.StakingKeeper) {
-	k.ak = ak
+	*k.ak = ak
 	k.sk = sk
 }
 
+// SendCoins settles the liquid receipt rewards of both accounts before transferring the coins
+func (k Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return k.withLiquidReceiptSettlement(ctx, []sdk.AccAddress{fromAddr, toAddr}, amt, func() error {
+		return k.BaseKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
+	})
+}
+
+// InputOutputCoins settles the liquid receipt rewards of every input and output before the multi-send
+func (k Keeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
+	var addrs []sdk.AccAddress
+	coins := sdk.NewCoins()
+	for _, in := range inputs {
+		addr, err := sdk.AccAddressFromBech32(in.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+		coins = coins.Add(in.Coins...)
+	}
+	for _, out := range outputs {
+		addr, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+		addrs = append(addrs, addr)
+	}
+	return k.withLiquidReceiptSettlement(ctx, addrs, coins, func() error {
+		return k.BaseKeeper.InputOutputCoins(ctx, inputs, outputs)
+	})
+}
+
+// SendCoinsFromModuleToAccount settles the liquid receipt rewards of both accounts before transferring the coins
+func (k Keeper) SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	addrs := []sdk.AccAddress{k.acck.GetModuleAddress(senderModule), recipientAddr}
+	return k.withLiquidReceiptSettlement(ctx, addrs, amt, func() error {
+		return k.BaseKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+	})
+}
+
+// SendCoinsFromAccountToModule settles the liquid receipt rewards of both accounts before transferring the coins
+func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	addrs := []sdk.AccAddress{senderAddr, k.acck.GetModuleAddress(recipientModule)}
+	return k.withLiquidReceiptSettlement(ctx, addrs, amt, func() error {
+		return k.BaseKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, recipientModule, amt)
+	})
+}
+
+// SendCoinsFromModuleToModule settles the liquid receipt rewards of both accounts before transferring the coins
+func (k Keeper) SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	addrs := []sdk.AccAddress{k.acck.GetModuleAddress(senderModule), k.acck.GetModuleAddress(recipientModule)}
+	return k.withLiquidReceiptSettlement(ctx, addrs, amt, func() error {
+		return k.BaseKeeper.SendCoinsFromModuleToModule(ctx, senderModule, recipientModule, amt)
+	})
+}
+
+// withLiquidReceiptSettlement runs send between the petrichor receipt settlement hooks when the coins contain
+// liquid staking receipts, so that receipt rewards follow the holder
+func (k Keeper) withLiquidReceiptSettlement(ctx sdk.Context, addrs []sdk.AccAddress, amt sdk.Coins, send func() error) error {
+	receipts := sdk.NewCoins()
+	for _, coin := range amt {
+		if strings.HasPrefix(coin.Denom, petrichortypes.LiquidReceiptDenomPrefix) {
+			receipts = receipts.Add(coin)
+		}
+	}
+	if receipts.Empty() {
+		return send()
+	}
+
+	err := k.ak.BeforeLiquidReceiptTransfer(ctx, addrs, receipts)
+	if err != nil {
+		return err
+	}
+	err = send()
+	if err != nil {
+		return err
+	}
+	k.ak.AfterLiquidReceiptTransfer(ctx, addrs, receipts)
+	return nil
+}
+
 // SupplyOf implements the Query/SupplyOf gRPC method
 func (k Keeper) SupplyOf(c context.Context, req *types.QuerySupplyOfRequest) (*types.QuerySupplyOfResponse, error) {
 	if req == nil {
@@ -0,0 +1,75 @@
+package twap
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PriceSource is a stand-in for an oracle or dex TWAP module. It keeps price observations in memory and returns the
+// time weighted average price over a trailing window. It is meant for tests and local networks only since its
+// state is not part of the chain state
+type PriceSource struct {
+	window       time.Duration
+	observations map[string][]observation
+}
+
+type observation struct {
+	time  time.Time
+	price sdk.Dec
+}
+
+func NewPriceSource(window time.Duration) *PriceSource {
+	return &PriceSource{
+		window:       window,
+		observations: map[string][]observation{},
+	}
+}
+
+// RecordPrice adds a price observation for the denom at the block time. A price holds until the next observation
+func (p *PriceSource) RecordPrice(ctx sdk.Context, denom string, price sdk.Dec) {
+	observations := append(p.observations[denom], observation{time: ctx.BlockTime(), price: price})
+	sort.SliceStable(observations, func(i, j int) bool {
+		return observations[i].time.Before(observations[j].time)
+	})
+	p.observations[denom] = observations
+}
+
+// GetPrice returns the time weighted average price of the denom over the window ending at the block time.
+// The last price is returned as is if it covers the whole window or no time has passed since it was recorded
+func (p *PriceSource) GetPrice(ctx sdk.Context, denom string) (sdk.Dec, bool) {
+	end := ctx.BlockTime()
+	start := end.Add(-p.window)
+
+	weighted := sdk.ZeroDec()
+	var elapsed time.Duration
+	var last *observation
+	observations := p.observations[denom]
+	for i := range observations {
+		o := observations[i]
+		if o.time.After(end) {
+			break
+		}
+		from := o.time
+		if from.Before(start) {
+			from = start
+		}
+		to := end
+		if i+1 < len(observations) && observations[i+1].time.Before(end) {
+			to = observations[i+1].time
+		}
+		if to.After(from) {
+			weighted = weighted.Add(o.price.MulInt64(int64(to.Sub(from))))
+			elapsed += to.Sub(from)
+		}
+		last = &observations[i]
+	}
+	if last == nil {
+		return sdk.Dec{}, false
+	}
+	if elapsed == 0 {
+		return last.price, true
+	}
+	return weighted.QuoInt64(int64(elapsed)), true
+}
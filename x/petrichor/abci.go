@@ -19,12 +19,17 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	if err := k.CompleteUndelegations(ctx); err != nil {
 		panic(fmt.Errorf("Failed to complete undelegations from x/petrichor module: %s", err))
 	}
+	k.ProcessValidatorOptOuts(ctx)
+	k.ProcessTombstonedValidators(ctx)
+	k.ProcessDelistingAssets(ctx)
 
 	assets := k.GetAllAssets(ctx)
 	if _, err := k.DeductAssetsHook(ctx, assets); err != nil {
 		panic(fmt.Errorf("Failed to deduct take rate from petrichor in x/petrichor module: %s", err))
 	}
+	k.PruneTakeRateHistory(ctx)
 	k.RewardWeightChangeHook(ctx, assets)
+	k.AutoCompoundHook(ctx)
 	if err := k.RebalanceHook(ctx, assets); err != nil {
 		panic(fmt.Errorf("Failed to rebalance assets in x/petrichor module: %s", err))
 	}
@@ -1,13 +1,21 @@
 package cli
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govmoduletypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/spf13/cobra"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"strings"
 	"time"
 )
 
@@ -63,6 +71,31 @@ func CreatePetrichor() *cobra.Command {
 				return err
 			}
 
+			maxTotalTokens, maxValidatorShare, minDelegationAmount, err := parseAssetCapFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardWeightMode, minRewardWeight, maxRewardWeight, err := parseRewardWeightModeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			takeRateSplits, err := parseTakeRateSplitsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardWeightSchedule, rewardWeightInterpolation, err := parseRewardWeightScheduleFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			insuranceCoverageCeiling, err := parseOptionalIntFlag(cmd, FlagInsuranceCoverageCeiling)
+			if err != nil {
+				return err
+			}
+
 			content := types.NewMsgCreatePetrichorProposal(
 				title,
 				description,
@@ -72,6 +105,16 @@ func CreatePetrichor() *cobra.Command {
 				rewardChangeRate,
 				rewardChangeInterval,
 			)
+			content.(*types.MsgCreatePetrichorProposal).MaxTotalTokens = maxTotalTokens
+			content.(*types.MsgCreatePetrichorProposal).MaxValidatorShare = maxValidatorShare
+			content.(*types.MsgCreatePetrichorProposal).MinDelegationAmount = minDelegationAmount
+			content.(*types.MsgCreatePetrichorProposal).RewardWeightMode = rewardWeightMode
+			content.(*types.MsgCreatePetrichorProposal).MinRewardWeight = minRewardWeight
+			content.(*types.MsgCreatePetrichorProposal).MaxRewardWeight = maxRewardWeight
+			content.(*types.MsgCreatePetrichorProposal).TakeRateSplits = takeRateSplits
+			content.(*types.MsgCreatePetrichorProposal).RewardWeightSchedule = rewardWeightSchedule
+			content.(*types.MsgCreatePetrichorProposal).RewardWeightInterpolation = rewardWeightInterpolation
+			content.(*types.MsgCreatePetrichorProposal).InsuranceCoverageCeiling = insuranceCoverageCeiling
 
 			err = content.ValidateBasic()
 
@@ -96,6 +139,16 @@ func CreatePetrichor() *cobra.Command {
 	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagMaxTotalTokens, "", "optional cap on the total tokens delegated for the petrichor")
+	cmd.Flags().String(FlagMaxValidatorShare, "", "optional cap on the ratio of the petrichor delegated to a single validator")
+	cmd.Flags().String(FlagMinDelegationAmount, "", "optional minimum amount of tokens a delegation of the petrichor must hold")
+	cmd.Flags().String(FlagRewardWeightMode, "static", "how the reward weight is set, either static, oracle or schedule. Oracle mode requires a chain with a price source")
+	cmd.Flags().String(FlagMinRewardWeight, "", "lower bound of the reward weight in oracle and schedule mode")
+	cmd.Flags().String(FlagMaxRewardWeight, "", "upper bound of the reward weight in oracle and schedule mode")
+	cmd.Flags().String(FlagTakeRateSplits, "", "optional split of the take rate proceeds e.g. fee_collector=0.5,community_pool=0.3,burn=0.2")
+	cmd.Flags().String(FlagRewardWeightSchedule, "", "reward weight targets in schedule mode e.g. 2024-01-01T00:00:00Z=1.5,2024-06-01T00:00:00Z=0.5")
+	cmd.Flags().String(FlagRewardWeightInterpolation, "linear", "how the reward weight moves between schedule targets, either linear or step")
+	cmd.Flags().String(FlagInsuranceCoverageCeiling, "", "optional ceiling on the slashed tokens of the petrichor covered by the insurance fund in each slash, only unbonding undelegations are covered")
 	return cmd
 }
 
@@ -151,7 +204,32 @@ func UpdatePetrichor() *cobra.Command {
 				return err
 			}
 
-			content := types.NewMsgCreatePetrichorProposal(
+			maxTotalTokens, maxValidatorShare, minDelegationAmount, err := parseAssetCapFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardWeightMode, minRewardWeight, maxRewardWeight, err := parseRewardWeightModeFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			takeRateSplits, err := parseTakeRateSplitsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardWeightSchedule, rewardWeightInterpolation, err := parseRewardWeightScheduleFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			insuranceCoverageCeiling, err := parseOptionalIntFlag(cmd, FlagInsuranceCoverageCeiling)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewMsgUpdatePetrichorProposal(
 				title,
 				description,
 				args[0],
@@ -160,6 +238,16 @@ func UpdatePetrichor() *cobra.Command {
 				rewardChangeRate,
 				rewardChangeInterval,
 			)
+			content.(*types.MsgUpdatePetrichorProposal).MaxTotalTokens = maxTotalTokens
+			content.(*types.MsgUpdatePetrichorProposal).MaxValidatorShare = maxValidatorShare
+			content.(*types.MsgUpdatePetrichorProposal).MinDelegationAmount = minDelegationAmount
+			content.(*types.MsgUpdatePetrichorProposal).RewardWeightMode = rewardWeightMode
+			content.(*types.MsgUpdatePetrichorProposal).MinRewardWeight = minRewardWeight
+			content.(*types.MsgUpdatePetrichorProposal).MaxRewardWeight = maxRewardWeight
+			content.(*types.MsgUpdatePetrichorProposal).TakeRateSplits = takeRateSplits
+			content.(*types.MsgUpdatePetrichorProposal).RewardWeightSchedule = rewardWeightSchedule
+			content.(*types.MsgUpdatePetrichorProposal).RewardWeightInterpolation = rewardWeightInterpolation
+			content.(*types.MsgUpdatePetrichorProposal).InsuranceCoverageCeiling = insuranceCoverageCeiling
 
 			err = content.ValidateBasic()
 
@@ -184,6 +272,16 @@ func UpdatePetrichor() *cobra.Command {
 	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
 	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagMaxTotalTokens, "", "optional cap on the total tokens delegated for the petrichor")
+	cmd.Flags().String(FlagMaxValidatorShare, "", "optional cap on the ratio of the petrichor delegated to a single validator")
+	cmd.Flags().String(FlagMinDelegationAmount, "", "optional minimum amount of tokens a delegation of the petrichor must hold")
+	cmd.Flags().String(FlagRewardWeightMode, "static", "how the reward weight is set, either static, oracle or schedule. Oracle mode requires a chain with a price source")
+	cmd.Flags().String(FlagMinRewardWeight, "", "lower bound of the reward weight in oracle and schedule mode")
+	cmd.Flags().String(FlagMaxRewardWeight, "", "upper bound of the reward weight in oracle and schedule mode")
+	cmd.Flags().String(FlagTakeRateSplits, "", "optional split of the take rate proceeds e.g. fee_collector=0.5,community_pool=0.3,burn=0.2")
+	cmd.Flags().String(FlagRewardWeightSchedule, "", "reward weight targets in schedule mode e.g. 2024-01-01T00:00:00Z=1.5,2024-06-01T00:00:00Z=0.5")
+	cmd.Flags().String(FlagRewardWeightInterpolation, "linear", "how the reward weight moves between schedule targets, either linear or step")
+	cmd.Flags().String(FlagInsuranceCoverageCeiling, "", "optional ceiling on the slashed tokens of the petrichor covered by the insurance fund in each slash, only unbonding undelegations are covered")
 	return cmd
 }
 
@@ -250,3 +348,365 @@ func DeletePetrichor() *cobra.Command {
 	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
 	return cmd
 }
+
+func DelistPetrichor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delist-petrichor denom",
+		Args:  cobra.ExactArgs(1),
+		Short: "Delist an petrichor, force-undelegating every delegation of the specified denom over the following blocks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewMsgDelistPetrichorProposal(
+				title,
+				description,
+				args[0],
+			)
+
+			err = content.ValidateBasic()
+
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	return cmd
+}
+
+func UpdateParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-params reward-delay-time take-rate-claim-interval auto-compound-interval",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit a gov proposal to update the petrichor module params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rewardDelayTime, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			takeRateClaimInterval, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			autoCompoundInterval, err := time.ParseDuration(args[2])
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			takeRateSplits, err := parseTakeRateSplitsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			autoRedelegateTombstoned, err := cmd.Flags().GetBool(FlagAutoRedelegateTombstoned)
+			if err != nil {
+				return err
+			}
+
+			maxTotalRewardWeight := sdk.ZeroDec()
+			if maxWeight, err := parseOptionalDecFlag(cmd, FlagMaxTotalRewardWeight); err != nil {
+				return err
+			} else if maxWeight != nil {
+				maxTotalRewardWeight = *maxWeight
+			}
+
+			takeRateHistoryRetention, err := cmd.Flags().GetDuration(FlagTakeRateHistoryRetention)
+			if err != nil {
+				return err
+			}
+
+			updateMsg := &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govmoduletypes.ModuleName).String(),
+				Params: types.Params{
+					RewardDelayTime:          rewardDelayTime,
+					TakeRateClaimInterval:    takeRateClaimInterval,
+					AutoCompoundInterval:     autoCompoundInterval,
+					TakeRateSplits:           takeRateSplits,
+					AutoRedelegateTombstoned: autoRedelegateTombstoned,
+					MaxTotalRewardWeight:     maxTotalRewardWeight,
+					TakeRateHistoryRetention: takeRateHistoryRetention,
+				},
+			}
+
+			err = updateMsg.ValidateBasic()
+
+			if err != nil {
+				return err
+			}
+
+			msg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{updateMsg}, deposit, from.String(), metadata)
+
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "metadata of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(FlagTakeRateSplits, "", "split of the take rate proceeds e.g. fee_collector=0.5,community_pool=0.3,burn=0.2")
+	cmd.Flags().Bool(FlagAutoRedelegateTombstoned, false, "automatically redelegate petrichor delegations away from tombstoned validators")
+	cmd.Flags().String(FlagMaxTotalRewardWeight, "", "optional cap on the sum of the reward weights of all petrichor assets")
+	cmd.Flags().Duration(FlagTakeRateHistoryRetention, types.DefaultParams().TakeRateHistoryRetention, "how long the per-period take rate history is kept")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func SweepDustDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sweep-dust-delegations denom",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a gov proposal to undelegate the delegations of a petrichor that are below its minimum delegation amount",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadata, err := cmd.Flags().GetString(FlagMetadata)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			sweepMsg := &types.MsgSweepDustDelegations{
+				Authority: authtypes.NewModuleAddress(govmoduletypes.ModuleName).String(),
+				Denom:     args[0],
+			}
+
+			err = sweepMsg.ValidateBasic()
+
+			if err != nil {
+				return err
+			}
+
+			msg, err := govv1.NewMsgSubmitProposal([]sdk.Msg{sweepMsg}, deposit, from.String(), metadata)
+
+			if err != nil {
+				return err
+			}
+
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMetadata, "", "metadata of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseAssetCapFlags reads the optional delegation caps and minimum of a petrichor. Empty flags mean no limit
+func parseAssetCapFlags(cmd *cobra.Command) (*math.Int, *sdk.Dec, *math.Int, error) {
+	maxTotalTokens, err := parseOptionalIntFlag(cmd, FlagMaxTotalTokens)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	maxValidatorShare, err := parseOptionalDecFlag(cmd, FlagMaxValidatorShare)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	minDelegationAmount, err := parseOptionalIntFlag(cmd, FlagMinDelegationAmount)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return maxTotalTokens, maxValidatorShare, minDelegationAmount, nil
+}
+
+// parseRewardWeightModeFlags reads the reward weight mode of a petrichor and the bounds of oracle priced weights
+func parseRewardWeightModeFlags(cmd *cobra.Command) (types.RewardWeightMode, *sdk.Dec, *sdk.Dec, error) {
+	modeStr, err := cmd.Flags().GetString(FlagRewardWeightMode)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	mode, ok := types.RewardWeightMode_value["REWARD_WEIGHT_MODE_"+strings.ToUpper(modeStr)]
+	if !ok {
+		return 0, nil, nil, fmt.Errorf("invalid %s: %s", FlagRewardWeightMode, modeStr)
+	}
+
+	minRewardWeight, err := parseOptionalDecFlag(cmd, FlagMinRewardWeight)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	maxRewardWeight, err := parseOptionalDecFlag(cmd, FlagMaxRewardWeight)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return types.RewardWeightMode(mode), minRewardWeight, maxRewardWeight, nil
+}
+
+func parseOptionalDecFlag(cmd *cobra.Command, flag string) (*sdk.Dec, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return nil, err
+	}
+	dec, err := sdk.NewDecFromStr(str)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %s", flag, str)
+	}
+	return &dec, nil
+}
+
+// parseTakeRateSplitsFlag reads a comma separated list of destination=ratio pairs
+func parseTakeRateSplitsFlag(cmd *cobra.Command) ([]types.TakeRateSplit, error) {
+	str, err := cmd.Flags().GetString(FlagTakeRateSplits)
+	if err != nil || str == "" {
+		return nil, err
+	}
+	var splits []types.TakeRateSplit
+	for _, pair := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid %s: %s", FlagTakeRateSplits, pair)
+		}
+		destination, ok := types.TakeRateDestination_value["TAKE_RATE_DESTINATION_"+strings.ToUpper(parts[0])]
+		if !ok {
+			return nil, fmt.Errorf("invalid take rate destination: %s", parts[0])
+		}
+		ratio, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid take rate ratio: %s", parts[1])
+		}
+		splits = append(splits, types.TakeRateSplit{
+			Destination: types.TakeRateDestination(destination),
+			Ratio:       ratio,
+		})
+	}
+	return splits, nil
+}
+
+// parseRewardWeightScheduleFlags reads a comma separated list of time=weight pairs, with times in RFC3339, and the
+// interpolation between them
+func parseRewardWeightScheduleFlags(cmd *cobra.Command) ([]types.RewardWeightSchedulePoint, types.RewardWeightInterpolation, error) {
+	interpolationStr, err := cmd.Flags().GetString(FlagRewardWeightInterpolation)
+	if err != nil {
+		return nil, 0, err
+	}
+	interpolation, ok := types.RewardWeightInterpolation_value["REWARD_WEIGHT_INTERPOLATION_"+strings.ToUpper(interpolationStr)]
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid %s: %s", FlagRewardWeightInterpolation, interpolationStr)
+	}
+
+	str, err := cmd.Flags().GetString(FlagRewardWeightSchedule)
+	if err != nil || str == "" {
+		return nil, types.RewardWeightInterpolation(interpolation), err
+	}
+	var schedule []types.RewardWeightSchedulePoint
+	for _, pair := range strings.Split(str, ",") {
+		parts := strings.Split(strings.TrimSpace(pair), "=")
+		if len(parts) != 2 {
+			return nil, 0, fmt.Errorf("invalid %s: %s", FlagRewardWeightSchedule, pair)
+		}
+		t, err := time.Parse(time.RFC3339, parts[0])
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid reward weight schedule time: %s", parts[0])
+		}
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid reward weight schedule target: %s", parts[1])
+		}
+		schedule = append(schedule, types.RewardWeightSchedulePoint{
+			Time:         t,
+			TargetWeight: weight,
+		})
+	}
+	return schedule, types.RewardWeightInterpolation(interpolation), nil
+}
+
+func parseOptionalIntFlag(cmd *cobra.Command, flag string) (*math.Int, error) {
+	str, err := cmd.Flags().GetString(flag)
+	if err != nil || str == "" {
+		return nil, err
+	}
+	amount, ok := sdk.NewIntFromString(str)
+	if !ok {
+		return nil, fmt.Errorf("invalid %s: %s", flag, str)
+	}
+	return &amount, nil
+}
@@ -25,6 +25,8 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryPetrichors())
 	cmd.AddCommand(CmdQueryPetrichor())
+	cmd.AddCommand(CmdQueryPetrichorCapacity())
+	cmd.AddCommand(CmdQueryPetrichorTakeRate())
 
 	cmd.AddCommand(CmdQueryValidator())
 	cmd.AddCommand(CmdQueryValidators())
@@ -34,6 +36,19 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdQueryPetrichorsDelegationByValidator())
 	cmd.AddCommand(CmdQueryPetrichorDelegation())
 	cmd.AddCommand(CmdQueryRewards())
+	cmd.AddCommand(CmdQueryDelegatorRewards())
+
+	cmd.AddCommand(CmdQueryUndelegations())
+	cmd.AddCommand(CmdQueryUndelegationsByValidator())
+	cmd.AddCommand(CmdQueryUndelegationsByAsset())
+	cmd.AddCommand(CmdQueryRedelegations())
+	cmd.AddCommand(CmdQueryRedelegationsByValidator())
+	cmd.AddCommand(CmdQueryRedelegationsByAsset())
+
+	cmd.AddCommand(CmdQueryValidatorSlashes())
+	cmd.AddCommand(CmdQueryDelegationSlashes())
+	cmd.AddCommand(CmdQueryInsuranceFund())
+	cmd.AddCommand(CmdQueryInsuranceClaims())
 
 	return cmd
 }
@@ -99,6 +114,219 @@ func CmdQueryPetrichor() *cobra.Command {
 	return cmd
 }
 
+func CmdQueryPetrichorTakeRate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "take-rate denom",
+		Short: "Query the cumulative take rate and paginated take rate history of a petrichor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorTakeRateRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := query.PetrichorTakeRate(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "take-rate")
+
+	return cmd
+}
+
+func CmdQueryValidatorSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-slashes validator-addr",
+		Short: "Query paginated slashes of a validator's petrichor positions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetUint64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorValidatorSlashesRequest{
+				ValidatorAddr: args[0],
+				StartHeight:   startHeight,
+				Pagination:    pageReq,
+			}
+
+			res, err := query.PetrichorValidatorSlashes(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartHeight, 0, "Only return slashes at or after this height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "validator-slashes")
+
+	return cmd
+}
+
+func CmdQueryInsuranceFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund",
+		Short: "Query the balance of the insurance fund that covers slashed petrichor undelegations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+			query := types.NewQueryClient(ctx)
+
+			res, err := query.PetrichorInsuranceFund(context.Background(), &types.QueryPetrichorInsuranceFundRequest{})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceClaims() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-claims [delegator-addr]",
+		Short: "Query paginated insurance claims of slashed undelegations, optionally of a single delegator",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorInsuranceClaimsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) == 1 {
+				params.DelegatorAddr = args[0]
+			}
+
+			res, err := query.PetrichorInsuranceClaims(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "insurance-claims")
+
+	return cmd
+}
+
+func CmdQueryDelegationSlashes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegation-slashes delegator-addr validator-addr denom",
+		Short: "Query paginated slashes that affected a petrichor delegation, including slashed redelegations into it",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startHeight, err := cmd.Flags().GetUint64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorDelegationSlashesRequest{
+				DelegatorAddr: args[0],
+				ValidatorAddr: args[1],
+				Denom:         args[2],
+				StartHeight:   startHeight,
+				Pagination:    pageReq,
+			}
+
+			res, err := query.PetrichorDelegationSlashes(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagStartHeight, 0, "Only return slashes at or after this height")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegation-slashes")
+
+	return cmd
+}
+
+func CmdQueryPetrichorCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "capacity denom [validator-addr]",
+		Short: "Query how many more tokens can be delegated for a petrichor, optionally to a validator",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			params := &types.QueryPetrichorCapacityRequest{Denom: args[0]}
+			if len(args) == 2 {
+				valAddr, err := sdk.ValAddressFromBech32(args[1])
+				if err != nil {
+					return err
+				}
+				params.ValidatorAddr = valAddr.String()
+			}
+
+			ctx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+
+			res, err := query.PetrichorCapacity(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdQueryValidator() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "validator validator-addr",
@@ -360,3 +588,242 @@ func CmdQueryRewards() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryDelegatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegator-rewards delegator_addr",
+		Short: "Query paginated rewards of all petrichor delegations for a delegator_addr",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			delegatorAddr := args[0]
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorDelegatorRewardsRequest{
+				DelegatorAddr: delegatorAddr,
+				Pagination:    pageReq,
+			}
+
+			res, err := query.PetrichorDelegatorRewards(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "delegator-rewards")
+
+	return cmd
+}
+
+func CmdQueryUndelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegations delegator_addr",
+		Short: "Query paginated petrichor undelegations for a delegator_addr",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorUndelegationsRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := query.PetrichorUndelegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "undelegations")
+
+	return cmd
+}
+
+func CmdQueryUndelegationsByValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegations-by-validator validator_addr",
+		Short: "Query paginated petrichor undelegations from a validator_addr",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorUndelegationsByValidatorRequest{
+				ValidatorAddr: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := query.PetrichorUndelegationsByValidator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "undelegations-by-validator")
+
+	return cmd
+}
+
+func CmdQueryUndelegationsByAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegations-by-asset denom",
+		Short: "Query paginated petrichor undelegations of an asset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorUndelegationsByAssetRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := query.PetrichorUndelegationsByAsset(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "undelegations-by-asset")
+
+	return cmd
+}
+
+func CmdQueryRedelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegations delegator_addr",
+		Short: "Query paginated petrichor redelegations for a delegator_addr",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorRedelegationsRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := query.PetrichorRedelegations(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegations")
+
+	return cmd
+}
+
+func CmdQueryRedelegationsByValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegations-by-validator validator_addr",
+		Short: "Query paginated petrichor redelegations from a source validator_addr",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorRedelegationsByValidatorRequest{
+				ValidatorAddr: args[0],
+				Pagination:    pageReq,
+			}
+
+			res, err := query.PetrichorRedelegationsByValidator(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegations-by-validator")
+
+	return cmd
+}
+
+func CmdQueryRedelegationsByAsset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegations-by-asset denom",
+		Short: "Query paginated petrichor redelegations of an asset",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			query := types.NewQueryClient(ctx)
+			params := &types.QueryPetrichorRedelegationsByAssetRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := query.PetrichorRedelegationsByAsset(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegations-by-asset")
+
+	return cmd
+}
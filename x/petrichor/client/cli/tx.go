@@ -3,7 +3,9 @@ package cli
 import (
 	"fmt"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -13,6 +15,38 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagValidator = "validator"
+	FlagDenom     = "denom"
+	FlagLimit     = "limit"
+	FlagStartKey  = "start-key"
+	FlagMetadata  = "metadata"
+
+	FlagMaxTotalTokens      = "max-total-tokens"
+	FlagMaxValidatorShare   = "max-validator-share"
+	FlagMinDelegationAmount = "min-delegation-amount"
+	FlagRewardWeightMode    = "reward-weight-mode"
+	FlagMinRewardWeight     = "min-reward-weight"
+	FlagMaxRewardWeight     = "max-reward-weight"
+	FlagTakeRateSplits      = "take-rate-splits"
+
+	FlagRewardWeightSchedule      = "reward-weight-schedule"
+	FlagRewardWeightInterpolation = "reward-weight-interpolation"
+
+	FlagInsuranceCoverageCeiling = "insurance-coverage-ceiling"
+
+	FlagOptOutPolicy = "opt-out-policy"
+
+	FlagCommissionMaxRate       = "max-rate"
+	FlagCommissionMaxChangeRate = "max-change-rate"
+
+	FlagStartHeight = "start-height"
+
+	FlagAutoRedelegateTombstoned = "auto-redelegate-tombstoned"
+	FlagMaxTotalRewardWeight     = "max-total-reward-weight"
+	FlagTakeRateHistoryRetention = "take-rate-history-retention"
+)
+
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -21,7 +55,12 @@ func NewTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	txCmd.AddCommand(NewDelegateCmd(), NewRedelegateCmd(), NewUndelegateCmd(), NewClaimDelegationRewardsCmd())
+	txCmd.AddCommand(NewDelegateCmd(), NewRedelegateCmd(), NewUndelegateCmd(), NewClaimDelegationRewardsCmd(),
+		NewLiquidDelegateCmd(), NewRedeemLiquidReceiptCmd(), NewClaimLiquidReceiptRewardsCmd(),
+		NewSetAutoCompoundCmd(), NewSetRewardWithdrawAddressCmd(),
+		NewClaimAllDelegationRewardsCmd(), NewCancelUndelegationCmd(), UpdateParams(), SweepDustDelegations(),
+		NewSetValidatorDenomFilterCmd(), NewSetPetrichorCommissionCmd(), NewWithdrawPetrichorCommissionCmd(),
+		NewSetFallbackValidatorCmd())
 	return txCmd
 }
 
@@ -215,3 +254,476 @@ $ %s tx petrichor claim-rewards %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm stake
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewLiquidDelegateCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "liquid-delegate [validator-addr] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Delegate petrichor enabled tokens to a validator and receive a transferable receipt",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Delegate an amount of petrichor enabled coins to a validator through the liquid staking pool.
+A receipt token that can be transferred and redeemed for the underlying delegation is minted to your wallet.
+
+Example:
+$ %s tx petrichor liquid-delegate %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgLiquidDelegate{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRedeemLiquidReceiptCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redeem-receipt [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Burn a liquid staking receipt and undelegate the underlying tokens",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn an amount of liquid staking receipts and undelegate the underlying tokens to your wallet (after the unbonding period has passed).
+
+Example:
+$ %s tx petrichor redeem-receipt 1000%s<hash> --from mykey
+`,
+				version.AppName, types.LiquidReceiptDenomPrefix,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRedeemLiquidReceipt{
+				HolderAddress: clientCtx.GetFromAddress().String(),
+				Amount:        amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewClaimLiquidReceiptRewardsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-receipt-rewards receipt-denom",
+		Args:  cobra.ExactArgs(1),
+		Short: "claim the rewards accrued by the liquid staking receipts you hold",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim all rewards accrued by a liquid staking receipt
+Example:
+$ %s tx petrichor claim-receipt-rewards %s<hash> --from mykey
+`,
+				version.AppName, types.LiquidReceiptDenomPrefix,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimLiquidReceiptRewards{
+				HolderAddress: clientCtx.GetFromAddress().String(),
+				ReceiptDenom:  args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetAutoCompoundCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "set-auto-compound validator-addr denom [true|false]",
+		Args:  cobra.ExactArgs(3),
+		Short: "turn auto-compounding of the rewards of a delegation on or off",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Periodically re-delegate the rewards of a delegation that are paid in the delegated denom
+Example:
+$ %s tx petrichor set-auto-compound %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm stake true --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSetAutoCompound{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Denom:            args[1],
+				Enabled:          enabled,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetRewardWithdrawAddressCmd() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-addr withdraw-addr",
+		Args:  cobra.ExactArgs(1),
+		Short: "change the address petrichor delegation rewards are paid to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the address that receives the rewards of all your petrichor delegations
+Example:
+$ %s tx petrichor set-withdraw-addr %s1gghjut3ccd8ay0zduzj64hwre2fxs9ld75ru9p --from mykey
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgSetRewardWithdrawAddress{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+				WithdrawAddress:  withdrawAddr.String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetFallbackValidatorCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "set-fallback-validator [validator-addr]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "set the validator your petrichor delegations are moved to when their validator is tombstoned",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the validator that receives your petrichor delegations when their validator is tombstoned.
+Without a fallback validator the delegations are spread across the active set. Omit the validator to remove it.
+Example:
+$ %s tx petrichor set-fallback-validator %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetFallbackValidator{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+			}
+			if len(args) == 1 {
+				valAddr, err := sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+				msg.ValidatorAddress = valAddr.String()
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetValidatorDenomFilterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-filter none|allowlist|denylist [denoms]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "set which petrichor assets your validator accepts delegations in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set an allowlist or a denylist of comma separated petrichor denoms for the validator operated by the sender.
+Existing delegations in assets that are no longer accepted are kept unless --%s is undelegate
+Example:
+$ %s tx petrichor set-denom-filter denylist ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2 --%s undelegate --from mykey
+`,
+				FlagOptOutPolicy, version.AppName, FlagOptOutPolicy,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			mode, ok := types.DenomFilterMode_value["DENOM_FILTER_MODE_"+strings.ToUpper(args[0])]
+			if !ok {
+				return fmt.Errorf("invalid denom filter mode: %s", args[0])
+			}
+			var denoms []string
+			if len(args) == 2 {
+				denoms = strings.Split(args[1], ",")
+			}
+			policyStr, err := cmd.Flags().GetString(FlagOptOutPolicy)
+			if err != nil {
+				return err
+			}
+			policy, ok := types.OptOutPolicy_value["OPT_OUT_POLICY_"+strings.ToUpper(policyStr)]
+			if !ok {
+				return fmt.Errorf("invalid %s: %s", FlagOptOutPolicy, policyStr)
+			}
+
+			msg := &types.MsgSetValidatorDenomFilter{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				Mode:             types.DenomFilterMode(mode),
+				Denoms:           denoms,
+				OptOutPolicy:     types.OptOutPolicy(policy),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagOptOutPolicy, "grandfather", "what happens to existing delegations in assets that are no longer accepted, either grandfather or undelegate")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewSetPetrichorCommissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-commission rate",
+		Args:  cobra.ExactArgs(1),
+		Short: "set the commission your validator takes on petrichor rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the commission the validator operated by the sender takes on petrichor rewards.
+--%s and --%s must be set with the first commission and cannot be changed afterwards
+Example:
+$ %s tx petrichor set-commission 0.05 --%s 0.2 --%s 0.01 --from mykey
+`,
+				FlagCommissionMaxRate, FlagCommissionMaxChangeRate, version.AppName, FlagCommissionMaxRate, FlagCommissionMaxChangeRate,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+			maxRate, err := parseOptionalDecFlag(cmd, FlagCommissionMaxRate)
+			if err != nil {
+				return err
+			}
+			maxChangeRate, err := parseOptionalDecFlag(cmd, FlagCommissionMaxChangeRate)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetPetrichorCommission{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+				Rate:             rate,
+				MaxRate:          maxRate,
+				MaxChangeRate:    maxChangeRate,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagCommissionMaxRate, "", "maximum commission rate, only set with the first commission")
+	cmd.Flags().String(FlagCommissionMaxChangeRate, "", "maximum daily change of the commission rate, only set with the first commission")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewWithdrawPetrichorCommissionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-commission",
+		Args:  cobra.NoArgs,
+		Short: "withdraw the petrichor commission accumulated by your validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawPetrichorCommission{
+				ValidatorAddress: sdk.ValAddress(clientCtx.GetFromAddress()).String(),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewClaimAllDelegationRewardsCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "claim-all-rewards",
+		Args:  cobra.NoArgs,
+		Short: "claim rewards from all delegations, optionally filtered by validator and denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim rewards from all delegations in a single transaction
+Example:
+$ %s tx petrichor claim-all-rewards --from mykey
+$ %s tx petrichor claim-all-rewards --validator %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm --denom stake --from mykey
+`,
+				version.AppName, version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			validator, err := cmd.Flags().GetString(FlagValidator)
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(FlagLimit)
+			if err != nil {
+				return err
+			}
+			startKey, err := cmd.Flags().GetBytesBase64(FlagStartKey)
+			if err != nil {
+				return err
+			}
+			msg := &types.MsgClaimAllDelegationRewards{
+				DelegatorAddress: clientCtx.GetFromAddress().String(),
+				ValidatorAddress: validator,
+				Denom:            denom,
+				Limit:            limit,
+				StartKey:         startKey,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagValidator, "", "Only claim rewards from this validator")
+	cmd.Flags().String(FlagDenom, "", "Only claim rewards of delegations with this denom")
+	cmd.Flags().Uint32(FlagLimit, 0, fmt.Sprintf("Maximum number of delegations to claim (max %d)", types.MaxClaimAllDelegations))
+	cmd.Flags().BytesBase64(FlagStartKey, nil, "Continue a previous claim from the next key it returned")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCancelUndelegationCmd() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "cancel-undelegation validator-addr amount completion-time",
+		Args:  cobra.ExactArgs(3),
+		Short: "Cancel an undelegation and delegate the tokens back to the validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel part or all of an undelegation that has not completed yet. The completion time is in RFC3339 format.
+
+Example:
+$ %s tx petrichor cancel-undelegation %s1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm 1000stake 2023-01-02T15:04:05Z --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			completionTime, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			delAddr := clientCtx.GetFromAddress()
+			valAddr, err := sdk.ValAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelUndelegation{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Amount:           amount,
+				CompletionTime:   completionTime,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
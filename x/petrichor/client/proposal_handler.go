@@ -10,4 +10,5 @@ var (
 	CreatePetrichorProposalHandler = govclient.NewProposalHandler(cli.CreatePetrichor)
 	UpdatePetrichorProposalHandler = govclient.NewProposalHandler(cli.UpdatePetrichor)
 	DeletePetrichorProposalHandler = govclient.NewProposalHandler(cli.DeletePetrichor)
+	DelistPetrichorProposalHandler = govclient.NewProposalHandler(cli.DelistPetrichor)
 )
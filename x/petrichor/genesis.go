@@ -1,15 +1,99 @@
 package petrichor
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
 	"time"
 )
 
 // ValidateGenesis
 func ValidateGenesis(data *types.GenesisState) error {
-	params := data.Params
-	if params.TakeRateClaimInterval <= 0 {
-		return types.ErrInvalidGenesisState.Wrap("reward_claim_interval has to be more than 0")
+	if err := data.Params.Validate(); err != nil {
+		return types.ErrInvalidGenesisState.Wrap(err.Error())
+	}
+	for _, asset := range data.Assets {
+		if err := types.ValidateTakeRateSplits(asset.TakeRateSplits); err != nil {
+			return types.ErrInvalidGenesisState.Wrapf("%s: %s", asset.Denom, err)
+		}
+	}
+	for _, accrual := range data.TakeRateAccruals {
+		if accrual.TotalAmount.IsNil() || accrual.TotalAmount.IsNegative() {
+			return types.ErrInvalidGenesisState.Wrapf("%s: take rate accrual cannot be negative", accrual.Denom)
+		}
+	}
+	for _, record := range data.TakeRateHistory {
+		if record.Amount.IsNil() || record.Amount.IsNegative() {
+			return types.ErrInvalidGenesisState.Wrapf("%s: take rate record cannot be negative", record.Denom)
+		}
+	}
+	for _, record := range data.SlashRecords {
+		if _, err := sdk.ValAddressFromBech32(record.ValidatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if record.Fraction.IsNil() || !record.Fraction.IsPositive() || record.Fraction.GT(sdk.OneDec()) {
+			return types.ErrInvalidGenesisState.Wrapf("%s: slash fraction must be in (0, 1]", record.ValidatorAddress)
+		}
+	}
+	for _, claim := range data.InsuranceClaims {
+		if _, err := sdk.AccAddressFromBech32(claim.DelegatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if _, err := sdk.ValAddressFromBech32(claim.ValidatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if claim.Slashed.Denom != claim.Covered.Denom || claim.Covered.Amount.GT(claim.Slashed.Amount) {
+			return types.ErrInvalidGenesisState.Wrapf("%s: insurance claim cannot cover more than was slashed", claim.DelegatorAddress)
+		}
+	}
+	for _, withdrawAddress := range data.WithdrawAddresses {
+		if _, err := sdk.AccAddressFromBech32(withdrawAddress.DelegatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if _, err := sdk.AccAddressFromBech32(withdrawAddress.WithdrawAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+	}
+	for _, fallback := range data.FallbackValidators {
+		if _, err := sdk.AccAddressFromBech32(fallback.DelegatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if _, err := sdk.ValAddressFromBech32(fallback.ValidatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+	}
+	receipts := map[string]bool{}
+	for _, receipt := range data.LiquidReceipts {
+		if err := sdk.ValidateDenom(receipt.Denom); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if err := sdk.ValidateDenom(receipt.AssetDenom); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if _, err := sdk.ValAddressFromBech32(receipt.ValidatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		receipts[receipt.Denom] = true
+	}
+	for _, holder := range data.LiquidReceiptHolders {
+		if _, err := sdk.AccAddressFromBech32(holder.HolderAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if !receipts[holder.ReceiptDenom] {
+			return types.ErrInvalidGenesisState.Wrapf("%s: liquid receipt holder of an unknown receipt %s", holder.HolderAddress, holder.ReceiptDenom)
+		}
+		if holder.Balance.IsNil() || holder.Balance.IsNegative() {
+			return types.ErrInvalidGenesisState.Wrapf("%s: liquid receipt balance cannot be negative", holder.HolderAddress)
+		}
+	}
+	archived := map[string]bool{}
+	for _, val := range data.ArchivedValidatorInfos {
+		if _, err := sdk.ValAddressFromBech32(val.ValidatorAddress); err != nil {
+			return types.ErrInvalidGenesisState.Wrap(err.Error())
+		}
+		if archived[val.ValidatorAddress] {
+			return types.ErrInvalidGenesisState.Wrapf("%s: duplicate archived validator info", val.ValidatorAddress)
+		}
+		archived[val.ValidatorAddress] = true
 	}
 	if len(data.Delegations) > 0 && len(data.Assets) == 0 {
 		return types.ErrInvalidGenesisState.Wrap("cannot have delegations without petrichor assets")
@@ -20,15 +104,22 @@ func ValidateGenesis(data *types.GenesisState) error {
 	if len(data.Redelegations) > 0 && len(data.Delegations) == 0 {
 		return types.ErrInvalidGenesisState.Wrap("cannot have redelegations without delegations")
 	}
+	if len(data.AutoCompoundDelegations) > 0 && len(data.Delegations) == 0 {
+		return types.ErrInvalidGenesisState.Wrap("cannot have auto-compounding delegations without delegations")
+	}
 	return nil
 }
 
 func DefaultGenesisState() *types.GenesisState {
 	return &types.GenesisState{
 		Params: types.Params{
-			RewardDelayTime:       24 * 60 * 60 * 1000_000_000,
-			TakeRateClaimInterval: 5 * 60 * 1000_000_000,
-			LastTakeRateClaimTime: time.Now(),
+			RewardDelayTime:          24 * 60 * 60 * 1000_000_000,
+			TakeRateClaimInterval:    5 * 60 * 1000_000_000,
+			LastTakeRateClaimTime:    time.Now(),
+			AutoCompoundInterval:     6 * 60 * 60 * 1000_000_000,
+			LastAutoCompoundTime:     time.Now(),
+			MaxTotalRewardWeight:     types.DefaultParams().MaxTotalRewardWeight,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
 		},
 		Assets:                     []types.PetrichorAsset{},
 		ValidatorInfos:             []types.ValidatorInfoState{},
@@ -36,5 +127,15 @@ func DefaultGenesisState() *types.GenesisState {
 		Delegations:                []types.Delegation{},
 		Redelegations:              []types.RedelegationState{},
 		Undelegations:              []types.UndelegationState{},
+		LiquidReceipts:             []types.LiquidReceipt{},
+		LiquidReceiptHolders:       []types.LiquidReceiptHolder{},
+		AutoCompoundDelegations:    []types.AutoCompoundDelegation{},
+		WithdrawAddresses:          []types.DelegatorWithdrawAddress{},
+		TakeRateAccruals:           []types.TakeRateAccrual{},
+		TakeRateHistory:            []types.TakeRateRecord{},
+		SlashRecords:               []types.SlashRecord{},
+		InsuranceClaims:            []types.InsuranceClaim{},
+		FallbackValidators:         []types.DelegatorFallbackValidator{},
+		ArchivedValidatorInfos:     []types.ValidatorInfoState{},
 	}
 }
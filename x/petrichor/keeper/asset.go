@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -18,8 +19,11 @@ func (k Keeper) UpdatePetrichorAsset(ctx sdk.Context, newAsset types.PetrichorAs
 	if !found {
 		return types.ErrUnknownAsset
 	}
+	err := k.PetrichorHooks().BeforeUpdatePetrichorAsset(ctx, newAsset)
+	if err != nil {
+		return err
+	}
 
-	var err error
 	// Only add a snapshot if reward weight changes
 	if !newAsset.RewardWeight.Equal(asset.RewardWeight) {
 		k.IteratePetrichorValidatorInfo(ctx, func(valAddr sdk.ValAddress, info types.PetrichorValidatorInfo) bool {
@@ -53,14 +57,11 @@ func (k Keeper) UpdatePetrichorAsset(ctx sdk.Context, newAsset types.PetrichorAs
 	}
 
 	// Make sure only whitelisted fields can be updated
-	asset.TakeRate = newAsset.TakeRate
-	asset.RewardWeight = newAsset.RewardWeight
-	asset.RewardChangeRate = newAsset.RewardChangeRate
-	asset.RewardChangeInterval = newAsset.RewardChangeInterval
+	applyAssetConfig(&asset, newAsset.AssetConfig())
 	asset.LastRewardChangeTime = newAsset.LastRewardChangeTime
 	k.SetAsset(ctx, asset)
 
-	return nil
+	return k.PetrichorHooks().AfterUpdatePetrichorAsset(ctx, asset)
 }
 
 func (k Keeper) RebalanceHook(ctx sdk.Context, assets []*types.PetrichorAsset) error {
@@ -227,11 +228,14 @@ func (k Keeper) DeductAssetsHook(ctx sdk.Context, assets []*types.PetrichorAsset
 }
 
 // DeductAssetsWithTakeRate Deducts an petrichor asset using the take_rate
-// The deducted asset is distributed to the fee_collector module account to be redistributed to stakers
+// The deducted asset is split across the take rate destinations of the asset, or of the module if the asset does
+// not define any. By default everything goes to the fee_collector module account to be redistributed to stakers
 func (k Keeper) DeductAssetsWithTakeRate(ctx sdk.Context, lastClaim time.Time, assets []*types.PetrichorAsset) (sdk.Coins, error) {
 	rewardClaimInterval := k.RewardClaimInterval(ctx)
 	durationSinceLastClaim := ctx.BlockTime().Sub(lastClaim)
 	intervalsSinceLastClaim := uint64(durationSinceLastClaim / rewardClaimInterval)
+	moduleSplits := k.TakeRateSplits(ctx)
+	distributions := map[types.TakeRateDestination]sdk.Coins{}
 	var coins sdk.Coins
 	for _, asset := range assets {
 		if asset.TotalTokens.IsPositive() && asset.TakeRate.IsPositive() {
@@ -247,13 +251,30 @@ func (k Keeper) DeductAssetsWithTakeRate(ctx sdk.Context, lastClaim time.Time, a
 			deductedAmount := oldAmount.Sub(asset.TotalTokens)
 			coins = coins.Add(sdk.NewCoin(asset.Denom, deductedAmount))
 			k.SetAsset(ctx, *asset)
+			accrual := k.recordTakeRate(ctx, asset.Denom, deductedAmount)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeTakeRateDeduction,
+				sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, deductedAmount.String()),
+				sdk.NewAttribute(types.AttributeKeyTotalAmount, accrual.TotalAmount.String()),
+			))
+
+			splits := asset.TakeRateSplits
+			if len(splits) == 0 {
+				splits = moduleSplits
+			}
+			for destination, amount := range types.SplitTakeRate(deductedAmount, splits) {
+				distributions[destination] = distributions[destination].Add(sdk.NewCoin(asset.Denom, amount))
+			}
 		}
 	}
 
 	if !coins.Empty() && !coins.IsZero() {
-		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
-		if err != nil {
-			return nil, err
+		for _, destination := range types.TakeRateDestinations {
+			err := k.distributeTakeRate(ctx, destination, distributions[destination])
+			if err != nil {
+				return nil, err
+			}
 		}
 		// Only update if there was a token transfer to prevent < 1 amounts to be ignored
 		k.SetLastRewardClaimTime(ctx, lastClaim.Add(rewardClaimInterval*time.Duration(intervalsSinceLastClaim)))
@@ -261,6 +282,37 @@ func (k Keeper) DeductAssetsWithTakeRate(ctx sdk.Context, lastClaim time.Time, a
 	return coins, nil
 }
 
+// distributeTakeRate sends take rate proceeds held by the module account to a destination
+func (k Keeper) distributeTakeRate(ctx sdk.Context, destination types.TakeRateDestination, coins sdk.Coins) error {
+	if coins.IsZero() {
+		return nil
+	}
+	var err error
+	switch destination {
+	case types.TakeRateDestinationFeeCollector:
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, coins)
+	case types.TakeRateDestinationCommunityPool:
+		err = k.distributionKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	case types.TakeRateDestinationBuyback:
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.BuybackPoolName, coins)
+	case types.TakeRateDestinationBurn:
+		err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	case types.TakeRateDestinationInsuranceFund:
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.InsuranceFundName, coins)
+	default:
+		err = fmt.Errorf("unknown take rate destination %d", destination)
+	}
+	if err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTakeRateDistribution,
+		sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+		sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+	))
+	return nil
+}
+
 func (k Keeper) SetRewardWeightChangeSnapshot(ctx sdk.Context, asset types.PetrichorAsset, val types.PetrichorValidator) {
 	snapshot := types.NewRewardWeightChangeSnapshot(asset, val)
 	k.setRewardWeightChangeSnapshot(ctx, asset.Denom, val.GetOperator(), uint64(ctx.BlockHeight()), snapshot)
@@ -296,6 +348,14 @@ func (k Keeper) IterateAllWeightChangeSnapshot(ctx sdk.Context, cb func(denom st
 
 func (k Keeper) RewardWeightChangeHook(ctx sdk.Context, assets []*types.PetrichorAsset) {
 	for _, asset := range assets {
+		if asset.IsOracleWeighted() {
+			k.updateOracleRewardWeight(ctx, asset)
+			continue
+		}
+		if asset.IsScheduleWeighted() {
+			k.updateScheduledRewardWeight(ctx, asset)
+			continue
+		}
 		// If no reward changes are required, skip
 		if asset.RewardChangeInterval == 0 || asset.RewardChangeRate.Equal(sdk.OneDec()) {
 			continue
@@ -315,3 +375,68 @@ func (k Keeper) RewardWeightChangeHook(ctx sdk.Context, assets []*types.Petricho
 		k.UpdatePetrichorAsset(ctx, *asset)
 	}
 }
+
+// checkPriceSource rejects oracle priced reward weights when the chain has no price source to derive them from
+func (k Keeper) checkPriceSource(config types.PetrichorAssetConfig) error {
+	if config.RewardWeightMode == types.RewardWeightModeOracle && k.priceSource == nil {
+		return types.ErrNoPriceSource.Wrapf("denom: %s", config.Denom)
+	}
+	return nil
+}
+
+// updateOracleRewardWeight derives the reward weight of the asset from its price at most once per
+// RewardWeightUpdateInterval. The asset keeps its last weight if the price is not available.
+// UpdatePetrichorAsset writes the snapshots when the clamped weight changes
+func (k Keeper) updateOracleRewardWeight(ctx sdk.Context, asset *types.PetrichorAsset) {
+	if k.priceSource == nil || asset.IsDelisting {
+		return
+	}
+	if asset.LastRewardChangeTime.Add(asset.RewardWeightUpdateInterval()).After(ctx.BlockTime()) {
+		return
+	}
+	price, found := k.priceSource.GetPrice(ctx, asset.Denom)
+	if !found || price.IsNil() || !price.IsPositive() {
+		return
+	}
+	weight := asset.ClampRewardWeight(price)
+	if weight.Equal(asset.RewardWeight) {
+		return
+	}
+	asset.RewardWeight = weight
+	asset.LastRewardChangeTime = ctx.BlockTime()
+	err := k.UpdatePetrichorAsset(ctx, *asset)
+	if err != nil {
+		k.Logger(ctx).Error("failed to update oracle priced reward weight", "denom", asset.Denom, "err", err)
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRewardWeightChange,
+		sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+		sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		sdk.NewAttribute(types.AttributeKeyRewardWeight, weight.String()),
+	))
+}
+
+// updateScheduledRewardWeight moves the reward weight of the asset along its schedule, which only changes at its
+// points and steps. UpdatePetrichorAsset writes the snapshots when the weight changes
+func (k Keeper) updateScheduledRewardWeight(ctx sdk.Context, asset *types.PetrichorAsset) {
+	if asset.IsDelisting {
+		return
+	}
+	weight, found := asset.ScheduledRewardWeight(ctx.BlockTime())
+	if !found || weight.Equal(asset.RewardWeight) {
+		return
+	}
+	asset.RewardWeight = weight
+	asset.LastRewardChangeTime = ctx.BlockTime()
+	err := k.UpdatePetrichorAsset(ctx, *asset)
+	if err != nil {
+		k.Logger(ctx).Error("failed to update scheduled reward weight", "denom", asset.Denom, "err", err)
+		return
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRewardWeightChange,
+		sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+		sdk.NewAttribute(types.AttributeKeyRewardWeight, weight.String()),
+	))
+}
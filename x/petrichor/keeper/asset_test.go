@@ -2,14 +2,17 @@ package keeper_test
 
 import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	abcitypes "github.com/tendermint/tendermint/abci/types"
 	test_helpers "github.com/petrinetwork/petrichor/app"
+	"github.com/petrinetwork/petrichor/testutil/twap"
 	"github.com/petrinetwork/petrichor/x/petrichor"
+	"github.com/petrinetwork/petrichor/x/petrichor/keeper"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
@@ -779,9 +782,11 @@ func TestClaimTakeRate(t *testing.T) {
 	takeRateInterval := time.Minute * 5
 	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
 		Params: types.Params{
-			RewardDelayTime:       time.Minute * 60,
-			TakeRateClaimInterval: takeRateInterval,
-			LastTakeRateClaimTime: startTime,
+			RewardDelayTime:          time.Minute * 60,
+			TakeRateClaimInterval:    takeRateInterval,
+			LastTakeRateClaimTime:    startTime,
+			AutoCompoundInterval:     types.DefaultParams().AutoCompoundInterval,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
 		},
 		Assets: []types.PetrichorAsset{
 			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.MustNewDecFromStr("0.5"), startTime),
@@ -867,9 +872,11 @@ func TestClaimTakeRateToZero(t *testing.T) {
 	asset := types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.MustNewDecFromStr("0.8"), startTime)
 	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
 		Params: types.Params{
-			RewardDelayTime:       time.Minute * 60,
-			TakeRateClaimInterval: takeRateInterval,
-			LastTakeRateClaimTime: startTime,
+			RewardDelayTime:          time.Minute * 60,
+			TakeRateClaimInterval:    takeRateInterval,
+			LastTakeRateClaimTime:    startTime,
+			AutoCompoundInterval:     types.DefaultParams().AutoCompoundInterval,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
 		},
 		Assets: []types.PetrichorAsset{
 			asset,
@@ -904,3 +911,370 @@ func TestClaimTakeRateToZero(t *testing.T) {
 	asset, _ = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
 	require.True(t, asset.TotalTokens.GTE(sdk.OneInt()))
 }
+
+func TestOracleRewardWeight(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{},
+	})
+	minRewardWeight := sdk.MustNewDecFromStr("0.5")
+	maxRewardWeight := sdk.NewDec(5)
+	createProposal := &types.MsgCreatePetrichorProposal{
+		Denom:            PETRICHOR_TOKEN_DENOM,
+		RewardWeight:     sdk.NewDec(1),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+		RewardWeightMode: types.RewardWeightModeOracle,
+		MinRewardWeight:  &minRewardWeight,
+		MaxRewardWeight:  &maxRewardWeight,
+	}
+
+	// Oracle mode is rejected while no price source is configured
+	err := app.PetrichorKeeper.CreatePetrichor(ctx, createProposal)
+	require.ErrorIs(t, err, types.ErrNoPriceSource)
+	_, err = keeper.NewMsgServerImpl(app.PetrichorKeeper).CreatePetrichor(ctx, &types.MsgCreatePetrichor{
+		Authority:        authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Denom:            PETRICHOR_TOKEN_DENOM,
+		RewardWeight:     sdk.NewDec(1),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+		RewardWeightMode: types.RewardWeightModeOracle,
+		MinRewardWeight:  &minRewardWeight,
+		MaxRewardWeight:  &maxRewardWeight,
+	})
+	require.ErrorIs(t, err, types.ErrNoPriceSource)
+	_, found := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+
+	priceSource := twap.NewPriceSource(time.Hour)
+	app.PetrichorKeeper.SetPriceSource(priceSource)
+	err = app.PetrichorKeeper.CreatePetrichor(ctx, createProposal)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	valAddr, err := sdk.ValAddressFromBech32(app.StakingKeeper.GetAllDelegations(ctx)[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx)
+	countSnapshots := func() int {
+		count := 0
+		app.PetrichorKeeper.IterateAllWeightChangeSnapshot(ctx, func(denom string, valAddr sdk.ValAddress, lastClaimHeight uint64, snapshot types.RewardWeightChangeSnapshot) (stop bool) {
+			count++
+			return false
+		})
+		return count
+	}
+	rewardWeight := func() sdk.Dec {
+		asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+		return asset.RewardWeight
+	}
+
+	// Without a price the weight set by governance is kept
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, sdk.NewDec(1), rewardWeight())
+	require.Equal(t, 0, countSnapshots())
+
+	// The weight follows the price once the update interval has passed since the reward start time and a snapshot
+	// is taken for the validator
+	priceSource.RecordPrice(ctx, PETRICHOR_TOKEN_DENOM, sdk.NewDec(2))
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 2)).WithBlockHeight(2)
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, sdk.NewDec(2), rewardWeight())
+	require.Equal(t, 1, countSnapshots())
+	require.True(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// The weight is kept until the update interval has passed again
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 5 / 2)).WithBlockHeight(3)
+	priceSource.RecordPrice(ctx, PETRICHOR_TOKEN_DENOM, sdk.NewDec(10))
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, sdk.NewDec(2), rewardWeight())
+	require.Equal(t, 1, countSnapshots())
+	require.False(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// The time weighted average of 2 and 10 is clamped to the max reward weight
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 3)).WithBlockHeight(4)
+	price, found := priceSource.GetPrice(ctx, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(6), price)
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, maxRewardWeight, rewardWeight())
+	require.Equal(t, 2, countSnapshots())
+	require.True(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// No snapshot is taken while the clamped weight does not change
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 4)).WithBlockHeight(5)
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, maxRewardWeight, rewardWeight())
+	require.Equal(t, 2, countSnapshots())
+	require.False(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// Oracle mode requires both bounds
+	err = (&types.MsgUpdatePetrichorProposal{
+		Denom:            PETRICHOR_TOKEN_DENOM,
+		RewardWeight:     sdk.NewDec(1),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+		RewardWeightMode: types.RewardWeightModeOracle,
+		MinRewardWeight:  &minRewardWeight,
+	}).ValidateBasic()
+	require.Error(t, err)
+}
+
+func TestScheduledRewardWeight(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{},
+	})
+
+	ceiling := sdk.MustNewDecFromStr("3.5")
+	err := app.PetrichorKeeper.CreatePetrichor(ctx, &types.MsgCreatePetrichorProposal{
+		Denom:            PETRICHOR_TOKEN_DENOM,
+		RewardWeight:     sdk.NewDec(1),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+		RewardWeightMode: types.RewardWeightModeSchedule,
+		MaxRewardWeight:  &ceiling,
+		RewardWeightSchedule: []types.RewardWeightSchedulePoint{
+			{Time: startTime.Add(time.Hour), TargetWeight: sdk.NewDec(2)},
+			{Time: startTime.Add(time.Hour * 3), TargetWeight: sdk.NewDec(4)},
+		},
+		RewardWeightInterpolation: types.RewardWeightInterpolationLinear,
+	})
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	valAddr, err := sdk.ValAddressFromBech32(app.StakingKeeper.GetAllDelegations(ctx)[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx)
+	countSnapshots := func() int {
+		count := 0
+		app.PetrichorKeeper.IterateAllWeightChangeSnapshot(ctx, func(denom string, valAddr sdk.ValAddress, lastClaimHeight uint64, snapshot types.RewardWeightChangeSnapshot) (stop bool) {
+			count++
+			return false
+		})
+		return count
+	}
+	rewardWeight := func() sdk.Dec {
+		asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+		return asset.RewardWeight
+	}
+
+	// The weight set by governance is kept before the first point
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, sdk.NewDec(1), rewardWeight())
+	require.Equal(t, 0, countSnapshots())
+
+	// The first target is reached and a snapshot is taken for the validator
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour)).WithBlockHeight(2)
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, sdk.NewDec(2), rewardWeight())
+	require.Equal(t, 1, countSnapshots())
+	require.True(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// The interpolated weight is kept until the update interval has passed
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 3 / 2)).WithBlockHeight(3)
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, sdk.NewDec(2), rewardWeight())
+	require.Equal(t, 1, countSnapshots())
+	require.False(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// Halfway to the next target the weight is interpolated
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 2)).WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, sdk.NewDec(3), rewardWeight())
+	require.Equal(t, 2, countSnapshots())
+	require.True(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+	changes := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRewardWeightChange {
+			changes++
+		}
+	}
+	require.Equal(t, 1, changes)
+
+	// Reaching the last target updates the weight before the interval has passed, bounded by the ceiling
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 3)).WithBlockHeight(5)
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, ceiling, rewardWeight())
+	require.Equal(t, 3, countSnapshots())
+	require.True(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+
+	// The weight does not change after the last target
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 5)).WithBlockHeight(6)
+	app.PetrichorKeeper.RewardWeightChangeHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.Equal(t, ceiling, rewardWeight())
+	require.Equal(t, 3, countSnapshots())
+	require.False(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+}
+
+func TestTakeRateSplits(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	takeRateInterval := time.Minute * 5
+	asset2 := types.NewPetrichorAsset(PETRICHOR_2_TOKEN_DENOM, sdk.NewDec(1), sdk.MustNewDecFromStr("0.5"), startTime)
+	asset2.TakeRateSplits = []types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationBuyback, Ratio: sdk.OneDec()},
+	}
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.Params{
+			RewardDelayTime:       time.Minute * 60,
+			TakeRateClaimInterval: takeRateInterval,
+			LastTakeRateClaimTime: startTime,
+			TakeRateSplits: []types.TakeRateSplit{
+				{Destination: types.TakeRateDestinationFeeCollector, Ratio: sdk.MustNewDecFromStr("0.5")},
+				{Destination: types.TakeRateDestinationCommunityPool, Ratio: sdk.MustNewDecFromStr("0.25")},
+				{Destination: types.TakeRateDestinationBurn, Ratio: sdk.MustNewDecFromStr("0.25")},
+			},
+			AutoCompoundInterval:     types.DefaultParams().AutoCompoundInterval,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
+		},
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.MustNewDecFromStr("0.5"), startTime),
+			asset2,
+		},
+	})
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	val, err = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	supplyBefore := app.BankKeeper.GetSupply(ctx, PETRICHOR_TOKEN_DENOM).Amount
+	communityBefore := app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf(PETRICHOR_TOKEN_DENOM)
+
+	// WHEN the take rate is claimed
+	ctx = ctx.WithBlockTime(startTime.Add(takeRateInterval + time.Second)).WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	coins, err := app.PetrichorKeeper.DeductAssetsHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+
+	// THEN the module wide split applies to the first asset and the asset split to the second one
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000)),
+		sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(500_000)),
+	), coins)
+	feeCollector := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName))
+	require.Equal(t, sdk.NewInt(250_000), feeCollector.AmountOf(PETRICHOR_TOKEN_DENOM))
+	require.True(t, feeCollector.AmountOf(PETRICHOR_2_TOKEN_DENOM).IsZero())
+	community := app.DistrKeeper.GetFeePool(ctx).CommunityPool.AmountOf(PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewDec(125_000), community.Sub(communityBefore))
+	require.Equal(t, sdk.NewInt(125_000), supplyBefore.Sub(app.BankKeeper.GetSupply(ctx, PETRICHOR_TOKEN_DENOM).Amount))
+	buyback := app.BankKeeper.GetAllBalances(ctx, app.AccountKeeper.GetModuleAddress(types.BuybackPoolName))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(500_000))), buyback)
+
+	// One event per destination that received proceeds
+	distributions := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeTakeRateDistribution {
+			distributions++
+		}
+	}
+	require.Equal(t, 4, distributions)
+}
+
+func TestTakeRateAccrual(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	takeRateInterval := time.Minute * 5
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.Params{
+			RewardDelayTime:          time.Minute * 60,
+			TakeRateClaimInterval:    takeRateInterval,
+			LastTakeRateClaimTime:    startTime,
+			AutoCompoundInterval:     types.DefaultParams().AutoCompoundInterval,
+			TakeRateHistoryRetention: time.Hour,
+		},
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.MustNewDecFromStr("0.5"), startTime),
+		},
+	})
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	// Nothing accrued before the first claim
+	accrual := app.PetrichorKeeper.GetTakeRateAccrual(ctx, PETRICHOR_TOKEN_DENOM)
+	require.True(t, accrual.TotalAmount.IsZero())
+
+	// WHEN the take rate is claimed twice
+	ctx = ctx.WithBlockTime(startTime.Add(takeRateInterval + time.Second)).WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	_, err = app.PetrichorKeeper.DeductAssetsHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.NoError(t, err)
+	deductions := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeTakeRateDeduction {
+			deductions++
+		}
+	}
+	require.Equal(t, 1, deductions)
+
+	secondClaim := startTime.Add(takeRateInterval*2 + time.Second*2)
+	ctx = ctx.WithBlockTime(secondClaim).WithBlockHeight(3)
+	_, err = app.PetrichorKeeper.DeductAssetsHook(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.NoError(t, err)
+
+	// THEN the accrual adds up both deductions and each one is kept in the history
+	accrual = app.PetrichorKeeper.GetTakeRateAccrual(ctx, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(750_000), accrual.TotalAmount)
+	require.Equal(t, secondClaim, accrual.LastClaimTime)
+
+	queryServer := keeper.NewQueryServerImpl(app.PetrichorKeeper)
+	res, err := queryServer.PetrichorTakeRate(ctx, &types.QueryPetrichorTakeRateRequest{Denom: PETRICHOR_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.Equal(t, accrual, res.Accrual)
+	require.Len(t, res.History, 2)
+	require.Equal(t, uint64(2), res.History[0].Height)
+	require.Equal(t, sdk.NewInt(500_000), res.History[0].Amount)
+	require.Equal(t, uint64(3), res.History[1].Height)
+	require.Equal(t, sdk.NewInt(250_000), res.History[1].Amount)
+
+	// AND both are exported
+	genesis := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.TakeRateAccrual{accrual}, genesis.TakeRateAccruals)
+	require.Equal(t, res.History, genesis.TakeRateHistory)
+
+	// WHEN the first record falls out of the retention window
+	ctx = ctx.WithBlockTime(startTime.Add(takeRateInterval + time.Second*2 + time.Hour))
+	app.PetrichorKeeper.PruneTakeRateHistory(ctx)
+
+	// THEN only the newer record is kept and the accrual is unchanged
+	res, err = queryServer.PetrichorTakeRate(ctx, &types.QueryPetrichorTakeRateRequest{Denom: PETRICHOR_TOKEN_DENOM})
+	require.NoError(t, err)
+	require.Equal(t, accrual, res.Accrual)
+	require.Len(t, res.History, 1)
+	require.Equal(t, uint64(3), res.History[0].Height)
+}
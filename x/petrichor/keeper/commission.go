@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SetValidatorCommission sets the commission the validator takes on petrichor rewards. The first commission sets
+// max_rate and max_change_rate, later changes follow the x/staking rules: the rate must stay below max_rate, change by
+// at most max_change_rate and be updated at most once a day.
+// Pending rewards are claimed first so that they are paid at the previous rate
+func (k Keeper) SetValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress, rate sdk.Dec, maxRate, maxChangeRate *sdk.Dec) error {
+	val, err := k.GetPetrichorValidator(ctx, valAddr)
+	if err != nil {
+		return types.ErrValidatorNotFound.Wrapf("validator: %s", valAddr)
+	}
+	_, err = k.ClaimValidatorRewards(ctx, val)
+	if err != nil {
+		return err
+	}
+	// re-query validator since it was updated in `ClaimValidatorRewards`
+	val, _ = k.GetPetrichorValidator(ctx, valAddr)
+
+	if val.PetrichorCommission == nil {
+		if maxRate == nil || maxChangeRate == nil {
+			return status.Errorf(codes.InvalidArgument, "max rate and max change rate must be set with the first petrichor commission")
+		}
+		commission := stakingtypes.NewCommissionWithTime(rate, *maxRate, *maxChangeRate, ctx.BlockTime())
+		if err = commission.Validate(); err != nil {
+			return err
+		}
+		val.PetrichorCommission = &commission
+	} else {
+		if maxRate != nil || maxChangeRate != nil {
+			return status.Errorf(codes.InvalidArgument, "max rate and max change rate of the petrichor commission cannot be changed")
+		}
+		if err = val.PetrichorCommission.ValidateNewRate(rate, ctx.BlockTime()); err != nil {
+			return err
+		}
+		val.PetrichorCommission.Rate = rate
+		val.PetrichorCommission.UpdateTime = ctx.BlockTime()
+	}
+	k.SetValidator(ctx, val)
+	return nil
+}
+
+// WithdrawValidatorCommission pays the accumulated petrichor commission of the validator to its operator account
+func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, valAddr sdk.ValAddress) (sdk.Coins, error) {
+	info, found := k.GetPetrichorValidatorInfo(ctx, valAddr)
+	if !found || info.AccumulatedCommission.IsZero() {
+		return nil, types.ErrNoValidatorCommission.Wrapf("validator: %s", valAddr)
+	}
+	commission := info.AccumulatedCommission
+	info.AccumulatedCommission = sdk.NewCoins()
+	k.SetValidatorInfo(ctx, valAddr, info)
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, sdk.AccAddress(valAddr), commission)
+	if err != nil {
+		return nil, err
+	}
+	return commission, nil
+}
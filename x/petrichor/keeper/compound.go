@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// SetAutoCompound turns auto-compounding on or off for an existing delegation
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, validator types.PetrichorValidator, denom string, enabled bool) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetAutoCompoundKey(delAddr, validator.GetOperator(), denom)
+	if !enabled {
+		store.Delete(key)
+		return nil
+	}
+	_, found := k.GetDelegation(ctx, delAddr, validator, denom)
+	if !found {
+		return stakingtypes.ErrNoDelegatorForAddress
+	}
+	store.Set(key, []byte{})
+	return nil
+}
+
+func (k Keeper) IsAutoCompound(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetAutoCompoundKey(delAddr, valAddr, denom))
+}
+
+func (k Keeper) setAutoCompoundDelegation(ctx sdk.Context, d types.AutoCompoundDelegation) {
+	delAddr, err := sdk.AccAddressFromBech32(d.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetAutoCompoundKey(delAddr, valAddr, d.Denom), []byte{})
+}
+
+// IterateAutoCompoundDelegations goes through the delegations that have auto-compounding turned on
+func (k Keeper) IterateAutoCompoundDelegations(ctx sdk.Context, cb func(d types.AutoCompoundDelegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.AutoCompoundKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr, valAddr, denom := types.ParseAutoCompoundKey(iter.Key())
+		if cb(types.AutoCompoundDelegation{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			Denom:            denom,
+		}) {
+			return
+		}
+	}
+}
+
+// AutoCompoundHook re-delegates the rewards of auto-compounding delegations once every auto_compound_interval.
+// At most MaxAutoCompoundsPerBlock delegations are compounded per block. A round that does not fit in one block
+// keeps a cursor and continues in the next blocks before the round is marked as done.
+// The cursor moves past a position whether or not it could be compounded. A failed position emits an event and is
+// retried in the next round
+func (k Keeper) AutoCompoundHook(ctx sdk.Context) {
+	last := k.LastAutoCompoundTime(ctx)
+	interval := k.AutoCompoundInterval(ctx)
+	if ctx.BlockTime().Before(last.Add(interval)) {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	start := store.Get(types.AutoCompoundCursorKey)
+	if start == nil {
+		start = types.AutoCompoundKey
+	}
+	var keys [][]byte
+	var positions []types.AutoCompoundDelegation
+	hasMore := false
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AutoCompoundKey))
+	for ; iter.Valid(); iter.Next() {
+		if len(positions) >= types.MaxAutoCompoundsPerBlock {
+			hasMore = true
+			break
+		}
+		delAddr, valAddr, denom := types.ParseAutoCompoundKey(iter.Key())
+		keys = append(keys, iter.Key())
+		positions = append(positions, types.AutoCompoundDelegation{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			Denom:            denom,
+		})
+	}
+	iter.Close()
+
+	for i, d := range positions {
+		// Each position is compounded in isolation so that a failing position does not halt the others
+		cacheCtx, write := ctx.CacheContext()
+		compounded, err := k.autoCompoundDelegation(cacheCtx, d)
+		// The smallest key after the position, so that the next block starts right after it
+		store.Set(types.AutoCompoundCursorKey, append(append([]byte{}, keys[i]...), 0x00))
+		if err != nil {
+			k.Logger(ctx).Error("failed to auto-compound petrichor delegation",
+				"delegator", d.DelegatorAddress, "validator", d.ValidatorAddress, "denom", d.Denom, "error", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeAutoCompoundFailed,
+				sdk.NewAttribute(types.AttributeKeyDelegator, d.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, d.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDenom, d.Denom),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		if compounded.IsZero() {
+			continue
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyDelegator, d.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, d.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, compounded.String()),
+		))
+	}
+
+	if hasMore {
+		return
+	}
+	store.Delete(types.AutoCompoundCursorKey)
+	k.SetLastAutoCompoundTime(ctx, ctx.BlockTime())
+}
+
+// autoCompoundDelegation claims the rewards of a delegation and re-delegates the rewards paid in the delegated denom.
+// Rewards in other denoms are paid out to the reward withdraw address the same way ClaimDelegationRewards does
+func (k Keeper) autoCompoundDelegation(ctx sdk.Context, d types.AutoCompoundDelegation) (sdk.Coin, error) {
+	delAddr, err := sdk.AccAddressFromBech32(d.DelegatorAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	validator, err := k.GetPetrichorValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	_, found := k.GetDelegation(ctx, delAddr, validator, d.Denom)
+	if !found {
+		// The delegation was removed, so the setting is stale
+		ctx.KVStore(k.storeKey).Delete(types.GetAutoCompoundKey(delAddr, valAddr, d.Denom))
+		return sdk.NewCoin(d.Denom, sdk.ZeroInt()), nil
+	}
+
+	// Rewards are claimed to the delegator since they are re-delegated from its account
+	coins, err := k.claimDelegationRewards(ctx, delAddr, validator, d.Denom, delAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	compounded := sdk.NewCoin(d.Denom, coins.AmountOf(d.Denom))
+	if compounded.IsPositive() {
+		_, err = k.Delegate(ctx, delAddr, validator, compounded)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	withdrawAddr := k.GetRewardWithdrawAddress(ctx, delAddr)
+	remaining := coins.Sub(compounded)
+	if !withdrawAddr.Equals(delAddr) && !remaining.IsZero() {
+		err = k.bankKeeper.SendCoins(ctx, delAddr, withdrawAddr, remaining)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+	return compounded, nil
+}
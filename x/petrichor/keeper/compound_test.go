@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	test_helpers "github.com/petrinetwork/petrichor/app"
+	"github.com/petrinetwork/petrichor/x/petrichor"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoCompoundDelegationRewards(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = time.Hour
+	params.LastAutoCompoundTime = startTime
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), startTime),
+		},
+	})
+
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user := addrs[0]
+
+	// Auto-compounding can only be turned on for existing delegations
+	err = app.PetrichorKeeper.SetAutoCompound(ctx, user, val, PETRICHOR_TOKEN_DENOM, true)
+	require.ErrorIs(t, err, stakingtypes.ErrNoDelegatorForAddress)
+
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.SetAutoCompound(ctx, user, val, PETRICHOR_TOKEN_DENOM, true)
+	require.NoError(t, err)
+	require.True(t, app.PetrichorKeeper.IsAutoCompound(ctx, user, valAddr, PETRICHOR_TOKEN_DENOM))
+
+	// Rewards paid in the delegated denom and in another denom
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100_000)),
+		sdk.NewCoin("stake", sdk.NewInt(100_000)),
+	))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100_000)),
+		sdk.NewCoin("stake", sdk.NewInt(100_000)),
+	))
+	require.NoError(t, err)
+
+	// Nothing happens before the interval has passed
+	app.PetrichorKeeper.AutoCompoundHook(ctx.WithBlockTime(startTime.Add(time.Minute)))
+	delegation, _ := app.PetrichorKeeper.GetDelegation(ctx, user, val, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewDec(1000_000), delegation.Shares)
+
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	app.PetrichorKeeper.AutoCompoundHook(ctx)
+	// Events of the compounded position are kept along with its writes
+	transfers := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == banktypes.EventTypeTransfer {
+			transfers++
+		}
+	}
+	require.Positive(t, transfers)
+	val, _ = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	delegation, _ = app.PetrichorKeeper.GetDelegation(ctx, user, val, PETRICHOR_TOKEN_DENOM)
+	asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1100_000)), types.GetDelegationTokens(delegation, val, asset))
+	require.True(t, app.BankKeeper.GetBalance(ctx, user, PETRICHOR_TOKEN_DENOM).IsZero())
+	require.Equal(t, sdk.NewInt(100_000), app.BankKeeper.GetBalance(ctx, user, "stake").Amount)
+	require.Equal(t, ctx.BlockTime(), app.PetrichorKeeper.LastAutoCompoundTime(ctx))
+
+	// Setting is exported and removed when the delegation is gone
+	state := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.AutoCompoundDelegation{
+		{
+			DelegatorAddress: user.String(),
+			ValidatorAddress: valAddr.String(),
+			Denom:            PETRICHOR_TOKEN_DENOM,
+		},
+	}, state.AutoCompoundDelegations)
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1100_000)))
+	require.NoError(t, err)
+	require.False(t, app.PetrichorKeeper.IsAutoCompound(ctx, user, valAddr, PETRICHOR_TOKEN_DENOM))
+}
+
+func TestAutoCompoundFailureEmitsEventAndMovesOn(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.AutoCompoundInterval = time.Hour
+	params.LastAutoCompoundTime = startTime
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), startTime),
+		},
+	})
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins())
+	user := addrs[0]
+
+	// GIVEN an auto-compounding position on a validator that does not exist
+	unknownVal := sdk.ValAddress(addrs[0])
+	store := ctx.KVStore(app.PetrichorKeeper.StoreKey())
+	store.Set(types.GetAutoCompoundKey(user, unknownVal, PETRICHOR_TOKEN_DENOM), []byte{})
+
+	// WHEN the round runs
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	app.PetrichorKeeper.AutoCompoundHook(ctx)
+
+	// THEN a failure event is emitted, the round completes and the position is kept for the next round
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoCompoundFailed {
+			failed = append(failed, event)
+		}
+	}
+	require.Len(t, failed, 1)
+	require.Equal(t, ctx.BlockTime(), app.PetrichorKeeper.LastAutoCompoundTime(ctx))
+	require.False(t, store.Has(types.AutoCompoundCursorKey))
+	require.True(t, app.PetrichorKeeper.IsAutoCompound(ctx, user, unknownVal, PETRICHOR_TOKEN_DENOM))
+}
+
+func TestValidateGenesisRejectsZeroAutoCompoundInterval(t *testing.T) {
+	genesis := petrichor.DefaultGenesisState()
+	require.NoError(t, petrichor.ValidateGenesis(genesis))
+
+	genesis.Params.AutoCompoundInterval = 0
+	require.ErrorIs(t, petrichor.ValidateGenesis(genesis), types.ErrInvalidGenesisState)
+}
@@ -22,17 +22,38 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, validator type
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "asset with denom: %s does not exist in petrichor whitelist", coin.Denom)
 	}
+	err := k.validateNewDelegation(ctx, delAddr, asset, validator, coin, true)
+	if err != nil {
+		return nil, err
+	}
+	err = k.PetrichorHooks().BeforeDelegate(ctx, delAddr, validator.GetOperator(), coin)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check and send delegated tokens into the petrichor module address
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(coin))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.ModuleName, sdk.NewCoins(coin))
 	if err != nil {
 		return nil, err
 	}
 
+	shares, err := k.addDelegationTokens(ctx, delAddr, validator, coin, asset)
+	if err != nil {
+		return nil, err
+	}
+	err = k.PetrichorHooks().AfterDelegate(ctx, delAddr, validator.GetOperator(), coin)
+	if err != nil {
+		return nil, err
+	}
+	return shares, nil
+}
+
+// addDelegationTokens adds tokens that are already held by the petrichor module account to a delegation
+func (k Keeper) addDelegationTokens(ctx sdk.Context, delAddr sdk.AccAddress, validator types.PetrichorValidator, coin sdk.Coin, asset types.PetrichorAsset) (*sdk.Dec, error) {
 	// Claim rewards before adding more to a previous delegation
-	_, found = k.GetDelegation(ctx, delAddr, validator, coin.Denom)
+	_, found := k.GetDelegation(ctx, delAddr, validator, coin.Denom)
 	if found {
-		_, err = k.ClaimDelegationRewards(ctx, delAddr, validator, coin.Denom)
+		_, err := k.ClaimDelegationRewards(ctx, delAddr, validator, coin.Denom)
 		if err != nil {
 			return nil, err
 		}
@@ -57,6 +78,12 @@ func (k Keeper) Delegate(ctx sdk.Context, delAddr sdk.AccAddress, validator type
 
 // Redelegate from one validator to another
 func (k Keeper) Redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types.PetrichorValidator, dstVal types.PetrichorValidator, coin sdk.Coin) (*time.Time, error) {
+	return k.redelegate(ctx, delAddr, srcVal, dstVal, coin, true)
+}
+
+// redelegate moves a delegation between validators. Automatic redelegations away from tombstoned validators skip the
+// transitive redelegation check since the delegator has no other way out
+func (k Keeper) redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types.PetrichorValidator, dstVal types.PetrichorValidator, coin sdk.Coin, checkTransitive bool) (*time.Time, error) {
 	if srcVal.Validator.Equal(dstVal.Validator) {
 		return nil, status.Errorf(codes.InvalidArgument, "Cannot redelegate to the same validator")
 	}
@@ -65,12 +92,16 @@ func (k Keeper) Redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", coin.Denom)
 	}
+	err := k.validateNewDelegation(ctx, delAddr, asset, dstVal, coin, false)
+	if err != nil {
+		return nil, err
+	}
 
 	_, found = k.GetDelegation(ctx, delAddr, srcVal, coin.Denom)
 	if !found {
 		return nil, stakingtypes.ErrNoDelegatorForAddress
 	}
-	_, err := k.ClaimDelegationRewards(ctx, delAddr, srcVal, coin.Denom)
+	_, err = k.ClaimDelegationRewards(ctx, delAddr, srcVal, coin.Denom)
 	if err != nil {
 		return nil, err
 	}
@@ -89,13 +120,21 @@ func (k Keeper) Redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types
 	if err != nil {
 		return nil, err
 	}
+	err = k.checkMinDelegationRemainder(srcDelegation, srcVal, asset, coin.Amount)
+	if err != nil {
+		return nil, err
+	}
 
 	// Prevents transitive re-delegations
 	// e.g. if a redelegation from A -> B is made before another request from B -> C
 	// the latter is blocked until the first redelegation is mature (time > unbonding time)
-	if k.HasRedelegation(ctx, delAddr, srcVal.GetOperator(), coin.Denom) {
+	if checkTransitive && k.HasRedelegation(ctx, delAddr, srcVal.GetOperator(), coin.Denom) {
 		return nil, stakingtypes.ErrTransitiveRedelegation
 	}
+	err = k.PetrichorHooks().BeforeRedelegate(ctx, delAddr, srcVal.GetOperator(), dstVal.GetOperator(), coin)
+	if err != nil {
+		return nil, err
+	}
 
 	completionTime := ctx.BlockHeader().Time.Add(k.stakingKeeper.UnbondingTime(ctx))
 	changedValidatorShares := types.GetValidatorShares(asset, coin.Amount)
@@ -124,6 +163,10 @@ func (k Keeper) Redelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types
 
 	k.QueueAssetRebalanceEvent(ctx)
 
+	err = k.PetrichorHooks().AfterRedelegate(ctx, delAddr, srcVal.GetOperator(), dstVal.GetOperator(), coin)
+	if err != nil {
+		return nil, err
+	}
 	return &completionTime, nil
 }
 
@@ -153,6 +196,14 @@ func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator ty
 	if err != nil {
 		return nil, err
 	}
+	err = k.checkMinDelegationRemainder(delegation, validator, asset, coin.Amount)
+	if err != nil {
+		return nil, err
+	}
+	err = k.PetrichorHooks().BeforeUndelegate(ctx, delAddr, validator.GetOperator(), coin)
+	if err != nil {
+		return nil, err
+	}
 	validatorSharesToRemove := types.GetValidatorShares(asset, coin.Amount)
 
 	// Remove tokens and shares from the petrichor asset
@@ -175,9 +226,163 @@ func (k Keeper) Undelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator ty
 	// Queue undelegation messages to distribute tokens after undelegation completes in the future
 	completionTime := k.queueUndelegation(ctx, delAddr, validator.GetOperator(), coin)
 	k.QueueAssetRebalanceEvent(ctx)
+
+	// A delisted asset is removed with its last undelegation
+	if asset.IsDelisting && asset.TotalTokens.IsZero() {
+		k.DeleteAsset(ctx, asset.Denom)
+	}
+
+	err = k.PetrichorHooks().AfterUndelegate(ctx, delAddr, validator.GetOperator(), coin)
+	if err != nil {
+		return nil, err
+	}
 	return &completionTime, nil
 }
 
+// forceUndelegate moves a whole delegation into the undelegation queue. Unlike Undelegate all delegation shares are
+// removed even if they are worth less than a token so that a delisted asset can be fully wound down
+func (k Keeper) forceUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator types.PetrichorValidator, denom string) (sdk.Coin, error) {
+	asset, found := k.GetAssetByDenom(ctx, denom)
+	if !found {
+		return sdk.Coin{}, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", denom)
+	}
+
+	_, err := k.ClaimDelegationRewards(ctx, delAddr, validator, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	delegation, found := k.GetDelegation(ctx, delAddr, validator, denom)
+	if !found {
+		return sdk.Coin{}, stakingtypes.ErrNoDelegatorForAddress
+	}
+
+	// Tokens are rounded instead of truncated so that precision errors do not leave dust in the asset
+	tokens := sdk.NewCoin(denom, sdk.MinInt(asset.TotalTokens, types.ConvertNewShareToDecToken(
+		validator.TotalDecTokensWithAsset(asset),
+		validator.TotalDelegationSharesWithDenom(denom),
+		delegation.Shares,
+	).RoundInt()))
+	err = k.PetrichorHooks().BeforeUndelegate(ctx, delAddr, validator.GetOperator(), tokens)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	validatorShares := validator.ValidatorSharesWithDenom(denom).
+		Mul(delegation.Shares).
+		Quo(validator.TotalDelegationSharesWithDenom(denom))
+
+	asset.TotalTokens = asset.TotalTokens.Sub(tokens.Amount)
+	asset.TotalValidatorShares = asset.TotalValidatorShares.Sub(validatorShares)
+	if asset.TotalValidatorShares.IsNegative() {
+		asset.TotalValidatorShares = sdk.ZeroDec()
+	}
+	k.SetAsset(ctx, asset)
+
+	k.reduceDelegationShares(ctx, delAddr, validator, tokens, delegation.Shares, delegation)
+	k.updateValidatorShares(
+		ctx,
+		validator,
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, delegation.Shares)),
+		sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, validatorShares)),
+		false,
+	)
+
+	if tokens.IsPositive() {
+		k.queueUndelegation(ctx, delAddr, validator.GetOperator(), tokens)
+	}
+	k.QueueAssetRebalanceEvent(ctx)
+
+	err = k.PetrichorHooks().AfterUndelegate(ctx, delAddr, validator.GetOperator(), tokens)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return tokens, nil
+}
+
+// CancelUndelegation restores part or all of an immature undelegation back into a delegation on the original validator.
+// The undelegated tokens never left the petrichor module account so they are delegated again without a transfer
+func (k Keeper) CancelUndelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator types.PetrichorValidator, coin sdk.Coin, completionTime time.Time) error {
+	asset, found := k.GetAssetByDenom(ctx, coin.Denom)
+	if !found {
+		return status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", coin.Denom)
+	}
+	if !completionTime.After(ctx.BlockTime()) {
+		return types.ErrUnknownUndelegation
+	}
+	err := k.validateNewDelegation(ctx, delAddr, asset, validator, coin, true)
+	if err != nil {
+		return err
+	}
+	err = k.PetrichorHooks().BeforeDelegate(ctx, delAddr, validator.GetOperator(), coin)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	queueKey := types.GetUndelegationQueueKey(completionTime, delAddr)
+	b := store.Get(queueKey)
+	if b == nil {
+		return types.ErrUnknownUndelegation
+	}
+	var queue types.QueuedUndelegation
+	k.cdc.MustUnmarshal(b, &queue)
+
+	// Take the amount out of the matching entries and keep the rest queued
+	remaining := coin.Amount
+	matched := false
+	var entries []*types.Undelegation
+	for _, entry := range queue.Entries {
+		if entry.ValidatorAddress != validator.GetOperator().String() || entry.Balance.Denom != coin.Denom {
+			entries = append(entries, entry)
+			continue
+		}
+		matched = true
+		cancelled := sdk.MinInt(remaining, entry.Balance.Amount)
+		remaining = remaining.Sub(cancelled)
+		entry.Balance = entry.Balance.SubAmount(cancelled)
+		if entry.Balance.IsPositive() {
+			entries = append(entries, entry)
+		}
+	}
+	if !matched {
+		return types.ErrUnknownUndelegation
+	}
+	if remaining.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "Cannot cancel more than the undelegated amount of %s", coin.Denom)
+	}
+
+	// Drop the validator and denom indexes if no entry is left for them
+	stillQueued := false
+	denomQueued := false
+	for _, entry := range entries {
+		if entry.Balance.Denom != coin.Denom {
+			continue
+		}
+		denomQueued = true
+		if entry.ValidatorAddress == validator.GetOperator().String() {
+			stillQueued = true
+			break
+		}
+	}
+	if !stillQueued {
+		store.Delete(types.GetUnbondingIndexKey(validator.GetOperator(), completionTime, coin.Denom, delAddr))
+	}
+	if !denomQueued {
+		store.Delete(types.GetUndelegationByDenomIndexKey(coin.Denom, completionTime, delAddr))
+	}
+	if len(entries) == 0 {
+		k.deleteQueuedUndelegations(ctx, completionTime, delAddr, queue)
+	} else {
+		queue.Entries = entries
+		k.setQueuedUndelegations(ctx, completionTime, delAddr, queue)
+	}
+
+	_, err = k.addDelegationTokens(ctx, delAddr, validator, coin, asset)
+	if err != nil {
+		return err
+	}
+	return k.PetrichorHooks().AfterDelegate(ctx, delAddr, validator.GetOperator(), coin)
+}
+
 // CompleteRedelegations Go through the re-delegations queue and remove all that have passed the completion time
 func (k Keeper) CompleteRedelegations(ctx sdk.Context) int {
 	store := ctx.KVStore(k.storeKey)
@@ -209,8 +414,9 @@ func (k Keeper) CompleteUndelegations(ctx sdk.Context) error {
 			return err
 		}
 		k.cdc.MustUnmarshal(iter.Value(), &queued)
+		var delAddr sdk.AccAddress
 		for _, undel := range queued.Entries {
-			delAddr, err := sdk.AccAddressFromBech32(undel.DelegatorAddress)
+			delAddr, err = sdk.AccAddressFromBech32(undel.DelegatorAddress)
 			if err != nil {
 				return err
 			}
@@ -218,14 +424,12 @@ func (k Keeper) CompleteUndelegations(ctx sdk.Context) error {
 			if err != nil {
 				return err
 			}
-			valAddr, err := sdk.ValAddressFromBech32(undel.ValidatorAddress)
-			if err != nil {
-				return err
-			}
-			indexKey := types.GetUnbondingIndexKey(valAddr, completionTime, undel.Balance.Denom, delAddr)
-			store.Delete(indexKey)
 		}
-		store.Delete(iter.Key())
+		if delAddr == nil {
+			store.Delete(iter.Key())
+			continue
+		}
+		k.deleteQueuedUndelegations(ctx, completionTime, delAddr, queued)
 	}
 
 	// Burn all "virtual" staking tokens in the module account
@@ -253,7 +457,10 @@ func (k Keeper) GetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator
 func (k Keeper) SetDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, del types.Delegation) {
 	key := types.GetDelegationKey(delAddr, valAddr, denom)
 	b := k.cdc.MustMarshal(&del)
-	ctx.KVStore(k.storeKey).Set(key, b)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, b)
+	store.Set(types.GetDelegationByValidatorIndexKey(valAddr, denom, delAddr), key)
+	store.Set(types.GetDelegationByDenomIndexKey(denom, valAddr, delAddr), key)
 }
 
 func (k Keeper) DeleteRedelegation(ctx sdk.Context, redel types.Redelegation, completion time.Time) {
@@ -274,6 +481,7 @@ func (k Keeper) DeleteRedelegation(ctx sdk.Context, redel types.Redelegation, co
 	store.Delete(key)
 	indexKey := types.GetRedelegationIndexKey(srcValAddr, completion, redel.Balance.Denom, dstValAddr, delAddr)
 	store.Delete(indexKey)
+	store.Delete(types.GetRedelegationByDenomIndexKey(redel.Balance.Denom, delAddr, dstValAddr, completion))
 }
 
 func (k Keeper) IterateDelegations(ctx sdk.Context, cb func(d types.Delegation) (stop bool)) {
@@ -289,6 +497,53 @@ func (k Keeper) IterateDelegations(ctx sdk.Context, cb func(d types.Delegation)
 	}
 }
 
+// IterateDelegationsByValidator iterates over the delegations to the validator in all denoms
+func (k Keeper) IterateDelegationsByValidator(ctx sdk.Context, valAddr sdk.ValAddress, cb func(d types.Delegation) (stop bool)) {
+	k.iterateDelegationsByValidatorIndex(ctx, valAddr, types.GetDelegationsByValidatorIndexKey(valAddr), cb)
+}
+
+// IterateDelegationsByValidatorAndDenom iterates over the delegations to the validator in the denom
+func (k Keeper) IterateDelegationsByValidatorAndDenom(ctx sdk.Context, valAddr sdk.ValAddress, denom string, cb func(d types.Delegation) (stop bool)) {
+	k.iterateDelegationsByValidatorIndex(ctx, valAddr, types.GetDelegationsByValidatorAndDenomIndexKey(valAddr, denom), cb)
+}
+
+// IterateDelegationsByDenom iterates over the delegations in the denom across all validators
+func (k Keeper) IterateDelegationsByDenom(ctx sdk.Context, denom string, cb func(d types.Delegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetDelegationsByDenomIndexKey(denom))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		b := store.Get(iter.Value())
+		if b == nil {
+			continue
+		}
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(b, &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
+func (k Keeper) iterateDelegationsByValidatorIndex(ctx sdk.Context, valAddr sdk.ValAddress, indexPrefix []byte, cb func(d types.Delegation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	valPrefixLen := len(types.GetDelegationsByValidatorIndexKey(valAddr))
+	iter := sdk.KVStorePrefixIterator(store, indexPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		denom, delAddr := types.ParseDelegationByValidatorIndexSuffix(iter.Key()[valPrefixLen:])
+		b := store.Get(types.GetDelegationKey(delAddr, valAddr, denom))
+		if b == nil {
+			continue
+		}
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(b, &delegation)
+		if cb(delegation) {
+			break
+		}
+	}
+}
+
 func (k Keeper) HasRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, dstVal sdk.ValAddress, denom string) bool {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetRedelegationsKey(delAddr, denom, dstVal)
@@ -380,6 +635,64 @@ func (k Keeper) ValidateDelegatedAmount(delegation types.Delegation, coin sdk.Co
 	return delegationSharesToUpdate, nil
 }
 
+// validateNewDelegation checks that the asset still accepts tokens for the validator, either new tokens from a
+// delegation or existing ones moved by a redelegation
+func (k Keeper) validateNewDelegation(ctx sdk.Context, delAddr sdk.AccAddress, asset types.PetrichorAsset, validator types.PetrichorValidator, coin sdk.Coin, newTokens bool) error {
+	if asset.IsDelisting {
+		return types.ErrAssetDelisting.Wrapf("denom: %s", coin.Denom)
+	}
+	if !validator.AcceptsDenom(coin.Denom) {
+		return types.ErrDenomNotAccepted.Wrapf("validator: %s denom: %s", validator.GetOperator(), coin.Denom)
+	}
+	err := k.checkAssetCaps(asset, validator, coin.Amount, newTokens)
+	if err != nil {
+		return err
+	}
+	return k.checkMinDelegation(ctx, delAddr, validator, asset, coin.Amount)
+}
+
+// checkMinDelegation makes sure that the delegation holds at least the minimum delegation amount of the asset once
+// amount is added to it. Existing delegations can be topped up by any amount as long as the total meets the minimum
+func (k Keeper) checkMinDelegation(ctx sdk.Context, delAddr sdk.AccAddress, validator types.PetrichorValidator, asset types.PetrichorAsset, amount math.Int) error {
+	if !asset.HasMinDelegationAmount() {
+		return nil
+	}
+	tokens := amount
+	if delegation, found := k.GetDelegation(ctx, delAddr, validator, asset.Denom); found {
+		tokens = tokens.Add(types.GetDelegationTokens(delegation, validator, asset).Amount)
+	}
+	if asset.IsBelowMinDelegation(tokens) {
+		return types.ErrBelowMinDelegation.Wrapf("delegation of %s%s is less than %s%s", tokens, asset.Denom, asset.MinDelegationAmount, asset.Denom)
+	}
+	return nil
+}
+
+// checkMinDelegationRemainder makes sure that removing amount from the delegation either empties it or leaves at
+// least the minimum delegation amount of the asset
+func (k Keeper) checkMinDelegationRemainder(delegation types.Delegation, validator types.PetrichorValidator, asset types.PetrichorAsset, amount math.Int) error {
+	if !asset.HasMinDelegationAmount() {
+		return nil
+	}
+	remainder := types.GetDelegationTokens(delegation, validator, asset).Amount.Sub(amount)
+	if asset.IsBelowMinDelegation(remainder) {
+		return types.ErrBelowMinDelegation.Wrapf("remaining delegation of %s%s is less than %s%s, undelegate everything instead", remainder, asset.Denom, asset.MinDelegationAmount, asset.Denom)
+	}
+	return nil
+}
+
+// checkAssetCaps makes sure that delegating amount to the validator stays within the optional caps of the asset.
+// newTokens is false for redelegations since they do not change the total tokens of the asset
+func (k Keeper) checkAssetCaps(asset types.PetrichorAsset, validator types.PetrichorValidator, amount math.Int, newTokens bool) error {
+	if newTokens && asset.HasMaxTotalTokens() && amount.GT(asset.RemainingTotalTokens()) {
+		return types.ErrMaxTotalTokens.Wrapf("%s can only receive %s more", asset.Denom, asset.RemainingTotalTokens())
+	}
+	remaining := asset.RemainingValidatorTokens(validator.TotalDecTokensWithAsset(asset), newTokens)
+	if !remaining.IsNil() && amount.GT(remaining) {
+		return types.ErrMaxValidatorShare.Wrapf("%s can only receive %s%s more", validator.GetOperator(), remaining, asset.Denom)
+	}
+	return nil
+}
+
 // addRedelegation adds a redelegation entry to be used to prevent premature re-delegations
 func (k Keeper) addRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, srcVal sdk.ValAddress, dstVal sdk.ValAddress, coin sdk.Coin, completionTime time.Time) {
 	store := ctx.KVStore(k.storeKey)
@@ -400,9 +713,10 @@ func (k Keeper) addRedelegation(ctx sdk.Context, delAddr sdk.AccAddress, srcVal
 	b = k.cdc.MustMarshal(&redelegation)
 	store.Set(key, b)
 
-	// Add another entry as an index to retrieve redelegations by validator
+	// Add other entries as indexes to retrieve redelegations by validator and by denom
 	indexKey := types.GetRedelegationIndexKey(srcVal, completionTime, coin.Denom, dstVal, delAddr)
 	store.Set(indexKey, []byte{})
+	store.Set(types.GetRedelegationByDenomIndexKey(coin.Denom, delAddr, dstVal, completionTime), []byte{})
 	k.queueRedelegation(ctx, delAddr, srcVal, dstVal, coin, completionTime)
 }
 
@@ -469,11 +783,31 @@ func (k Keeper) queueUndelegation(ctx sdk.Context, delAddr sdk.AccAddress, val s
 	return completionTime
 }
 
+// setQueuedUndelegations stores the queued undelegations of the delegator along with the delegator and denom indexes
 func (k Keeper) setQueuedUndelegations(ctx sdk.Context, completionTime time.Time, delAddr sdk.AccAddress, queuedDelegations types.QueuedUndelegation) {
 	store := ctx.KVStore(k.storeKey)
 	queueKey := types.GetUndelegationQueueKey(completionTime, delAddr)
 	b := k.cdc.MustMarshal(&queuedDelegations)
 	store.Set(queueKey, b)
+	store.Set(types.GetUndelegationByDelegatorIndexKey(delAddr, completionTime), []byte{})
+	for _, entry := range queuedDelegations.Entries {
+		store.Set(types.GetUndelegationByDenomIndexKey(entry.Balance.Denom, completionTime, delAddr), []byte{})
+	}
+}
+
+// deleteQueuedUndelegations removes the queued undelegations of the delegator and the indexes that point to them
+func (k Keeper) deleteQueuedUndelegations(ctx sdk.Context, completionTime time.Time, delAddr sdk.AccAddress, queuedDelegations types.QueuedUndelegation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetUndelegationQueueKey(completionTime, delAddr))
+	store.Delete(types.GetUndelegationByDelegatorIndexKey(delAddr, completionTime))
+	for _, entry := range queuedDelegations.Entries {
+		valAddr, err := sdk.ValAddressFromBech32(entry.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		store.Delete(types.GetUnbondingIndexKey(valAddr, completionTime, entry.Balance.Denom, delAddr))
+		store.Delete(types.GetUndelegationByDenomIndexKey(entry.Balance.Denom, completionTime, delAddr))
+	}
 }
 
 func (k Keeper) setUnbondingIndexByVal(ctx sdk.Context, valAddr sdk.ValAddress, completionTime time.Time, delAddr sdk.AccAddress, denom string) {
@@ -502,6 +836,9 @@ func (k Keeper) reduceDelegationShares(ctx sdk.Context, delAddr sdk.AccAddress,
 		store := ctx.KVStore(k.storeKey)
 		key := types.GetDelegationKey(delAddr, validator.GetOperator(), coin.Denom)
 		store.Delete(key)
+		store.Delete(types.GetDelegationByValidatorIndexKey(validator.GetOperator(), coin.Denom, delAddr))
+		store.Delete(types.GetDelegationByDenomIndexKey(coin.Denom, validator.GetOperator(), delAddr))
+		store.Delete(types.GetAutoCompoundKey(delAddr, validator.GetOperator(), coin.Denom))
 	} else {
 		k.SetDelegation(ctx, delAddr, validator.GetOperator(), coin.Denom, delegation)
 	}
@@ -10,6 +10,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
@@ -643,6 +644,81 @@ func TestSuccessfulUndelegation(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCancelUndelegation(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, _ := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	delAddr := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))[0]
+
+	_, err = app.PetrichorKeeper.Delegate(ctx, delAddr, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	completionTime, err := app.PetrichorKeeper.Undelegate(ctx, delAddr, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+
+	// Cannot cancel more than what is undelegating or an unknown undelegation
+	err = app.PetrichorKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(600_000)), *completionTime)
+	require.Error(t, err)
+	err = app.PetrichorKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100_000)), completionTime.Add(time.Second))
+	require.ErrorIs(t, err, types.ErrUnknownUndelegation)
+
+	// Partially cancel the undelegation
+	err = app.PetrichorKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(200_000)), *completionTime)
+	require.NoError(t, err)
+	asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(700_000), asset.TotalTokens)
+	val, _ = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	delegation, _ := app.PetrichorKeeper.GetDelegation(ctx, delAddr, val, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(700_000)), types.GetDelegationTokens(delegation, val, asset))
+	require.Equal(t, sdk.NewDec(700_000), val.TotalDelegationSharesWithDenom(PETRICHOR_TOKEN_DENOM))
+
+	var undelegations []types.Undelegation
+	app.PetrichorKeeper.IterateUndelegations(ctx, func(u types.QueuedUndelegation, _ time.Time) bool {
+		for _, e := range u.Entries {
+			undelegations = append(undelegations, *e)
+		}
+		return false
+	})
+	require.Equal(t, []types.Undelegation{
+		{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+			Balance:          sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(300_000)),
+		},
+	}, undelegations)
+	iter := app.PetrichorKeeper.IterateUndelegationsBySrcValidator(ctx, valAddr)
+	require.True(t, iter.Valid())
+	iter.Close()
+
+	// Cancelling the rest removes the queue entry and the validator index
+	err = app.PetrichorKeeper.CancelUndelegation(ctx, delAddr, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(300_000)), *completionTime)
+	require.NoError(t, err)
+	iter = app.PetrichorKeeper.IterateUndelegationsBySrcValidator(ctx, valAddr)
+	require.False(t, iter.Valid())
+	iter.Close()
+	iter = app.PetrichorKeeper.IterateUndelegationsByCompletionTime(ctx, completionTime.Add(time.Second))
+	require.False(t, iter.Valid())
+	iter.Close()
+	asset, _ = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(1000_000), asset.TotalTokens)
+
+	// Completing undelegations pays nothing out
+	ctx = ctx.WithBlockTime(completionTime.Add(time.Minute))
+	err = app.PetrichorKeeper.CompleteUndelegations(ctx)
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, delAddr, PETRICHOR_TOKEN_DENOM).IsZero())
+}
+
 func TestUndelegationWithoutDelegation(t *testing.T) {
 	app, ctx := createTestContext(t)
 	ctx = ctx.WithBlockTime(time.Now())
@@ -923,3 +999,469 @@ func TestDelegationWithNativeStakingChanges(t *testing.T) {
 	// Check total bonded tokens
 	require.Equal(t, sdk.NewInt(26_000_000), app.StakingKeeper.TotalBondedTokens(ctx))
 }
+
+func TestDelegationCaps(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	asset := types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime)
+	maxTotalTokens := sdk.NewInt(1000_000)
+	maxValidatorShare := sdk.MustNewDecFromStr("0.6")
+	asset.MaxTotalTokens = &maxTotalTokens
+	asset.MaxValidatorShare = &maxValidatorShare
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{asset},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.PetrichorKeeper)
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(2000_000)),
+	))
+	user := addrs[1]
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0]))
+	getVal := func(valAddr sdk.ValAddress) types.PetrichorValidator {
+		val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return val
+	}
+
+	// 60% of the total cap can be delegated to a single validator
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(600_001)))
+	require.ErrorIs(t, err, types.ErrMaxValidatorShare)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(600_000)))
+	require.NoError(t, err)
+
+	// The total cap applies across validators
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.ErrorIs(t, err, types.ErrMaxTotalTokens)
+	res, err := queryServer.PetrichorCapacity(ctx, &types.QueryPetrichorCapacityRequest{
+		Denom:         PETRICHOR_TOKEN_DENOM,
+		ValidatorAddr: valAddr2.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(400_000), *res.RemainingTotalTokens)
+	require.Equal(t, sdk.NewInt(400_000), *res.RemainingValidatorTokens)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(400_000)))
+	require.NoError(t, err)
+
+	// Redelegations are checked against the destination validator cap
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, getVal(valAddr2), getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.ErrorIs(t, err, types.ErrMaxValidatorShare)
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, getVal(valAddr1), getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100_000)))
+	require.NoError(t, err)
+
+	// Without a total cap the validator share is measured against the total tokens after the delegation
+	err = app.PetrichorKeeper.UpdatePetrichor(ctx, &types.MsgUpdatePetrichorProposal{
+		Denom:             PETRICHOR_TOKEN_DENOM,
+		RewardWeight:      sdk.NewDec(1),
+		TakeRate:          sdk.ZeroDec(),
+		RewardChangeRate:  sdk.OneDec(),
+		MaxValidatorShare: &maxValidatorShare,
+	})
+	require.NoError(t, err)
+	res, err = queryServer.PetrichorCapacity(ctx, &types.QueryPetrichorCapacityRequest{
+		Denom:         PETRICHOR_TOKEN_DENOM,
+		ValidatorAddr: valAddr1.String(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, res.RemainingTotalTokens)
+	// (0.6 * 1,000,000 - 500,000) / 0.4
+	require.Equal(t, sdk.NewInt(250_000), *res.RemainingValidatorTokens)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(250_001)))
+	require.ErrorIs(t, err, types.ErrMaxValidatorShare)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(250_000)))
+	require.NoError(t, err)
+}
+
+func TestMinDelegationAmount(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	asset := types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime)
+	minDelegationAmount := sdk.NewInt(1000)
+	asset.MinDelegationAmount = &minDelegationAmount
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{asset},
+	})
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user := addrs[1]
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0]))
+	getVal := func(valAddr sdk.ValAddress) types.PetrichorValidator {
+		val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return val
+	}
+
+	// New delegations must hold at least the minimum while existing ones can be topped up by any amount
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(999)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1500)))
+	require.NoError(t, err)
+
+	// Partial undelegations and redelegations cannot leave dust behind
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(2000)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, getVal(valAddr1), getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(2000)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+
+	// The destination of a redelegation must also end up above the minimum
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, getVal(valAddr1), getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500)))
+	require.ErrorIs(t, err, types.ErrBelowMinDelegation)
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, getVal(valAddr1), getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1500)))
+	require.NoError(t, err)
+
+	// Undelegating everything is always allowed
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+	_, found := app.PetrichorKeeper.GetDelegation(ctx, user, getVal(valAddr1), PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+}
+
+func TestValidatorDenomFilter(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+			types.NewPetrichorAsset(PETRICHOR_2_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user := addrs[1]
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0]))
+	getVal := func(valAddr sdk.ValAddress) types.PetrichorValidator {
+		val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return val
+	}
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+
+	// An allowlist only accepts the listed denoms for new delegations and redelegations
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	_, err = msgServer.SetValidatorDenomFilter(ctx, &types.MsgSetValidatorDenomFilter{
+		ValidatorAddress: valAddr2.String(),
+		Mode:             types.DenomFilterModeAllowlist,
+		Denoms:           []string{PETRICHOR_TOKEN_DENOM},
+	})
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr2), sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.ErrorIs(t, err, types.ErrDenomNotAccepted)
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, getVal(valAddr1), getVal(valAddr2), sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(500)))
+	require.ErrorIs(t, err, types.ErrDenomNotAccepted)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+
+	// Existing delegations are grandfathered by default
+	err = app.PetrichorKeeper.SetDenomFilter(ctx, valAddr1, types.DenomFilterModeDenylist, []string{PETRICHOR_2_TOKEN_DENOM}, types.OptOutPolicyGrandfather)
+	require.NoError(t, err)
+	app.PetrichorKeeper.ProcessValidatorOptOuts(ctx)
+	_, found := app.PetrichorKeeper.GetDelegation(ctx, user, getVal(valAddr1), PETRICHOR_2_TOKEN_DENOM)
+	require.True(t, found)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.ErrorIs(t, err, types.ErrDenomNotAccepted)
+
+	// Or undelegated at the end of the block when the validator asks for it
+	err = app.PetrichorKeeper.SetDenomFilter(ctx, valAddr1, types.DenomFilterModeDenylist, []string{PETRICHOR_2_TOKEN_DENOM}, types.OptOutPolicyUndelegate)
+	require.NoError(t, err)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.PetrichorKeeper.ProcessValidatorOptOuts(ctx)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user, getVal(valAddr1), PETRICHOR_2_TOKEN_DENOM)
+	require.False(t, found)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user, getVal(valAddr1), PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	undelegations := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeForceUndelegate {
+			undelegations++
+		}
+	}
+	require.Equal(t, 1, undelegations)
+
+	// The validator leaves the queue once nothing is left to undelegate and its filter is exported
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetValidatorOptOutQueueKey(valAddr1)))
+	info, found := app.PetrichorKeeper.GetPetrichorValidatorInfo(ctx, valAddr1)
+	require.True(t, found)
+	require.Equal(t, types.DenomFilterModeDenylist, info.DenomFilterMode)
+	require.Equal(t, []string{PETRICHOR_2_TOKEN_DENOM}, info.FilteredDenoms)
+
+	// A failed undelegation does not hold back the others and is retried in a limited number of passes
+	broken := types.NewDelegation(ctx, addrs[0], valAddr1, PETRICHOR_2_TOKEN_DENOM, sdk.OneDec(), nil)
+	broken.DelegatorAddress = "invalid"
+	app.PetrichorKeeper.SetDelegation(ctx, addrs[0], valAddr1, PETRICHOR_2_TOKEN_DENOM, broken)
+	err = app.PetrichorKeeper.SetDenomFilter(ctx, valAddr1, types.DenomFilterModeNone, nil, types.OptOutPolicyGrandfather)
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.SetDenomFilter(ctx, valAddr1, types.DenomFilterModeDenylist, []string{PETRICHOR_2_TOKEN_DENOM}, types.OptOutPolicyUndelegate)
+	require.NoError(t, err)
+	for pass := 1; pass <= types.MaxWindDownPasses; pass++ {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		app.PetrichorKeeper.ProcessValidatorOptOuts(ctx)
+		failures := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeOptOutUndelegateFailed {
+				failures++
+			}
+		}
+		require.Equal(t, 1, failures)
+		_, found = app.PetrichorKeeper.GetDelegation(ctx, user, getVal(valAddr1), PETRICHOR_2_TOKEN_DENOM)
+		require.False(t, found)
+		require.Equal(t, pass < types.MaxWindDownPasses, store.Has(types.GetValidatorOptOutQueueKey(valAddr1)))
+	}
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user, getVal(valAddr1), PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+
+	// Denoms cannot be set without a filter
+	err = (&types.MsgSetValidatorDenomFilter{
+		ValidatorAddress: valAddr1.String(),
+		Denoms:           []string{PETRICHOR_TOKEN_DENOM},
+	}).ValidateBasic()
+	require.Error(t, err)
+}
+
+func TestAutoRedelegateTombstoned(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.AutoRedelegateTombstoned = true
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 4, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[2]
+	user2 := addrs[3]
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	valAddr3 := sdk.ValAddress(addrs[1])
+	pubKeys := test_helpers.CreateTestPubKeys(2)
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, pubKeys[0]))
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr3, pubKeys[1]))
+	getVal := func(valAddr sdk.ValAddress) types.PetrichorValidator {
+		val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return val
+	}
+
+	// user1 moved into validator 1 with a redelegation and falls back to validator 2
+	_, err = app.PetrichorKeeper.Delegate(ctx, user1, getVal(valAddr3), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user1, getVal(valAddr3), getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	_, err = msgServer.SetFallbackValidator(ctx, &types.MsgSetFallbackValidator{
+		DelegatorAddress: user1.String(),
+		ValidatorAddress: valAddr2.String(),
+	})
+	require.NoError(t, err)
+
+	// user2 has no fallback
+	_, err = app.PetrichorKeeper.Delegate(ctx, user2, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1001)))
+	require.NoError(t, err)
+
+	asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+
+	// Tombstone validator 1 and let it begin unbonding
+	consAddr1, err := getVal(valAddr1).GetConsAddr()
+	require.NoError(t, err)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr1, slashingtypes.NewValidatorSigningInfo(consAddr1, 0, 0, time.Unix(0, 0), false, 0))
+	app.SlashingKeeper.Jail(ctx, consAddr1)
+	app.SlashingKeeper.Tombstone(ctx, consAddr1)
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.PetrichorKeeper.ProcessTombstonedValidators(ctx)
+
+	// user1 moved to the fallback despite the transitive redelegation, leaving only rounding dust behind
+	asset, _ = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	delegation, found := app.PetrichorKeeper.GetDelegation(ctx, user1, getVal(valAddr1), PETRICHOR_TOKEN_DENOM)
+	if found {
+		require.True(t, types.GetDelegationTokens(delegation, getVal(valAddr1), asset).Amount.IsZero())
+	}
+	delegation, found = app.PetrichorKeeper.GetDelegation(ctx, user1, getVal(valAddr2), PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	require.InDelta(t, 1000, types.GetDelegationTokens(delegation, getVal(valAddr2), asset).Amount.Int64(), 1)
+
+	// user2 is spread across the remaining active validators
+	delegation, found = app.PetrichorKeeper.GetDelegation(ctx, user2, getVal(valAddr1), PETRICHOR_TOKEN_DENOM)
+	if found {
+		require.True(t, types.GetDelegationTokens(delegation, getVal(valAddr1), asset).Amount.IsZero())
+	}
+	delegation, found = app.PetrichorKeeper.GetDelegation(ctx, user2, getVal(valAddr2), PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	tokens2 := types.GetDelegationTokens(delegation, getVal(valAddr2), asset).Amount
+	delegation, found = app.PetrichorKeeper.GetDelegation(ctx, user2, getVal(valAddr3), PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	tokens3 := types.GetDelegationTokens(delegation, getVal(valAddr3), asset).Amount
+	require.InDelta(t, 1001, tokens2.Add(tokens3).Int64(), 2)
+
+	redelegations := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeAutoRedelegate {
+			redelegations++
+		}
+	}
+	require.Equal(t, 3, redelegations)
+
+	// The validator leaves the queue once nothing is left to move and the fallbacks are exported
+	app.PetrichorKeeper.ProcessTombstonedValidators(ctx)
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.False(t, store.Has(types.GetTombstonedValidatorQueueKey(valAddr1)))
+	genesis := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.True(t, genesis.Params.AutoRedelegateTombstoned)
+	require.Equal(t, []types.DelegatorFallbackValidator{{
+		DelegatorAddress: user1.String(),
+		ValidatorAddress: valAddr2.String(),
+	}}, genesis.FallbackValidators)
+	require.NoError(t, petrichor.ValidateGenesis(genesis))
+
+	// An empty validator clears the fallback
+	_, err = msgServer.SetFallbackValidator(ctx, &types.MsgSetFallbackValidator{
+		DelegatorAddress: user1.String(),
+	})
+	require.NoError(t, err)
+	_, found = app.PetrichorKeeper.GetDelegatorFallbackValidator(ctx, user1)
+	require.False(t, found)
+}
+
+func TestAutoRedelegateTombstonedLimits(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	params := types.DefaultParams()
+	params.AutoRedelegateTombstoned = true
+	asset := types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime)
+	minDelegationAmount := sdk.NewInt(600)
+	asset.MinDelegationAmount = &minDelegationAmount
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.PetrichorAsset{
+			asset,
+			types.NewPetrichorAsset(PETRICHOR_2_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 4, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+		sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[2]
+	user2 := addrs[3]
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	valAddr3 := sdk.ValAddress(addrs[1])
+	pubKeys := test_helpers.CreateTestPubKeys(2)
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, pubKeys[0]))
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr3, pubKeys[1]))
+	getVal := func(valAddr sdk.ValAddress) types.PetrichorValidator {
+		val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return val
+	}
+
+	// user1 holds too little to give both remaining validators the minimum delegation amount
+	_, err = app.PetrichorKeeper.Delegate(ctx, user1, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1001)))
+	require.NoError(t, err)
+	// user2 delegates an asset that no other validator accepts
+	_, err = app.PetrichorKeeper.Delegate(ctx, user2, getVal(valAddr1), sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000)))
+	require.NoError(t, err)
+	for _, valAddr := range []sdk.ValAddress{valAddr2, valAddr3} {
+		err = app.PetrichorKeeper.SetDenomFilter(ctx, valAddr, types.DenomFilterModeAllowlist, []string{PETRICHOR_TOKEN_DENOM}, types.OptOutPolicyGrandfather)
+		require.NoError(t, err)
+	}
+	// A delegation that cannot be moved
+	broken := types.NewDelegation(ctx, addrs[0], valAddr1, PETRICHOR_TOKEN_DENOM, sdk.NewDec(1000), nil)
+	broken.DelegatorAddress = "invalid"
+	app.PetrichorKeeper.SetDelegation(ctx, addrs[0], valAddr1, PETRICHOR_TOKEN_DENOM, broken)
+
+	// Tombstone validator 1 and let it begin unbonding
+	consAddr1, err := getVal(valAddr1).GetConsAddr()
+	require.NoError(t, err)
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, consAddr1, slashingtypes.NewValidatorSigningInfo(consAddr1, 0, 0, time.Unix(0, 0), false, 0))
+	app.SlashingKeeper.Jail(ctx, consAddr1)
+	app.SlashingKeeper.Tombstone(ctx, consAddr1)
+	_, err = app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.PetrichorKeeper.ProcessTombstonedValidators(ctx)
+	asset, _ = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+
+	// user1 is moved to a single validator so that the new delegation meets the minimum
+	_, found2 := app.PetrichorKeeper.GetDelegation(ctx, user1, getVal(valAddr2), PETRICHOR_TOKEN_DENOM)
+	_, found3 := app.PetrichorKeeper.GetDelegation(ctx, user1, getVal(valAddr3), PETRICHOR_TOKEN_DENOM)
+	require.True(t, found2 != found3)
+	dstVal := getVal(valAddr3)
+	if found2 {
+		dstVal = getVal(valAddr2)
+	}
+	delegation, _ := app.PetrichorKeeper.GetDelegation(ctx, user1, dstVal, PETRICHOR_TOKEN_DENOM)
+	require.InDelta(t, 1001, types.GetDelegationTokens(delegation, dstVal, asset).Amount.Int64(), 1)
+
+	// user2 is undelegated since no validator can take the delegation
+	_, found := app.PetrichorKeeper.GetDelegation(ctx, user2, getVal(valAddr1), PETRICHOR_2_TOKEN_DENOM)
+	require.False(t, found)
+
+	redelegations, undelegations, failures := 0, 0, 0
+	for _, event := range ctx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeAutoRedelegate:
+			redelegations++
+		case types.EventTypeForceUndelegate:
+			undelegations++
+		case types.EventTypeAutoRedelegateFailed:
+			failures++
+		}
+	}
+	require.Equal(t, 1, redelegations)
+	require.Equal(t, 1, undelegations)
+	require.Equal(t, 1, failures)
+
+	// The failed delegation is retried in a limited number of passes before the validator leaves the queue
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	require.True(t, store.Has(types.GetTombstonedValidatorQueueKey(valAddr1)))
+	for pass := 2; pass <= types.MaxWindDownPasses && store.Has(types.GetTombstonedValidatorQueueKey(valAddr1)); pass++ {
+		app.PetrichorKeeper.ProcessTombstonedValidators(ctx)
+	}
+	require.False(t, store.Has(types.GetTombstonedValidatorQueueKey(valAddr1)))
+}
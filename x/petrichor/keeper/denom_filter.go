@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// SetDenomFilter sets which petrichor assets the validator accepts delegations in. Depending on the opt out
+// policy, existing delegations in assets that are no longer accepted are either kept or gradually undelegated
+func (k Keeper) SetDenomFilter(ctx sdk.Context, valAddr sdk.ValAddress, mode types.DenomFilterMode, denoms []string, policy types.OptOutPolicy) error {
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return types.ErrValidatorNotFound.Wrapf("validator: %s", valAddr)
+	}
+	info, found := k.GetPetrichorValidatorInfo(ctx, valAddr)
+	if !found {
+		info = k.createPetrichorValidatorInfo(ctx, valAddr)
+	}
+	info.DenomFilterMode = mode
+	info.FilteredDenoms = denoms
+	info.OptOutPolicy = policy
+	k.SetValidatorInfo(ctx, valAddr, info)
+	k.queueValidatorOptOut(ctx, valAddr, info)
+	return nil
+}
+
+// queueValidatorOptOut queues the validator for ProcessValidatorOptOuts if it rejects some assets and wants existing
+// delegations in them undelegated
+func (k Keeper) queueValidatorOptOut(ctx sdk.Context, valAddr sdk.ValAddress, info types.PetrichorValidatorInfo) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetValidatorOptOutQueueKey(valAddr)
+	if info.OptOutPolicy == types.OptOutPolicyUndelegate && info.DenomFilterMode != types.DenomFilterModeNone {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// ProcessValidatorOptOuts undelegates up to MaxOptOutUndelegationsPerBlock delegations in assets that their validator
+// no longer accepts. A validator leaves the queue once all of its delegations in these assets have been visited, while
+// failed undelegations are retried in up to MaxWindDownPasses passes without holding back the other delegations.
+// Delegations of the liquid staking pool are kept since they back outstanding receipts
+func (k Keeper) ProcessValidatorOptOuts(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var queuedAddrs []sdk.ValAddress
+	iter := sdk.KVStorePrefixIterator(store, types.ValidatorOptOutQueueKey)
+	for ; iter.Valid(); iter.Next() {
+		queuedAddrs = append(queuedAddrs, types.ParsePetrichorValidatorKey(iter.Key()))
+	}
+	iter.Close()
+	if len(queuedAddrs) == 0 {
+		return
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName).String()
+	assets := k.GetAllAssets(ctx)
+	budget := types.MaxOptOutUndelegationsPerBlock
+	for _, valAddr := range queuedAddrs {
+		if budget <= 0 {
+			return
+		}
+		queueKey := types.GetValidatorOptOutQueueKey(valAddr)
+		info, found := k.GetPetrichorValidatorInfo(ctx, valAddr)
+		if !found {
+			store.Delete(queueKey)
+			continue
+		}
+		var indexPrefixes [][]byte
+		for _, asset := range assets {
+			if !info.AcceptsDenom(asset.Denom) {
+				indexPrefixes = append(indexPrefixes, types.GetDelegationsByValidatorAndDenomIndexKey(valAddr, asset.Denom))
+			}
+		}
+		k.windDown(ctx, queueKey, indexPrefixes, &budget, types.EventTypeOptOutUndelegateFailed,
+			func(ctx sdk.Context, d types.Delegation) error {
+				if d.DelegatorAddress == poolAddr {
+					return nil
+				}
+				return k.forceUndelegateAll(ctx, []types.Delegation{d})
+			})
+	}
+}
@@ -16,6 +16,7 @@ func (k Keeper) InitGenesis(ctx sdk.Context, g *types.GenesisState) []abci.Valid
 	for _, val := range g.ValidatorInfos {
 		valAddr, _ := sdk.ValAddressFromBech32(val.ValidatorAddress)
 		k.SetValidatorInfo(ctx, valAddr, val.Validator)
+		k.queueValidatorOptOut(ctx, valAddr, val.Validator)
 	}
 
 	for _, delegation := range g.Delegations {
@@ -50,6 +51,57 @@ func (k Keeper) InitGenesis(ctx sdk.Context, g *types.GenesisState) []abci.Valid
 		k.setRewardWeightChangeSnapshot(ctx, rewardWeightSnapshot.Denom, valAddr, rewardWeightSnapshot.Height, rewardWeightSnapshot.Snapshot)
 	}
 
+	for _, receipt := range g.LiquidReceipts {
+		k.SetLiquidReceipt(ctx, receipt)
+	}
+
+	for _, holder := range g.LiquidReceiptHolders {
+		k.SetLiquidReceiptHolder(ctx, holder)
+	}
+
+	for _, autoCompound := range g.AutoCompoundDelegations {
+		k.setAutoCompoundDelegation(ctx, autoCompound)
+	}
+
+	for _, withdrawAddress := range g.WithdrawAddresses {
+		delAddr := sdk.MustAccAddressFromBech32(withdrawAddress.DelegatorAddress)
+		withdrawAddr := sdk.MustAccAddressFromBech32(withdrawAddress.WithdrawAddress)
+		ctx.KVStore(k.storeKey).Set(types.GetWithdrawAddressKey(delAddr), withdrawAddr.Bytes())
+	}
+
+	for _, accrual := range g.TakeRateAccruals {
+		k.SetTakeRateAccrual(ctx, accrual)
+	}
+
+	for _, record := range g.TakeRateHistory {
+		k.SetTakeRateRecord(ctx, record)
+	}
+
+	for _, record := range g.SlashRecords {
+		k.SetSlashRecord(ctx, record)
+	}
+
+	for _, claim := range g.InsuranceClaims {
+		k.SetInsuranceClaim(ctx, claim)
+	}
+
+	for _, fallback := range g.FallbackValidators {
+		delAddr := sdk.MustAccAddressFromBech32(fallback.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(fallback.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setFallbackValidator(ctx, delAddr, valAddr)
+	}
+
+	for _, val := range g.ArchivedValidatorInfos {
+		valAddr, err := sdk.ValAddressFromBech32(val.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		k.setArchivedValidatorInfo(ctx, valAddr, val.Validator)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -99,10 +151,75 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		return false
 	})
 
+	k.IterateLiquidReceipts(ctx, func(receipt types.LiquidReceipt) (stop bool) {
+		state.LiquidReceipts = append(state.LiquidReceipts, receipt)
+		return false
+	})
+
+	k.IterateLiquidReceiptHolders(ctx, func(h types.LiquidReceiptHolder) (stop bool) {
+		state.LiquidReceiptHolders = append(state.LiquidReceiptHolders, h)
+		return false
+	})
+
+	k.IterateAutoCompoundDelegations(ctx, func(d types.AutoCompoundDelegation) (stop bool) {
+		state.AutoCompoundDelegations = append(state.AutoCompoundDelegations, d)
+		return false
+	})
+
+	k.IterateRewardWithdrawAddresses(ctx, func(delAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) (stop bool) {
+		state.WithdrawAddresses = append(state.WithdrawAddresses, types.DelegatorWithdrawAddress{
+			DelegatorAddress: delAddr.String(),
+			WithdrawAddress:  withdrawAddr.String(),
+		})
+		return false
+	})
+
+	k.IterateTakeRateAccruals(ctx, func(accrual types.TakeRateAccrual) (stop bool) {
+		state.TakeRateAccruals = append(state.TakeRateAccruals, accrual)
+		return false
+	})
+
+	k.IterateTakeRateHistory(ctx, func(record types.TakeRateRecord) (stop bool) {
+		state.TakeRateHistory = append(state.TakeRateHistory, record)
+		return false
+	})
+
+	k.IterateSlashRecords(ctx, func(record types.SlashRecord) (stop bool) {
+		state.SlashRecords = append(state.SlashRecords, record)
+		return false
+	})
+
+	k.IterateInsuranceClaims(ctx, func(claim types.InsuranceClaim) (stop bool) {
+		state.InsuranceClaims = append(state.InsuranceClaims, claim)
+		return false
+	})
+
+	k.IterateFallbackValidators(ctx, func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool) {
+		state.FallbackValidators = append(state.FallbackValidators, types.DelegatorFallbackValidator{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: valAddr.String(),
+		})
+		return false
+	})
+
+	k.IterateArchivedValidatorInfo(ctx, func(valAddr sdk.ValAddress, info types.PetrichorValidatorInfo) (stop bool) {
+		state.ArchivedValidatorInfos = append(state.ArchivedValidatorInfos, types.ValidatorInfoState{
+			ValidatorAddress: valAddr.String(),
+			Validator:        info,
+		})
+		return false
+	})
+
 	state.Params = types.Params{
-		RewardDelayTime:       k.RewardDelayTime(ctx),
-		TakeRateClaimInterval: k.RewardClaimInterval(ctx),
-		LastTakeRateClaimTime: k.LastRewardClaimTime(ctx),
+		RewardDelayTime:          k.RewardDelayTime(ctx),
+		TakeRateClaimInterval:    k.RewardClaimInterval(ctx),
+		LastTakeRateClaimTime:    k.LastRewardClaimTime(ctx),
+		AutoCompoundInterval:     k.AutoCompoundInterval(ctx),
+		LastAutoCompoundTime:     k.LastAutoCompoundTime(ctx),
+		TakeRateSplits:           k.TakeRateSplits(ctx),
+		AutoRedelegateTombstoned: k.AutoRedelegateTombstoned(ctx),
+		MaxTotalRewardWeight:     k.MaxTotalRewardWeight(ctx),
+		TakeRateHistoryRetention: k.TakeRateHistoryRetention(ctx),
 	}
 
 	return &state
@@ -16,9 +16,11 @@ func TestGenesis(t *testing.T) {
 	app, ctx := createTestContext(t)
 	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
 		Params: types.Params{
-			RewardDelayTime:       time.Duration(1000000),
-			TakeRateClaimInterval: time.Duration(1000000),
-			LastTakeRateClaimTime: time.Unix(0, 0).UTC(),
+			RewardDelayTime:          time.Duration(1000000),
+			TakeRateClaimInterval:    time.Duration(1000000),
+			LastTakeRateClaimTime:    time.Unix(0, 0).UTC(),
+			AutoCompoundInterval:     types.DefaultParams().AutoCompoundInterval,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
 		},
 		Assets: []types.PetrichorAsset{
 			types.NewPetrichorAsset("stake", sdk.NewDec(1), sdk.ZeroDec(), ctx.BlockTime()),
@@ -53,9 +55,11 @@ func TestExportAndImportGenesis(t *testing.T) {
 	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
 	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
 		Params: types.Params{
-			RewardDelayTime:       time.Duration(1000000),
-			TakeRateClaimInterval: time.Duration(1000000),
-			LastTakeRateClaimTime: time.Unix(0, 0).UTC(),
+			RewardDelayTime:          time.Duration(1000000),
+			TakeRateClaimInterval:    time.Duration(1000000),
+			LastTakeRateClaimTime:    time.Unix(0, 0).UTC(),
+			AutoCompoundInterval:     types.DefaultParams().AutoCompoundInterval,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
 		},
 		Assets: []types.PetrichorAsset{},
 	})
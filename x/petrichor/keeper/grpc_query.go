@@ -11,6 +11,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"time"
 )
 
 type QueryServer struct {
@@ -83,6 +84,8 @@ func (k QueryServer) PetrichorValidator(c context.Context, req *types.QueryPetri
 	}
 	res.ValidatorShares = val.ValidatorShares
 	res.TotalDelegationShares = val.TotalDelegatorShares
+	res.Commission = val.PetrichorCommission
+	res.AccumulatedCommission = val.AccumulatedCommission
 
 	for _, share := range val.ValidatorShares {
 		asset, found := k.GetAssetByDenom(ctx, share.Denom)
@@ -128,6 +131,8 @@ func (k QueryServer) AllPetrichorValidators(c context.Context, req *types.QueryA
 			TotalDelegationShares: val.TotalDelegatorShares,
 			ValidatorShares:       val.ValidatorShares,
 			TotalStaked:           totalStaked,
+			Commission:            val.PetrichorCommission,
+			AccumulatedCommission: val.AccumulatedCommission,
 		})
 		return nil
 	})
@@ -221,6 +226,217 @@ func (k QueryServer) IBCPetrichor(c context.Context, request *types.QueryIBCPetr
 	return k.Petrichor(c, &req)
 }
 
+func (k QueryServer) PetrichorCapacity(c context.Context, req *types.QueryPetrichorCapacityRequest) (*types.QueryPetrichorCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	asset, found := k.GetAssetByDenom(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrUnknownAsset
+	}
+
+	var res types.QueryPetrichorCapacityResponse
+	if asset.HasMaxTotalTokens() {
+		remaining := asset.RemainingTotalTokens()
+		res.RemainingTotalTokens = &remaining
+	}
+	if req.ValidatorAddr == "" {
+		return &res, nil
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("validator address %s invalid", req.ValidatorAddr))
+	}
+	val, err := k.GetPetrichorValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("validator with address %s not found", req.ValidatorAddr))
+	}
+	remaining := asset.RemainingValidatorTokens(val.TotalDecTokensWithAsset(asset), true)
+	if remaining.IsNil() {
+		res.RemainingValidatorTokens = res.RemainingTotalTokens
+	} else {
+		if res.RemainingTotalTokens != nil {
+			remaining = sdk.MinInt(remaining, *res.RemainingTotalTokens)
+		}
+		res.RemainingValidatorTokens = &remaining
+	}
+	return &res, nil
+}
+
+func (k QueryServer) PetrichorTakeRate(c context.Context, req *types.QueryPetrichorTakeRateRequest) (*types.QueryPetrichorTakeRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	var history []types.TakeRateRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetTakeRateHistoryKeyForDenom(req.Denom))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var record types.TakeRateRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		history = append(history, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPetrichorTakeRateResponse{
+		Accrual:    k.GetTakeRateAccrual(ctx, req.Denom),
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k QueryServer) PetrichorValidatorSlashes(c context.Context, req *types.QueryPetrichorValidatorSlashesRequest) (*types.QueryPetrichorSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pagination, err := slashesPageRequest(req.Pagination, req.StartHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var slashes []types.SlashRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSlashRecordsKeyForValidator(valAddr))
+	pageRes, err := query.Paginate(store, pagination, func(key []byte, value []byte) error {
+		var record types.SlashRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		slashes = append(slashes, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPetrichorSlashesResponse{
+		Slashes:    slashes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k QueryServer) PetrichorDelegationSlashes(c context.Context, req *types.QueryPetrichorDelegationSlashesRequest) (*types.QueryPetrichorSlashesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "denom cannot be empty")
+	}
+
+	pagination, err := slashesPageRequest(req.Pagination, req.StartHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Redelegation slashes of other delegators into the same position are skipped without counting towards the page
+	var slashes []types.SlashRecord
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSlashRecordsByDelegationIndexKey(valAddr, req.Denom))
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		record, found := k.getDelegationSlashRecord(ctx, key, delAddr)
+		if !found {
+			return false, nil
+		}
+		if accumulate {
+			slashes = append(slashes, record)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPetrichorSlashesResponse{
+		Slashes:    slashes,
+		Pagination: pageRes,
+	}, nil
+}
+
+// slashesPageRequest starts the first page of a slash query at the start height. Slashes are keyed by height so that
+// earlier ones are never read, which leaves offsets only usable without a start height
+func slashesPageRequest(pagination *query.PageRequest, startHeight uint64) (*query.PageRequest, error) {
+	if startHeight == 0 || (pagination != nil && len(pagination.Key) > 0) {
+		return pagination, nil
+	}
+	if pagination == nil {
+		pagination = &query.PageRequest{}
+	} else if pagination.Offset > 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset cannot be combined with a start height, use the next key instead")
+	}
+	res := *pagination
+	res.Key = sdk.Uint64ToBigEndian(startHeight)
+	return &res, nil
+}
+
+func (k QueryServer) PetrichorInsuranceFund(c context.Context, req *types.QueryPetrichorInsuranceFundRequest) (*types.QueryPetrichorInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPetrichorInsuranceFundResponse{
+		Balance: k.GetInsuranceFundBalance(ctx),
+	}, nil
+}
+
+func (k QueryServer) PetrichorInsuranceClaims(c context.Context, req *types.QueryPetrichorInsuranceClaimsRequest) (*types.QueryPetrichorInsuranceClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	keyPrefix := types.InsuranceClaimKey
+	if req.DelegatorAddr != "" {
+		delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keyPrefix = types.GetInsuranceClaimsKeyForDelegator(delAddr)
+	}
+
+	var claims []types.InsuranceClaim
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var claim types.InsuranceClaim
+		if err := k.cdc.Unmarshal(value, &claim); err != nil {
+			return err
+		}
+		claims = append(claims, claim)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPetrichorInsuranceClaimsResponse{
+		Claims:     claims,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k QueryServer) PetrichorDelegationRewards(context context.Context, request *types.QueryPetrichorDelegationRewardsRequest) (*types.QueryPetrichorDelegationRewardsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(context)
 	delAddr, err := sdk.AccAddressFromBech32(request.DelegatorAddr)
@@ -246,12 +462,88 @@ func (k QueryServer) PetrichorDelegationRewards(context context.Context, request
 		return nil, stakingtypes.ErrNoDelegation
 	}
 
-	rewards, err := k.ClaimDelegationRewards(ctx, delAddr, val, request.Denom)
+	rewards, segments, err := k.EstimateDelegationRewards(ctx, delAddr, val, request.Denom)
 	if err != nil {
 		return nil, err
 	}
 	return &types.QueryPetrichorDelegationRewardsResponse{
-		Rewards: rewards,
+		Rewards:  rewards,
+		Segments: segments,
+	}, nil
+}
+
+func (k QueryServer) PetrichorDelegatorRewards(c context.Context, req *types.QueryPetrichorDelegatorRewardsRequest) (*types.QueryPetrichorDelegatorRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var validatorsRes []types.ValidatorRewards
+	total := sdk.NewCoins()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	delegationsStore := prefix.NewStore(store, types.GetDelegationsKey(delAddr))
+
+	// Delegations are keyed by validator so the delegations to a validator are next to each other
+	// and the pending validator rewards only need to be estimated once per validator
+	var validator types.PetrichorValidator
+	pageRes, err := query.Paginate(delegationsStore, req.Pagination, func(key []byte, value []byte) error {
+		var delegation types.Delegation
+		if err := k.cdc.Unmarshal(value, &delegation); err != nil {
+			return err
+		}
+
+		// Delegations left behind by a deleted asset earn nothing
+		asset, found := k.GetAssetByDenom(ctx, delegation.Denom)
+		if !found {
+			return nil
+		}
+
+		if len(validatorsRes) == 0 || validatorsRes[len(validatorsRes)-1].ValidatorAddr != delegation.ValidatorAddress {
+			valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+			if err != nil {
+				return err
+			}
+			validator, err = k.GetPetrichorValidator(ctx, valAddr)
+			if err != nil {
+				return err
+			}
+			validator, err = k.withPendingValidatorRewards(ctx, validator)
+			if err != nil {
+				return err
+			}
+			validatorsRes = append(validatorsRes, types.ValidatorRewards{
+				ValidatorAddr: delegation.ValidatorAddress,
+				Total:         sdk.NewCoins(),
+			})
+		}
+
+		rewards, _, _ := k.calculateDelegationRewards(ctx, delegation, validator, asset)
+		validatorRes := &validatorsRes[len(validatorsRes)-1]
+		validatorRes.Assets = append(validatorRes.Assets, types.AssetRewards{
+			Denom:   delegation.Denom,
+			Rewards: rewards,
+		})
+		validatorRes.Total = validatorRes.Total.Add(rewards...)
+		total = total.Add(rewards...)
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPetrichorDelegatorRewardsResponse{
+		Validators: validatorsRes,
+		Total:      total,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -443,6 +735,303 @@ func (k QueryServer) IBCPetrichorDelegation(c context.Context, request *types.Qu
 	}
 	return k.PetrichorDelegation(c, &req)
 }
+
+func (k QueryServer) PetrichorUndelegations(c context.Context, req *types.QueryPetrichorUndelegationsRequest) (*types.QueryPetrichorUndelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	return k.paginateUndelegationIndex(ctx, types.GetUndelegationsByDelegatorIndexKey(delAddr), delAddr, req.Pagination, func(undelegation types.Undelegation) bool {
+		return true
+	})
+}
+
+func (k QueryServer) PetrichorUndelegationsByAsset(c context.Context, req *types.QueryPetrichorUndelegationsByAssetRequest) (*types.QueryPetrichorUndelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	_, found := k.GetAssetByDenom(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrUnknownAsset
+	}
+	return k.paginateUndelegationIndex(ctx, types.GetUndelegationsByDenomIndexKey(req.Denom), nil, req.Pagination, func(undelegation types.Undelegation) bool {
+		return undelegation.Balance.Denom == req.Denom
+	})
+}
+
+// paginateUndelegationIndex paginates over an undelegation index and returns the entries of the queued undelegations
+// it points to that are accepted by the filter. delAddr is the delegator of an index that is keyed by delegator
+func (k QueryServer) paginateUndelegationIndex(ctx sdk.Context, indexPrefix []byte, delAddr sdk.AccAddress, pagination *query.PageRequest, filter func(undelegation types.Undelegation) bool) (*types.QueryPetrichorUndelegationsResponse, error) {
+	var undelegationsRes []types.UndelegationResponse
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, _ []byte) error {
+		undelegationKey, completionTime, err := types.ParseUndelegationIndexTimeAndDelegator(key, delAddr)
+		if err != nil {
+			return err
+		}
+		b := store.Get(undelegationKey)
+		if b == nil {
+			return nil
+		}
+		var queue types.QueuedUndelegation
+		if err := k.cdc.Unmarshal(b, &queue); err != nil {
+			return err
+		}
+		for _, entry := range queue.Entries {
+			if !filter(*entry) {
+				continue
+			}
+			undelegationsRes = append(undelegationsRes, types.UndelegationResponse{
+				Undelegation:   *entry,
+				CompletionTime: completionTime,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPetrichorUndelegationsResponse{
+		Undelegations: undelegationsRes,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (k QueryServer) PetrichorUndelegationsByValidator(c context.Context, req *types.QueryPetrichorUndelegationsByValidatorRequest) (*types.QueryPetrichorUndelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var undelegationsRes []types.UndelegationResponse
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexPrefix := types.GetUndelegationsIndexOrderedByValidatorKey(valAddr)
+	indexStore := prefix.NewStore(store, indexPrefix)
+
+	// Each index entry points to the queued undelegations of a delegator at a completion time for a single denom
+	pageRes, err := query.Paginate(indexStore, req.Pagination, func(key []byte, _ []byte) error {
+		indexKey := append(append([]byte{}, indexPrefix...), key...)
+		undelegationKey, completionTime, err := types.ParseUnbondingIndexKeyToUndelegationKey(indexKey)
+		if err != nil {
+			return err
+		}
+		denom := types.ParseUnbondingIndexKeyDenom(indexKey)
+
+		b := store.Get(undelegationKey)
+		if b == nil {
+			return nil
+		}
+		var queue types.QueuedUndelegation
+		if err := k.cdc.Unmarshal(b, &queue); err != nil {
+			return err
+		}
+		for _, entry := range queue.Entries {
+			if entry.ValidatorAddress != req.ValidatorAddr || entry.Balance.Denom != denom {
+				continue
+			}
+			undelegationsRes = append(undelegationsRes, types.UndelegationResponse{
+				Undelegation:   *entry,
+				CompletionTime: completionTime,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPetrichorUndelegationsResponse{
+		Undelegations: undelegationsRes,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (k QueryServer) PetrichorRedelegations(c context.Context, req *types.QueryPetrichorRedelegationsRequest) (*types.QueryPetrichorRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var redelegationsRes []types.RedelegationResponse
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	redelegationsPrefix := types.GetRedelegationsKeyByDelegator(delAddr)
+	redelegationsStore := prefix.NewStore(store, redelegationsPrefix)
+
+	pageRes, err := query.FilteredPaginate(redelegationsStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var redelegation types.Redelegation
+		if err := k.cdc.Unmarshal(value, &redelegation); err != nil {
+			return false, err
+		}
+		completionTime := types.ParseRedelegationKeyForCompletionTime(append(append([]byte{}, redelegationsPrefix...), key...))
+		redelegationRes, err := k.newRedelegationResponse(ctx, redelegation, completionTime)
+		if err != nil {
+			// Redelegations that cannot be resolved are skipped instead of failing the whole page
+			return false, nil
+		}
+		if accumulate {
+			redelegationsRes = append(redelegationsRes, redelegationRes)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPetrichorRedelegationsResponse{
+		Redelegations: redelegationsRes,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (k QueryServer) PetrichorRedelegationsByAsset(c context.Context, req *types.QueryPetrichorRedelegationsByAssetRequest) (*types.QueryPetrichorRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var redelegationsRes []types.RedelegationResponse
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, found := k.GetAssetByDenom(ctx, req.Denom)
+	if !found {
+		return nil, types.ErrUnknownAsset
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, types.GetRedelegationsByDenomIndexKey(req.Denom))
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		redelegationKey, completionTime, err := types.ParseRedelegationByDenomIndexSuffix(req.Denom, key)
+		if err != nil {
+			return false, err
+		}
+		b := store.Get(redelegationKey)
+		if b == nil {
+			return false, nil
+		}
+		var redelegation types.Redelegation
+		if err := k.cdc.Unmarshal(b, &redelegation); err != nil {
+			return false, err
+		}
+		redelegationRes, err := k.newRedelegationResponse(ctx, redelegation, completionTime)
+		if err != nil {
+			// Redelegations that cannot be resolved are skipped instead of failing the whole page
+			return false, nil
+		}
+		if accumulate {
+			redelegationsRes = append(redelegationsRes, redelegationRes)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPetrichorRedelegationsResponse{
+		Redelegations: redelegationsRes,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (k QueryServer) PetrichorRedelegationsByValidator(c context.Context, req *types.QueryPetrichorRedelegationsByValidatorRequest) (*types.QueryPetrichorRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	var redelegationsRes []types.RedelegationResponse
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexPrefix := types.GetRedelegationsIndexOrderedByValidatorKey(valAddr)
+	indexStore := prefix.NewStore(store, indexPrefix)
+
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		redelegationKey, completionTime, err := types.ParseRedelegationIndexForRedelegationKey(append(append([]byte{}, indexPrefix...), key...))
+		if err != nil {
+			return false, err
+		}
+		b := store.Get(redelegationKey)
+		if b == nil {
+			return false, nil
+		}
+		var redelegation types.Redelegation
+		if err := k.cdc.Unmarshal(b, &redelegation); err != nil {
+			return false, err
+		}
+		redelegationRes, err := k.newRedelegationResponse(ctx, redelegation, completionTime)
+		if err != nil {
+			// Redelegations that cannot be resolved are skipped instead of failing the whole page
+			return false, nil
+		}
+		if accumulate {
+			redelegationsRes = append(redelegationsRes, redelegationRes)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPetrichorRedelegationsResponse{
+		Redelegations: redelegationsRes,
+		Pagination:    pageRes,
+	}, nil
+}
+
+// newRedelegationResponse adds the current balance of a redelegation. Redelegations are not updated when slashed,
+// instead the shares of the delegation at the destination validator are reduced. The redelegated balance is capped
+// by the tokens of that delegation so that slashing of both the source and destination validators is accounted for
+func (k QueryServer) newRedelegationResponse(ctx sdk.Context, redelegation types.Redelegation, completionTime time.Time) (types.RedelegationResponse, error) {
+	res := types.RedelegationResponse{
+		Redelegation:   redelegation,
+		CompletionTime: completionTime,
+		Balance:        sdk.NewCoin(redelegation.Balance.Denom, sdk.ZeroInt()),
+	}
+	delAddr, err := sdk.AccAddressFromBech32(redelegation.DelegatorAddress)
+	if err != nil {
+		return res, err
+	}
+	dstValAddr, err := sdk.ValAddressFromBech32(redelegation.DstValidatorAddress)
+	if err != nil {
+		return res, err
+	}
+	dstVal, err := k.GetPetrichorValidator(ctx, dstValAddr)
+	if err != nil {
+		return res, err
+	}
+	asset, found := k.GetAssetByDenom(ctx, redelegation.Balance.Denom)
+	if !found {
+		return res, types.ErrUnknownAsset
+	}
+	delegation, found := k.GetDelegation(ctx, delAddr, dstVal, redelegation.Balance.Denom)
+	if !found {
+		return res, nil
+	}
+	tokens := types.GetDelegationTokens(delegation, dstVal, asset)
+	res.Balance = sdk.NewCoin(redelegation.Balance.Denom, sdk.MinInt(tokens.Amount, redelegation.Balance.Amount))
+	return res, nil
+}
+
 func NewQueryServerImpl(keeper Keeper) types.QueryServer {
 	return &QueryServer{Keeper: keeper}
 }
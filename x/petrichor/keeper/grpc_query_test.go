@@ -246,9 +246,11 @@ func TestClaimQueryReward(t *testing.T) {
 	ctx = ctx.WithBlockHeight(1)
 	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
 		Params: types.Params{
-			RewardDelayTime:       time.Minute * 60,
-			TakeRateClaimInterval: time.Minute * 5,
-			LastTakeRateClaimTime: startTime,
+			RewardDelayTime:          time.Minute * 60,
+			TakeRateClaimInterval:    time.Minute * 5,
+			LastTakeRateClaimTime:    startTime,
+			AutoCompoundInterval:     types.DefaultParams().AutoCompoundInterval,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
 		},
 		Assets: []types.PetrichorAsset{
 			{
@@ -314,7 +316,305 @@ func TestClaimQueryReward(t *testing.T) {
 				Amount: math.NewInt(32666),
 			},
 		},
+		Segments: []types.DelegationRewardSegment{
+			{
+				Height:       2,
+				RewardWeight: sdk.NewDec(2),
+				Rewards:      sdk.NewCoins(sdk.NewCoin(UPETRI_PETRICHOR, math.NewInt(32666))),
+			},
+		},
 	}, queryDelegation)
+
+	// ... the query did not claim anything ...
+	delegation, found := app.PetrichorKeeper.GetDelegation(ctx, delAddr, val1, UPETRI_PETRICHOR)
+	require.True(t, found)
+	require.Equal(t, uint64(1), delegation.LastRewardClaimHeight)
+	require.True(t, app.BankKeeper.GetBalance(ctx, delAddr, UPETRI_PETRICHOR).IsZero())
+
+	// ... and claiming pays out the queried rewards.
+	rewards, err := app.PetrichorKeeper.ClaimDelegationRewards(ctx, delAddr, val1, UPETRI_PETRICHOR)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(queryDelegation.Rewards...), rewards)
+}
+
+func TestQueryPetrichorDelegatorRewards(t *testing.T) {
+	// GIVEN: THE BLOCKCHAIN WITH PETRICHORS ON GENESIS
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+			types.NewPetrichorAsset(PETRICHOR_2_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.PetrichorKeeper)
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, _ := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	val1, _ := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(2000_000)),
+		sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(2000_000)),
+	))
+	pks := test_helpers.CreateTestPubKeys(1)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, pks[0]))
+	val2, _ := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	delAddr := addrs[1]
+
+	// WHEN: DELEGATING TO BOTH VALIDATORS AND DISTRIBUTING REWARDS ...
+	_, err := app.PetrichorKeeper.Delegate(ctx, delAddr, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, delAddr, val1, sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, delAddr, val2, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(3000_000))))
+	require.NoError(t, err)
+	val1, _ = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+	val2, _ = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val2, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+
+	// ... AND QUERYING THE REWARDS OF THE DELEGATOR ...
+	res, err := queryServer.PetrichorDelegatorRewards(ctx, &types.QueryPetrichorDelegatorRewardsRequest{
+		DelegatorAddr: delAddr.String(),
+	})
+	require.NoError(t, err)
+
+	// THEN: REWARDS ARE GROUPED BY VALIDATOR AND ASSET WITH TOTALS
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(3000_000))), res.Total)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	require.Len(t, res.Validators, 2)
+	for _, validatorRes := range res.Validators {
+		switch validatorRes.ValidatorAddr {
+		case valAddr1.String():
+			require.Equal(t, []types.AssetRewards{
+				{Denom: PETRICHOR_TOKEN_DENOM, Rewards: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000)))},
+				{Denom: PETRICHOR_2_TOKEN_DENOM, Rewards: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000)))},
+			}, validatorRes.Assets)
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))), validatorRes.Total)
+		case valAddr2.String():
+			require.Equal(t, []types.AssetRewards{
+				{Denom: PETRICHOR_TOKEN_DENOM, Rewards: sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000)))},
+			}, validatorRes.Assets)
+			require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))), validatorRes.Total)
+		default:
+			t.Fatalf("unexpected validator %s", validatorRes.ValidatorAddr)
+		}
+	}
+
+	// ... paging only totals the rewards of the page ...
+	res, err = queryServer.PetrichorDelegatorRewards(ctx, &types.QueryPetrichorDelegatorRewardsRequest{
+		DelegatorAddr: delAddr.String(),
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Validators, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))), res.Total)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	// ... and nothing has been claimed.
+	require.True(t, app.BankKeeper.GetBalance(ctx, delAddr, "stake").IsZero())
+
+	// Delegations of a deleted asset are skipped
+	app.PetrichorKeeper.DeleteAsset(ctx, PETRICHOR_2_TOKEN_DENOM)
+	res, err = queryServer.PetrichorDelegatorRewards(ctx, &types.QueryPetrichorDelegatorRewardsRequest{
+		DelegatorAddr: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))), res.Total)
+
+	// An empty request is rejected
+	_, err = queryServer.PetrichorDelegatorRewards(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryPetrichorUndelegationsAndRedelegations(t *testing.T) {
+	// GIVEN: THE BLOCKCHAIN WITH PETRICHORS ON GENESIS
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+			types.NewPetrichorAsset(PETRICHOR_2_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.PetrichorKeeper)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, _ := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	val1, _ := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(10_000_000)),
+		sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(10_000_000)),
+	))
+	pks := test_helpers.CreateTestPubKeys(1)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, pks[0]))
+	val2, _ := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	delAddr := addrs[1]
+
+	// WHEN: UNDELEGATING AND REDELEGATING ...
+	_, err := app.PetrichorKeeper.Delegate(ctx, delAddr, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, delAddr, val1, sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	val1, _ = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	undelegationCompletion, err := app.PetrichorKeeper.Undelegate(ctx, delAddr, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(2_000_000)))
+	require.NoError(t, err)
+	val1, _ = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	redelegationCompletion, err := app.PetrichorKeeper.Redelegate(ctx, delAddr, val1, val2, sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(4_000_000)))
+	require.NoError(t, err)
+
+	// ... AND SLASHING THE SOURCE VALIDATOR BY 10% AND THE DESTINATION VALIDATOR BY 20%
+	err = app.PetrichorKeeper.SlashValidator(ctx, valAddr1, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.SlashValidator(ctx, valAddr2, sdk.NewDecWithPrec(2, 1))
+	require.NoError(t, err)
+
+	// THEN: UNDELEGATIONS ARE QUERYABLE BY DELEGATOR, VALIDATOR AND ASSET WITH THE SLASHED BALANCE
+	expectedUndelegations := []types.UndelegationResponse{
+		{
+			Undelegation: types.Undelegation{
+				DelegatorAddress: delAddr.String(),
+				ValidatorAddress: valAddr1.String(),
+				Balance:          sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1_800_000)),
+			},
+			CompletionTime: *undelegationCompletion,
+		},
+	}
+	undelegations, err := queryServer.PetrichorUndelegations(ctx, &types.QueryPetrichorUndelegationsRequest{
+		DelegatorAddr: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedUndelegations, undelegations.Undelegations)
+	undelegations, err = queryServer.PetrichorUndelegationsByValidator(ctx, &types.QueryPetrichorUndelegationsByValidatorRequest{
+		ValidatorAddr: valAddr1.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedUndelegations, undelegations.Undelegations)
+	undelegations, err = queryServer.PetrichorUndelegationsByAsset(ctx, &types.QueryPetrichorUndelegationsByAssetRequest{
+		Denom: PETRICHOR_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedUndelegations, undelegations.Undelegations)
+	undelegations, err = queryServer.PetrichorUndelegationsByValidator(ctx, &types.QueryPetrichorUndelegationsByValidatorRequest{
+		ValidatorAddr: valAddr2.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, undelegations.Undelegations)
+	undelegations, err = queryServer.PetrichorUndelegationsByAsset(ctx, &types.QueryPetrichorUndelegationsByAssetRequest{
+		Denom: PETRICHOR_2_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Empty(t, undelegations.Undelegations)
+
+	// ... AND SO ARE REDELEGATIONS
+	expectedRedelegations := []types.RedelegationResponse{
+		{
+			Redelegation: types.Redelegation{
+				DelegatorAddress:    delAddr.String(),
+				SrcValidatorAddress: valAddr1.String(),
+				DstValidatorAddress: valAddr2.String(),
+				Balance:             sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(4_000_000)),
+			},
+			CompletionTime: *redelegationCompletion,
+			// 3.2M of the 8.6M validator shares left for the asset at the destination validator
+			Balance: sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(3_720_930)),
+		},
+	}
+	redelegations, err := queryServer.PetrichorRedelegations(ctx, &types.QueryPetrichorRedelegationsRequest{
+		DelegatorAddr: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRedelegations, redelegations.Redelegations)
+	redelegations, err = queryServer.PetrichorRedelegationsByValidator(ctx, &types.QueryPetrichorRedelegationsByValidatorRequest{
+		ValidatorAddr: valAddr1.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRedelegations, redelegations.Redelegations)
+	redelegations, err = queryServer.PetrichorRedelegationsByAsset(ctx, &types.QueryPetrichorRedelegationsByAssetRequest{
+		Denom: PETRICHOR_2_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRedelegations, redelegations.Redelegations)
+	redelegations, err = queryServer.PetrichorRedelegationsByValidator(ctx, &types.QueryPetrichorRedelegationsByValidatorRequest{
+		ValidatorAddr: valAddr2.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, redelegations.Redelegations)
+
+	// Unknown assets cannot be queried
+	_, err = queryServer.PetrichorRedelegationsByAsset(ctx, &types.QueryPetrichorRedelegationsByAssetRequest{
+		Denom: "unknown",
+	})
+	require.ErrorIs(t, err, types.ErrUnknownAsset)
+
+	// Redelegations whose destination validator cannot be resolved are skipped instead of failing the page
+	unknownVal := sdk.ValAddress(addrs[1])
+	orphan := types.Redelegation{
+		DelegatorAddress:    delAddr.String(),
+		SrcValidatorAddress: valAddr1.String(),
+		DstValidatorAddress: unknownVal.String(),
+		Balance:             sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(1)),
+	}
+	store := ctx.KVStore(app.PetrichorKeeper.StoreKey())
+	store.Set(types.GetRedelegationKey(delAddr, PETRICHOR_2_TOKEN_DENOM, unknownVal, *redelegationCompletion), app.AppCodec().MustMarshal(&orphan))
+	store.Set(types.GetRedelegationByDenomIndexKey(PETRICHOR_2_TOKEN_DENOM, delAddr, unknownVal, *redelegationCompletion), []byte{})
+	redelegations, err = queryServer.PetrichorRedelegationsByAsset(ctx, &types.QueryPetrichorRedelegationsByAssetRequest{
+		Denom: PETRICHOR_2_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRedelegations, redelegations.Redelegations)
+	redelegations, err = queryServer.PetrichorRedelegations(ctx, &types.QueryPetrichorRedelegationsRequest{
+		DelegatorAddr: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRedelegations, redelegations.Redelegations)
+	// Skipped redelegations do not count toward the page limit
+	redelegations, err = queryServer.PetrichorRedelegationsByAsset(ctx, &types.QueryPetrichorRedelegationsByAssetRequest{
+		Denom:      PETRICHOR_2_TOKEN_DENOM,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, expectedRedelegations[:1], redelegations.Redelegations)
+	require.Equal(t, uint64(len(expectedRedelegations)), redelegations.Pagination.Total)
+
+	// Empty requests are rejected
+	_, err = queryServer.PetrichorUndelegations(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.PetrichorUndelegationsByAsset(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.PetrichorUndelegationsByValidator(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.PetrichorRedelegations(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.PetrichorRedelegationsByAsset(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = queryServer.PetrichorRedelegationsByValidator(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Completed undelegations are removed from every index
+	ctx = ctx.WithBlockTime(undelegationCompletion.Add(time.Second))
+	err = app.PetrichorKeeper.CompleteUndelegations(ctx)
+	require.NoError(t, err)
+	undelegations, err = queryServer.PetrichorUndelegations(ctx, &types.QueryPetrichorUndelegationsRequest{
+		DelegatorAddr: delAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, undelegations.Undelegations)
+	undelegations, err = queryServer.PetrichorUndelegationsByAsset(ctx, &types.QueryPetrichorUndelegationsByAssetRequest{
+		Denom: PETRICHOR_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Empty(t, undelegations.Undelegations)
+	require.Equal(t, uint64(0), undelegations.Pagination.Total)
 }
 
 func TestQueryPetrichorDelegation(t *testing.T) {
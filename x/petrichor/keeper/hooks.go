@@ -14,15 +14,25 @@ var (
 )
 
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
-	return nil
+	return h.k.initializeValidatorInfo(ctx, valAddr)
 }
 
+// BeforeValidatorModified also creates the info of validators that existed before the petrichor module
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) error {
-	return nil
+	return h.k.initializeValidatorInfo(ctx, valAddr)
 }
 
 func (h Hooks) AfterValidatorRemoved(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	h.k.QueueAssetRebalanceEvent(ctx)
+	// x/staking ignores errors of this hook so a failed removal must not be partially written
+	cacheCtx, write := ctx.CacheContext()
+	err := h.k.removeValidator(cacheCtx, valAddr)
+	if err != nil {
+		h.k.Logger(ctx).Error("failed to remove petrichor validator", "validator", valAddr, "error", err)
+		return err
+	}
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	return nil
 }
 
@@ -33,6 +43,7 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 
 func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) error {
 	h.k.QueueAssetRebalanceEvent(ctx)
+	h.k.queueTombstonedValidator(ctx, consAddr, valAddr)
 	return nil
 }
 
@@ -0,0 +1,285 @@
+package keeper_test
+
+import (
+	"fmt"
+	test_helpers "github.com/petrinetwork/petrichor/app"
+	"github.com/petrinetwork/petrichor/x/petrichor"
+	"github.com/petrinetwork/petrichor/x/petrichor/keeper"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatorLifecycle(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime),
+		},
+	})
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user := addrs[2]
+	valAddr1 := sdk.ValAddress(addrs[0])
+	valAddr2 := sdk.ValAddress(addrs[1])
+	pubKeys := test_helpers.CreateTestPubKeys(2)
+
+	// The info is created with the validator
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr1, pubKeys[0]))
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, pubKeys[1]))
+	_, found := app.PetrichorKeeper.GetPetrichorValidatorInfo(ctx, valAddr1)
+	require.True(t, found)
+	_, found = app.PetrichorKeeper.GetPetrichorValidatorInfo(ctx, valAddr2)
+	require.True(t, found)
+
+	// The user has pending rewards with validator 1
+	val1, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	val1, _ = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100_000))))
+	require.NoError(t, err)
+	mintPoolAddr := authtypes.NewModuleAddress(minttypes.ModuleName)
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val1, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(100_000))))
+	require.NoError(t, err)
+	val2, _ := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	app.PetrichorKeeper.SetRewardWeightChangeSnapshot(ctx, types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.ZeroDec(), startTime), val2)
+
+	removeValidator := func(valAddr sdk.ValAddress) {
+		val, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+		require.True(t, found)
+		app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, val)
+		val.Status = stakingtypes.Unbonded
+		app.StakingKeeper.SetValidator(ctx, val)
+		app.StakingKeeper.RemoveValidator(ctx, valAddr)
+	}
+
+	// Removing validator 1 pays out the rewards and keeps the info for the remaining delegation
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	removeValidator(valAddr1)
+	require.Equal(t, sdk.NewInt(100_000), app.BankKeeper.GetBalance(ctx, user, "stake").Amount)
+	_, found = app.PetrichorKeeper.GetPetrichorValidatorInfo(ctx, valAddr1)
+	require.True(t, found)
+	_, found = app.PetrichorKeeper.GetArchivedValidatorInfo(ctx, valAddr1)
+	require.False(t, found)
+
+	// Validator 2 has no petrichor delegations so its info is archived
+	removeValidator(valAddr2)
+	_, found = app.PetrichorKeeper.GetPetrichorValidatorInfo(ctx, valAddr2)
+	require.False(t, found)
+	_, found = app.PetrichorKeeper.GetArchivedValidatorInfo(ctx, valAddr2)
+	require.True(t, found)
+	iter := app.PetrichorKeeper.IterateWeightChangeSnapshot(ctx, PETRICHOR_TOKEN_DENOM, valAddr2, 0)
+	require.False(t, iter.Valid())
+	iter.Close()
+	archived := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeArchiveValidator {
+			archived++
+		}
+	}
+	require.Equal(t, 1, archived)
+
+	// Archived infos are exported
+	genesis := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.ArchivedValidatorInfos, 1)
+	require.Equal(t, valAddr2.String(), genesis.ArchivedValidatorInfos[0].ValidatorAddress)
+	require.NoError(t, petrichor.ValidateGenesis(genesis))
+}
+
+// hooksRecorder records the petrichor hooks it receives, the embedded hooks do nothing for the others
+type hooksRecorder struct {
+	types.MultiPetrichorHooks
+	calls             []string
+	beforeDelegateErr error
+}
+
+func (h *hooksRecorder) BeforeDelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	h.calls = append(h.calls, "BeforeDelegate "+coin.String())
+	return h.beforeDelegateErr
+}
+
+func (h *hooksRecorder) AfterDelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	h.calls = append(h.calls, "AfterDelegate "+coin.String())
+	return nil
+}
+
+func (h *hooksRecorder) BeforeRedelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcValAddr sdk.ValAddress, dstValAddr sdk.ValAddress, coin sdk.Coin) error {
+	h.calls = append(h.calls, "BeforeRedelegate "+coin.String())
+	return nil
+}
+
+func (h *hooksRecorder) AfterRedelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcValAddr sdk.ValAddress, dstValAddr sdk.ValAddress, coin sdk.Coin) error {
+	h.calls = append(h.calls, "AfterRedelegate "+coin.String())
+	return nil
+}
+
+func (h *hooksRecorder) BeforeUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	h.calls = append(h.calls, "BeforeUndelegate "+coin.String())
+	return nil
+}
+
+func (h *hooksRecorder) AfterUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	h.calls = append(h.calls, "AfterUndelegate "+coin.String())
+	return nil
+}
+
+func (h *hooksRecorder) BeforeClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error {
+	h.calls = append(h.calls, "BeforeClaimDelegationRewards "+denom)
+	return nil
+}
+
+func (h *hooksRecorder) AfterClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, rewards sdk.Coins) error {
+	h.calls = append(h.calls, "AfterClaimDelegationRewards "+denom)
+	return nil
+}
+
+func (h *hooksRecorder) BeforeCreatePetrichorAsset(ctx sdk.Context, denom string) error {
+	h.calls = append(h.calls, "BeforeCreatePetrichorAsset "+denom)
+	return nil
+}
+
+func (h *hooksRecorder) AfterCreatePetrichorAsset(ctx sdk.Context, asset types.PetrichorAsset) error {
+	h.calls = append(h.calls, "AfterCreatePetrichorAsset "+asset.Denom)
+	return nil
+}
+
+func (h *hooksRecorder) BeforeUpdatePetrichorAsset(ctx sdk.Context, asset types.PetrichorAsset) error {
+	h.calls = append(h.calls, "BeforeUpdatePetrichorAsset "+asset.Denom)
+	return nil
+}
+
+func (h *hooksRecorder) AfterUpdatePetrichorAsset(ctx sdk.Context, asset types.PetrichorAsset) error {
+	h.calls = append(h.calls, "AfterUpdatePetrichorAsset "+asset.Denom)
+	return nil
+}
+
+func (h *hooksRecorder) BeforeSlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	h.calls = append(h.calls, "BeforeSlashValidator "+fraction.String())
+	return nil
+}
+
+func (h *hooksRecorder) AfterSlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	h.calls = append(h.calls, "AfterSlashValidator "+fraction.String())
+	return nil
+}
+
+func TestPetrichorHooks(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	app.PetrichorKeeper.InitGenesis(ctx, petrichor.DefaultGenesisState())
+
+	// The app keeper already has its hooks so the hooks are registered on a keeper over the same store
+	hooks := &hooksRecorder{}
+	k := keeper.NewKeeper(
+		app.AppCodec(),
+		app.GetKey(types.StoreKey),
+		app.GetSubspace(types.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.StakingKeeper,
+		app.DistrKeeper,
+		app.SlashingKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	k.SetHooks(types.NewMultiPetrichorHooks(hooks))
+	require.Panics(t, func() {
+		k.SetHooks(types.NewMultiPetrichorHooks(hooks))
+	})
+
+	err := k.CreatePetrichor(ctx, &types.MsgCreatePetrichorProposal{
+		Denom:        PETRICHOR_TOKEN_DENOM,
+		RewardWeight: sdk.OneDec(),
+		TakeRate:     sdk.ZeroDec(),
+	})
+	require.NoError(t, err)
+	asset, _ := k.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	asset.RewardWeight = sdk.NewDec(2)
+	err = k.UpdatePetrichorAsset(ctx, asset)
+	require.NoError(t, err)
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user := addrs[1]
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	valAddr2 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0]))
+	getVal := func(valAddr sdk.ValAddress) types.PetrichorValidator {
+		val, err := k.GetPetrichorValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return val
+	}
+	coin := sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000))
+	_, err = k.Delegate(ctx, user, getVal(valAddr1), coin)
+	require.NoError(t, err)
+	_, err = k.Redelegate(ctx, user, getVal(valAddr1), getVal(valAddr2), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500)))
+	require.NoError(t, err)
+	completionTime, err := k.Undelegate(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500)))
+	require.NoError(t, err)
+	err = k.SlashValidator(ctx, valAddr2, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"BeforeCreatePetrichorAsset " + PETRICHOR_TOKEN_DENOM,
+		"AfterCreatePetrichorAsset " + PETRICHOR_TOKEN_DENOM,
+		"BeforeUpdatePetrichorAsset " + PETRICHOR_TOKEN_DENOM,
+		"AfterUpdatePetrichorAsset " + PETRICHOR_TOKEN_DENOM,
+		"BeforeDelegate 1000" + PETRICHOR_TOKEN_DENOM,
+		"AfterDelegate 1000" + PETRICHOR_TOKEN_DENOM,
+		"BeforeClaimDelegationRewards " + PETRICHOR_TOKEN_DENOM,
+		"AfterClaimDelegationRewards " + PETRICHOR_TOKEN_DENOM,
+		"BeforeRedelegate 500" + PETRICHOR_TOKEN_DENOM,
+		"AfterRedelegate 500" + PETRICHOR_TOKEN_DENOM,
+		"BeforeClaimDelegationRewards " + PETRICHOR_TOKEN_DENOM,
+		"AfterClaimDelegationRewards " + PETRICHOR_TOKEN_DENOM,
+		"BeforeUndelegate 500" + PETRICHOR_TOKEN_DENOM,
+		"AfterUndelegate 500" + PETRICHOR_TOKEN_DENOM,
+		"BeforeSlashValidator 0.100000000000000000",
+		"AfterSlashValidator 0.100000000000000000",
+	}, hooks.calls)
+
+	// An error returned by a hook aborts the action
+	hooks.beforeDelegateErr = fmt.Errorf("not allowed")
+	_, err = k.Delegate(ctx, user, getVal(valAddr1), coin)
+	require.EqualError(t, err, "not allowed")
+	require.Equal(t, sdk.NewInt(1000_000-1000), app.BankKeeper.GetBalance(ctx, user, PETRICHOR_TOKEN_DENOM).Amount)
+
+	// Cancelled undelegations go through the delegate hooks
+	hooks.beforeDelegateErr = nil
+	hooks.calls = nil
+	err = k.CancelUndelegation(ctx, user, getVal(valAddr1), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500)), *completionTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"BeforeDelegate 500" + PETRICHOR_TOKEN_DENOM,
+		"AfterDelegate 500" + PETRICHOR_TOKEN_DENOM,
+	}, hooks.calls)
+
+	// Forced undelegations go through the undelegate hooks
+	hooks.calls = nil
+	err = k.SetDenomFilter(ctx, valAddr2, types.DenomFilterModeDenylist, []string{PETRICHOR_TOKEN_DENOM}, types.OptOutPolicyUndelegate)
+	require.NoError(t, err)
+	k.ProcessValidatorOptOuts(ctx)
+	require.Equal(t, []string{
+		"BeforeClaimDelegationRewards " + PETRICHOR_TOKEN_DENOM,
+		"AfterClaimDelegationRewards " + PETRICHOR_TOKEN_DENOM,
+		"BeforeUndelegate 500" + PETRICHOR_TOKEN_DENOM,
+		"AfterUndelegate 500" + PETRICHOR_TOKEN_DENOM,
+	}, hooks.calls)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// GetInsuranceFundBalance returns the tokens held by the insurance fund
+func (k Keeper) GetInsuranceFundBalance(ctx sdk.Context) sdk.Coins {
+	return k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.InsuranceFundName))
+}
+
+// coverSlashedUndelegation pays back a slashed undelegation entry from the insurance fund. The coverage is limited by
+// the coverage ceiling of the asset, less what was already covered for the asset in the same slash, and by the
+// balance of the fund. The covered tokens are added back to the entry and returned
+func (k Keeper) coverSlashedUndelegation(ctx sdk.Context, entry *types.Undelegation, slashed sdk.Coin, alreadyCovered math.Int) (math.Int, error) {
+	asset, found := k.GetAssetByDenom(ctx, slashed.Denom)
+	if !found || asset.InsuranceCoverageCeiling == nil || asset.InsuranceCoverageCeiling.IsNil() {
+		return math.ZeroInt(), nil
+	}
+	coverage := math.MinInt(slashed.Amount, asset.InsuranceCoverageCeiling.Sub(alreadyCovered))
+	fund := k.bankKeeper.GetBalance(ctx, k.accountKeeper.GetModuleAddress(types.InsuranceFundName), slashed.Denom)
+	coverage = math.MinInt(coverage, fund.Amount)
+	if !coverage.IsPositive() {
+		return math.ZeroInt(), nil
+	}
+
+	covered := sdk.NewCoin(slashed.Denom, coverage)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.InsuranceFundName, types.ModuleName, sdk.NewCoins(covered))
+	if err != nil {
+		return math.ZeroInt(), err
+	}
+	entry.Balance = entry.Balance.Add(covered)
+
+	k.SetInsuranceClaim(ctx, types.InsuranceClaim{
+		DelegatorAddress: entry.DelegatorAddress,
+		ValidatorAddress: entry.ValidatorAddress,
+		Height:           uint64(ctx.BlockHeight()),
+		Time:             ctx.BlockTime(),
+		Slashed:          slashed,
+		Covered:          covered,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeInsuranceClaim,
+			sdk.NewAttribute(types.AttributeKeyDelegator, entry.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, entry.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, covered.String()),
+		),
+	)
+	return coverage, nil
+}
+
+// SetInsuranceClaim stores an insurance claim, combining it with any existing claim of the delegator for the same
+// validator and asset at the same height
+func (k Keeper) SetInsuranceClaim(ctx sdk.Context, claim types.InsuranceClaim) {
+	store := ctx.KVStore(k.storeKey)
+	delAddr, err := sdk.AccAddressFromBech32(claim.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(claim.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	key := types.GetInsuranceClaimKey(delAddr, claim.Height, valAddr, claim.Slashed.Denom)
+	if b := store.Get(key); b != nil {
+		var existing types.InsuranceClaim
+		k.cdc.MustUnmarshal(b, &existing)
+		claim.Slashed = claim.Slashed.Add(existing.Slashed)
+		claim.Covered = claim.Covered.Add(existing.Covered)
+	}
+	store.Set(key, k.cdc.MustMarshal(&claim))
+}
+
+// IterateInsuranceClaims iterates over the insurance claims of all delegators ordered by delegator and height
+func (k Keeper) IterateInsuranceClaims(ctx sdk.Context, cb func(claim types.InsuranceClaim) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.InsuranceClaimKey)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var claim types.InsuranceClaim
+		k.cdc.MustUnmarshal(iter.Value(), &claim)
+		if cb(claim) {
+			return
+		}
+	}
+}
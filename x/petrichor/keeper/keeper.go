@@ -20,7 +20,10 @@ type Keeper struct {
 	bankKeeper         types.BankKeeper
 	stakingKeeper      types.StakingKeeper
 	distributionKeeper types.DistributionKeeper
+	slashingKeeper     types.SlashingKeeper
 	authority          string
+	priceSource        types.PriceSource
+	hooks              types.PetrichorHooks
 }
 
 func NewKeeper(
@@ -31,6 +34,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	stakingKeeper types.StakingKeeper,
 	distributionKeeper types.DistributionKeeper,
+	slashingKeeper types.SlashingKeeper,
+	authority string,
 ) Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -46,9 +51,41 @@ func NewKeeper(
 		bankKeeper:         bankKeeper,
 		stakingKeeper:      stakingKeeper,
 		distributionKeeper: distributionKeeper,
+		slashingKeeper:     slashingKeeper,
+		authority:          authority,
 	}
 }
 
+// GetAuthority returns the address allowed to execute governance gated messages
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetPriceSource sets the source of the prices used by assets with oracle priced reward weights.
+// Without a price source these assets keep their last reward weight
+func (k *Keeper) SetPriceSource(priceSource types.PriceSource) *Keeper {
+	k.priceSource = priceSource
+	return k
+}
+
+// SetHooks sets the petrichor hooks. It must be called before the keeper is handed to other modules since they
+// hold a copy of the keeper
+func (k *Keeper) SetHooks(hooks types.PetrichorHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set petrichor hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
+// PetrichorHooks returns the registered petrichor hooks, or hooks that do nothing when none are registered
+func (k Keeper) PetrichorHooks() types.PetrichorHooks {
+	if k.hooks == nil {
+		return types.MultiPetrichorHooks{}
+	}
+	return k.hooks
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
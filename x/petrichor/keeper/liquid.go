@@ -0,0 +1,373 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LiquidDelegate delegates the coin through the liquid staking pool and mints a transferable receipt to the delegator.
+// The pool module account owns the underlying delegation so the position can be moved by transferring the receipt.
+// One receipt unit is minted per delegation share that the pool receives
+func (k Keeper) LiquidDelegate(ctx sdk.Context, delAddr sdk.AccAddress, validator types.PetrichorValidator, coin sdk.Coin) (sdk.Coin, error) {
+	_, found := k.GetAssetByDenom(ctx, coin.Denom)
+	if !found {
+		return sdk.Coin{}, status.Errorf(codes.NotFound, "asset with denom: %s does not exist in petrichor whitelist", coin.Denom)
+	}
+
+	receiptDenom := types.GetLiquidReceiptDenom(validator.GetOperator(), coin.Denom)
+	receipt, found := k.GetLiquidReceipt(ctx, receiptDenom)
+	if !found {
+		receipt = types.LiquidReceipt{
+			Denom:            receiptDenom,
+			ValidatorAddress: validator.GetOperator().String(),
+			AssetDenom:       coin.Denom,
+		}
+	}
+
+	// Settle rewards before the receipt supply changes
+	receipt, err := k.settleLiquidReceiptRewards(ctx, receipt, validator)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	_, err = k.settleLiquidReceiptHolder(ctx, receipt, delAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+	sharesBefore := sdk.ZeroDec()
+	if poolDelegation, found := k.GetDelegation(ctx, poolAddr, validator, coin.Denom); found {
+		sharesBefore = poolDelegation.Shares
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, delAddr, types.LiquidStakingPoolName, sdk.NewCoins(coin))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	_, err = k.Delegate(ctx, poolAddr, validator, coin)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	poolDelegation, _ := k.GetDelegation(ctx, poolAddr, validator, coin.Denom)
+	minted := sdk.NewCoin(receiptDenom, poolDelegation.Shares.Sub(sharesBefore).TruncateInt())
+	if minted.IsZero() {
+		return sdk.Coin{}, types.ErrInsufficientReceipt
+	}
+	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(minted))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delAddr, sdk.NewCoins(minted))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	k.SetLiquidReceipt(ctx, receipt)
+	k.setLiquidReceiptHolderCheckpoint(ctx, receipt, delAddr)
+	return minted, nil
+}
+
+// RedeemLiquidReceipt burns the receipt and undelegates the underlying shares to the holder through Undelegate
+func (k Keeper) RedeemLiquidReceipt(ctx sdk.Context, holder sdk.AccAddress, coin sdk.Coin) (*time.Time, error) {
+	receipt, found := k.GetLiquidReceipt(ctx, coin.Denom)
+	if !found {
+		return nil, types.ErrUnknownLiquidReceipt
+	}
+	asset, found := k.GetAssetByDenom(ctx, receipt.AssetDenom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", receipt.AssetDenom)
+	}
+	valAddr, err := sdk.ValAddressFromBech32(receipt.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := k.GetPetrichorValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err = k.settleLiquidReceiptRewards(ctx, receipt, validator)
+	if err != nil {
+		return nil, err
+	}
+	_, err = k.settleLiquidReceiptHolder(ctx, receipt, holder)
+	if err != nil {
+		return nil, err
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+	poolDelegation, found := k.GetDelegation(ctx, poolAddr, validator, receipt.AssetDenom)
+	if !found {
+		return nil, stakingtypes.ErrNoDelegatorForAddress
+	}
+	shares := sdk.NewDecFromInt(coin.Amount)
+	if poolDelegation.Shares.LT(shares) {
+		return nil, stakingtypes.ErrInsufficientShares
+	}
+	tokens := types.ConvertNewShareToDecToken(
+		validator.TotalDecTokensWithAsset(asset),
+		validator.TotalDelegationSharesWithDenom(asset.Denom),
+		shares,
+	).TruncateInt()
+	if tokens.IsZero() {
+		return nil, types.ErrInsufficientReceipt
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, err
+	}
+	err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, err
+	}
+
+	// Move the shares from the pool to the holder so that the undelegation is paid out to the holder
+	_, found = k.GetDelegation(ctx, holder, validator, receipt.AssetDenom)
+	if found {
+		_, err = k.ClaimDelegationRewards(ctx, holder, validator, receipt.AssetDenom)
+		if err != nil {
+			return nil, err
+		}
+	}
+	k.reduceDelegationShares(ctx, poolAddr, validator, sdk.NewCoin(receipt.AssetDenom, tokens), shares, poolDelegation)
+	delegation, found := k.GetDelegation(ctx, holder, validator, receipt.AssetDenom)
+	if !found {
+		delegation = types.NewDelegation(ctx, holder, validator.GetOperator(), receipt.AssetDenom, shares, validator.GlobalRewardHistory)
+	} else {
+		delegation.Shares = delegation.Shares.Add(shares)
+	}
+	k.SetDelegation(ctx, holder, validator.GetOperator(), receipt.AssetDenom, delegation)
+
+	k.SetLiquidReceipt(ctx, receipt)
+	k.setLiquidReceiptHolderCheckpoint(ctx, receipt, holder)
+
+	return k.Undelegate(ctx, holder, validator, sdk.NewCoin(receipt.AssetDenom, tokens))
+}
+
+// ClaimLiquidReceiptRewards pays out the rewards accrued by the receipts held by the holder
+func (k Keeper) ClaimLiquidReceiptRewards(ctx sdk.Context, holder sdk.AccAddress, receiptDenom string) (sdk.Coins, error) {
+	receipt, found := k.GetLiquidReceipt(ctx, receiptDenom)
+	if !found {
+		return nil, types.ErrUnknownLiquidReceipt
+	}
+	valAddr, err := sdk.ValAddressFromBech32(receipt.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	validator, err := k.GetPetrichorValidator(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err = k.settleLiquidReceiptRewards(ctx, receipt, validator)
+	if err != nil {
+		return nil, err
+	}
+	k.SetLiquidReceipt(ctx, receipt)
+	return k.settleLiquidReceiptHolder(ctx, receipt, holder)
+}
+
+// BeforeLiquidReceiptTransfer pays every account of a transfer the rewards accrued on the receipts it held so far.
+// It is called by the bank keeper before receipts change hands so that rewards follow whoever holds the receipt.
+// Transfers from or to the petrichor module accounts are checkpointed by LiquidDelegate and RedeemLiquidReceipt
+func (k Keeper) BeforeLiquidReceiptTransfer(ctx sdk.Context, addrs []sdk.AccAddress, coins sdk.Coins) error {
+	if k.isLiquidReceiptModuleTransfer(addrs) {
+		return nil
+	}
+	for _, coin := range coins {
+		receipt, found := k.GetLiquidReceipt(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		valAddr, err := sdk.ValAddressFromBech32(receipt.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		// Receipts of a removed validator keep their reward index and can still be transferred
+		if validator, err := k.GetPetrichorValidator(ctx, valAddr); err == nil {
+			receipt, err = k.settleLiquidReceiptRewards(ctx, receipt, validator)
+			if err != nil {
+				return err
+			}
+			k.SetLiquidReceipt(ctx, receipt)
+		}
+		for _, addr := range addrs {
+			_, err = k.settleLiquidReceiptHolder(ctx, receipt, addr)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// AfterLiquidReceiptTransfer checkpoints the receipt balances of every account of a transfer
+func (k Keeper) AfterLiquidReceiptTransfer(ctx sdk.Context, addrs []sdk.AccAddress, coins sdk.Coins) {
+	if k.isLiquidReceiptModuleTransfer(addrs) {
+		return
+	}
+	for _, coin := range coins {
+		receipt, found := k.GetLiquidReceipt(ctx, coin.Denom)
+		if !found {
+			continue
+		}
+		for _, addr := range addrs {
+			k.setLiquidReceiptHolderCheckpoint(ctx, receipt, addr)
+		}
+	}
+}
+
+func (k Keeper) isLiquidReceiptModuleTransfer(addrs []sdk.AccAddress) bool {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+	for _, addr := range addrs {
+		if addr.Equals(moduleAddr) || addr.Equals(poolAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// settleLiquidReceiptRewards claims the rewards of the pool delegation with CalculateDelegationRewards and
+// spreads them across the receipt supply so that rewards follow whoever holds the receipt
+func (k Keeper) settleLiquidReceiptRewards(ctx sdk.Context, receipt types.LiquidReceipt, validator types.PetrichorValidator) (types.LiquidReceipt, error) {
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+	_, found := k.GetDelegation(ctx, poolAddr, validator, receipt.AssetDenom)
+	if !found {
+		return receipt, nil
+	}
+	coins, err := k.claimDelegationRewards(ctx, poolAddr, validator, receipt.AssetDenom, poolAddr)
+	if err != nil {
+		return receipt, err
+	}
+	supply := k.bankKeeper.GetSupply(ctx, receipt.Denom)
+	if supply.IsZero() {
+		return receipt, nil
+	}
+	rewardHistories := types.NewRewardHistories(receipt.RewardHistory)
+	for _, c := range coins {
+		rewardHistory, found := rewardHistories.GetIndexByDenom(c.Denom)
+		if !found {
+			rewardHistories = append(rewardHistories, types.RewardHistory{
+				Denom: c.Denom,
+				Index: sdk.NewDecFromInt(c.Amount).QuoInt(supply.Amount),
+			})
+		} else {
+			rewardHistory.Index = rewardHistory.Index.Add(sdk.NewDecFromInt(c.Amount).QuoInt(supply.Amount))
+		}
+	}
+	receipt.RewardHistory = rewardHistories
+	return receipt, nil
+}
+
+// settleLiquidReceiptHolder pays the holder the rewards accrued since its last checkpoint.
+// The bank keeper checkpoints both sides of every transfer, so the checkpointed balance is the one held since then.
+// Only the lower of the checkpointed and current balance is eligible so that balance changes the bank keeper does
+// not report, such as burns by other modules, never pay out more than was held
+func (k Keeper) settleLiquidReceiptHolder(ctx sdk.Context, receipt types.LiquidReceipt, holder sdk.AccAddress) (sdk.Coins, error) {
+	rewards := sdk.NewCoins()
+	checkpoint, found := k.GetLiquidReceiptHolder(ctx, receipt.Denom, holder)
+	if found {
+		balance := k.bankKeeper.GetBalance(ctx, holder, receipt.Denom).Amount
+		rewards = calculateLiquidReceiptRewards(receipt, checkpoint, math.MinInt(balance, checkpoint.Balance))
+	}
+	k.setLiquidReceiptHolderCheckpoint(ctx, receipt, holder)
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidStakingPoolName, k.GetRewardWithdrawAddress(ctx, holder), rewards)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, nil
+}
+
+func calculateLiquidReceiptRewards(receipt types.LiquidReceipt, checkpoint types.LiquidReceiptHolder, balance math.Int) sdk.Coins {
+	rewards := sdk.NewCoins()
+	holderHistories := types.NewRewardHistories(checkpoint.RewardHistory)
+	for _, history := range receipt.RewardHistory {
+		index := sdk.ZeroDec()
+		if holderHistory, found := holderHistories.GetIndexByDenom(history.Denom); found {
+			index = holderHistory.Index
+		}
+		if index.GTE(history.Index) {
+			continue
+		}
+		rewards = rewards.Add(sdk.NewCoin(history.Denom, history.Index.Sub(index).MulInt(balance).TruncateInt()))
+	}
+	return rewards
+}
+
+func (k Keeper) setLiquidReceiptHolderCheckpoint(ctx sdk.Context, receipt types.LiquidReceipt, holder sdk.AccAddress) {
+	k.SetLiquidReceiptHolder(ctx, types.LiquidReceiptHolder{
+		HolderAddress: holder.String(),
+		ReceiptDenom:  receipt.Denom,
+		Balance:       k.bankKeeper.GetBalance(ctx, holder, receipt.Denom).Amount,
+		RewardHistory: receipt.RewardHistory,
+	})
+}
+
+func (k Keeper) GetLiquidReceipt(ctx sdk.Context, receiptDenom string) (receipt types.LiquidReceipt, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetLiquidReceiptKey(receiptDenom))
+	if b == nil {
+		return receipt, false
+	}
+	k.cdc.MustUnmarshal(b, &receipt)
+	return receipt, true
+}
+
+func (k Keeper) SetLiquidReceipt(ctx sdk.Context, receipt types.LiquidReceipt) {
+	b := k.cdc.MustMarshal(&receipt)
+	ctx.KVStore(k.storeKey).Set(types.GetLiquidReceiptKey(receipt.Denom), b)
+}
+
+func (k Keeper) IterateLiquidReceipts(ctx sdk.Context, cb func(receipt types.LiquidReceipt) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LiquidReceiptKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var receipt types.LiquidReceipt
+		k.cdc.MustUnmarshal(iter.Value(), &receipt)
+		if cb(receipt) {
+			return
+		}
+	}
+}
+
+func (k Keeper) GetLiquidReceiptHolder(ctx sdk.Context, receiptDenom string, holder sdk.AccAddress) (h types.LiquidReceiptHolder, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetLiquidReceiptHolderKey(receiptDenom, holder))
+	if b == nil {
+		return h, false
+	}
+	k.cdc.MustUnmarshal(b, &h)
+	return h, true
+}
+
+func (k Keeper) SetLiquidReceiptHolder(ctx sdk.Context, h types.LiquidReceiptHolder) {
+	holder, err := sdk.AccAddressFromBech32(h.HolderAddress)
+	if err != nil {
+		panic(err)
+	}
+	b := k.cdc.MustMarshal(&h)
+	ctx.KVStore(k.storeKey).Set(types.GetLiquidReceiptHolderKey(h.ReceiptDenom, holder), b)
+}
+
+func (k Keeper) IterateLiquidReceiptHolders(ctx sdk.Context, cb func(h types.LiquidReceiptHolder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.LiquidReceiptHolderKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var h types.LiquidReceiptHolder
+		k.cdc.MustUnmarshal(iter.Value(), &h)
+		if cb(h) {
+			return
+		}
+	}
+}
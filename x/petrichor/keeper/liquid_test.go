@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	test_helpers "github.com/petrinetwork/petrichor/app"
+	"github.com/petrinetwork/petrichor/x/petrichor"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLiquidDelegateAndRedeem(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+	poolAddr := app.AccountKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+
+	receipt, err := app.PetrichorKeeper.LiquidDelegate(ctx, user1, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	receiptDenom := types.GetLiquidReceiptDenom(valAddr, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewCoin(receiptDenom, sdk.NewInt(1000_000)), receipt)
+	require.Equal(t, receipt, app.BankKeeper.GetBalance(ctx, user1, receiptDenom))
+
+	// The delegation is owned by the pool, not the user
+	_, found := app.PetrichorKeeper.GetDelegation(ctx, user1, val, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+	poolDelegation, found := app.PetrichorKeeper.GetDelegation(ctx, poolAddr, val, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(1000_000), poolDelegation.Shares)
+
+	// Receipts can be transferred
+	err = app.BankKeeper.SendCoins(ctx, user1, user2, sdk.NewCoins(sdk.NewCoin(receiptDenom, sdk.NewInt(400_000))))
+	require.NoError(t, err)
+
+	// Redeeming receipts undelegates the underlying tokens to the holder
+	_, err = app.PetrichorKeeper.RedeemLiquidReceipt(ctx, user2, sdk.NewCoin(receiptDenom, sdk.NewInt(400_000)))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, user2, receiptDenom).IsZero())
+	require.Equal(t, sdk.NewInt(600_000), app.BankKeeper.GetSupply(ctx, receiptDenom).Amount)
+	poolDelegation, _ = app.PetrichorKeeper.GetDelegation(ctx, poolAddr, val, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewDec(600_000), poolDelegation.Shares)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user2, val, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+
+	var undelegations []types.Undelegation
+	app.PetrichorKeeper.IterateUndelegations(ctx, func(u types.QueuedUndelegation, _ time.Time) bool {
+		for _, e := range u.Entries {
+			undelegations = append(undelegations, *e)
+		}
+		return false
+	})
+	require.Equal(t, []types.Undelegation{
+		{
+			DelegatorAddress: user2.String(),
+			ValidatorAddress: valAddr.String(),
+			Balance:          sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(400_000)),
+		},
+	}, undelegations)
+
+	// Unknown receipts cannot be redeemed
+	_, err = app.PetrichorKeeper.RedeemLiquidReceipt(ctx, user1, sdk.NewCoin(types.LiquidReceiptDenomPrefix+"unknown", sdk.NewInt(1)))
+	require.ErrorIs(t, err, types.ErrUnknownLiquidReceipt)
+}
+
+func TestLiquidReceiptTransferWithoutSettling(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(4000_000))))
+	require.NoError(t, err)
+
+	receipt, err := app.PetrichorKeeper.LiquidDelegate(ctx, user1, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+
+	// Neither side settles before the transfer, the sender is paid what it accrued while holding the receipts
+	stakeBefore := app.BankKeeper.GetBalance(ctx, user1, "stake")
+	err = app.BankKeeper.SendCoins(ctx, user1, user2, sdk.NewCoins(receipt))
+	require.NoError(t, err)
+	require.Equal(t, stakeBefore.AddAmount(sdk.NewInt(2000_000)), app.BankKeeper.GetBalance(ctx, user1, "stake"))
+
+	// The receiver accrues from the transfer on without having to checkpoint first
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+	coins, err := app.PetrichorKeeper.ClaimLiquidReceiptRewards(ctx, user1, receipt.Denom)
+	require.NoError(t, err)
+	require.True(t, coins.IsZero())
+	coins, err = app.PetrichorKeeper.ClaimLiquidReceiptRewards(ctx, user2, receipt.Denom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))), coins)
+
+	// Nothing is left behind in the liquid staking pool
+	poolAddr := app.AccountKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+	require.True(t, app.BankKeeper.GetBalance(ctx, poolAddr, "stake").IsZero())
+}
+
+func TestLiquidReceiptRewardsFollowHolder(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1 := addrs[0]
+	user2 := addrs[1]
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(4000_000))))
+	require.NoError(t, err)
+
+	receipt, err := app.PetrichorKeeper.LiquidDelegate(ctx, user1, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	// user2 checkpoints before receiving receipts
+	_, err = app.PetrichorKeeper.ClaimLiquidReceiptRewards(ctx, user2, receipt.Denom)
+	require.NoError(t, err)
+
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+
+	// user1 holds all receipts while the rewards are distributed
+	coins, err := app.PetrichorKeeper.ClaimLiquidReceiptRewards(ctx, user1, receipt.Denom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))), coins)
+
+	// Rewards distributed after the transfer belong to the new holder
+	err = app.BankKeeper.SendCoins(ctx, user1, user2, sdk.NewCoins(receipt))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.ClaimLiquidReceiptRewards(ctx, user2, receipt.Denom)
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+
+	coins, err = app.PetrichorKeeper.ClaimLiquidReceiptRewards(ctx, user1, receipt.Denom)
+	require.NoError(t, err)
+	require.True(t, coins.IsZero())
+	coins, err = app.PetrichorKeeper.ClaimLiquidReceiptRewards(ctx, user2, receipt.Denom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))), coins)
+
+	// Receipts and holder checkpoints are exported in genesis
+	state := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Len(t, state.LiquidReceipts, 1)
+	require.Len(t, state.LiquidReceiptHolders, 2)
+	require.NoError(t, petrichor.ValidateGenesis(state))
+
+	// Malformed holders are rejected by validation instead of panicking in InitGenesis
+	invalid := *state
+	invalid.LiquidReceiptHolders = []types.LiquidReceiptHolder{
+		{HolderAddress: "invalid", ReceiptDenom: receipt.Denom, Balance: sdk.ZeroInt()},
+	}
+	require.ErrorIs(t, petrichor.ValidateGenesis(&invalid), types.ErrInvalidGenesisState)
+	invalid.LiquidReceiptHolders = []types.LiquidReceiptHolder{
+		{HolderAddress: user1.String(), ReceiptDenom: "unknown", Balance: sdk.ZeroInt()},
+	}
+	require.ErrorIs(t, petrichor.ValidateGenesis(&invalid), types.ErrInvalidGenesisState)
+	invalid.LiquidReceiptHolders = state.LiquidReceiptHolders
+	invalid.LiquidReceipts = []types.LiquidReceipt{
+		{Denom: receipt.Denom, ValidatorAddress: "invalid", AssetDenom: PETRICHOR_TOKEN_DENOM},
+	}
+	require.ErrorIs(t, petrichor.ValidateGenesis(&invalid), types.ErrInvalidGenesisState)
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate3to4 migrates the store from consensus version 3 to 4.
+// The params added since version 3 are set to their defaults so that reading them does not panic, except for the
+// reward weight cap which stays disabled until governance sets one,
+// and the indexes added since version 3 are built from the existing entries
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	ps := m.keeper.paramstore
+	defaults := types.DefaultParams()
+	if !ps.Has(ctx, types.AutoCompoundInterval) {
+		ps.Set(ctx, types.AutoCompoundInterval, defaults.AutoCompoundInterval)
+	}
+	if !ps.Has(ctx, types.LastAutoCompoundTime) {
+		ps.Set(ctx, types.LastAutoCompoundTime, ctx.BlockTime())
+	}
+	if !ps.Has(ctx, types.TakeRateSplits) {
+		ps.Set(ctx, types.TakeRateSplits, []types.TakeRateSplit{})
+	}
+	if !ps.Has(ctx, types.AutoRedelegateTombstoned) {
+		ps.Set(ctx, types.AutoRedelegateTombstoned, false)
+	}
+	if !ps.Has(ctx, types.MaxTotalRewardWeight) {
+		ps.Set(ctx, types.MaxTotalRewardWeight, sdk.ZeroDec())
+	}
+	if !ps.Has(ctx, types.TakeRateHistoryRetention) {
+		ps.Set(ctx, types.TakeRateHistoryRetention, defaults.TakeRateHistoryRetention)
+	}
+
+	m.keeper.IterateUndelegations(ctx, func(queue types.QueuedUndelegation, completionTime time.Time) (stop bool) {
+		if len(queue.Entries) == 0 {
+			return false
+		}
+		delAddr := sdk.MustAccAddressFromBech32(queue.Entries[0].DelegatorAddress)
+		m.keeper.setQueuedUndelegations(ctx, completionTime, delAddr, queue)
+		return false
+	})
+	store := ctx.KVStore(m.keeper.storeKey)
+	m.keeper.IterateDelegations(ctx, func(d types.Delegation) (stop bool) {
+		delAddr := sdk.MustAccAddressFromBech32(d.DelegatorAddress)
+		valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		key := types.GetDelegationKey(delAddr, valAddr, d.Denom)
+		store.Set(types.GetDelegationByValidatorIndexKey(valAddr, d.Denom, delAddr), key)
+		store.Set(types.GetDelegationByDenomIndexKey(d.Denom, valAddr, delAddr), key)
+		return false
+	})
+	m.keeper.IterateRedelegations(ctx, func(redelegation types.Redelegation, completionTime time.Time) (stop bool) {
+		delAddr := sdk.MustAccAddressFromBech32(redelegation.DelegatorAddress)
+		dstValAddr, err := sdk.ValAddressFromBech32(redelegation.DstValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.GetRedelegationByDenomIndexKey(redelegation.Balance.Denom, delAddr, dstValAddr, completionTime), []byte{})
+		return false
+	})
+	return nil
+}
@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
+	test_helpers "github.com/petrinetwork/petrichor/app"
+	"github.com/petrinetwork/petrichor/x/petrichor/keeper"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now())
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user := addrs[0]
+	valAddr2 := sdk.ValAddress(addrs[1])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0]))
+	val2, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	val, err = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, val, val2, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(200_000)))
+	require.NoError(t, err)
+
+	// GIVEN a chain upgraded from version 3 without the params that were added later
+	store := prefix.NewStore(ctx.KVStore(app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.AutoCompoundInterval,
+		types.LastAutoCompoundTime,
+		types.TakeRateSplits,
+		types.AutoRedelegateTombstoned,
+		types.MaxTotalRewardWeight,
+	} {
+		store.Delete(key)
+	}
+	petrichorStore := ctx.KVStore(app.GetKey(types.StoreKey))
+	for _, indexPrefix := range [][]byte{types.UndelegationByDelegatorIndexKey, types.UndelegationByDenomIndexKey, types.RedelegationByDenomIndexKey, types.DelegationByValidatorIndexKey, types.DelegationByDenomIndexKey} {
+		iter := sdk.KVStorePrefixIterator(petrichorStore, indexPrefix)
+		for ; iter.Valid(); iter.Next() {
+			petrichorStore.Delete(iter.Key())
+		}
+		iter.Close()
+	}
+	require.Panics(t, func() { app.PetrichorKeeper.AutoCompoundInterval(ctx) })
+
+	// WHEN
+	err = keeper.NewMigrator(app.PetrichorKeeper).Migrate3to4(ctx)
+
+	// THEN the params are set to their defaults
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams().AutoCompoundInterval, app.PetrichorKeeper.AutoCompoundInterval(ctx))
+	require.Equal(t, ctx.BlockTime().UTC(), app.PetrichorKeeper.LastAutoCompoundTime(ctx).UTC())
+	require.Empty(t, app.PetrichorKeeper.TakeRateSplits(ctx))
+	require.False(t, app.PetrichorKeeper.AutoRedelegateTombstoned(ctx))
+	require.True(t, app.PetrichorKeeper.MaxTotalRewardWeight(ctx).IsZero())
+	require.NotPanics(t, func() { app.PetrichorKeeper.AutoCompoundHook(ctx) })
+
+	// ... and the undelegation indexes are built
+	queryServer := keeper.NewQueryServerImpl(app.PetrichorKeeper)
+	res, err := queryServer.PetrichorUndelegations(ctx, &types.QueryPetrichorUndelegationsRequest{
+		DelegatorAddr: user.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Undelegations, 1)
+	res, err = queryServer.PetrichorUndelegationsByAsset(ctx, &types.QueryPetrichorUndelegationsByAssetRequest{
+		Denom: PETRICHOR_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Undelegations, 1)
+
+	// ... and so is the redelegation index
+	redelegations, err := queryServer.PetrichorRedelegationsByAsset(ctx, &types.QueryPetrichorRedelegationsByAssetRequest{
+		Denom: PETRICHOR_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Len(t, redelegations.Redelegations, 1)
+
+	// ... and so are the delegation indexes
+	var byValidator, byDenom []types.Delegation
+	app.PetrichorKeeper.IterateDelegationsByValidator(ctx, valAddr2, func(d types.Delegation) (stop bool) {
+		byValidator = append(byValidator, d)
+		return false
+	})
+	require.Len(t, byValidator, 1)
+	app.PetrichorKeeper.IterateDelegationsByDenom(ctx, PETRICHOR_TOKEN_DENOM, func(d types.Delegation) (stop bool) {
+		byDenom = append(byDenom, d)
+		return false
+	})
+	require.Len(t, byDenom, 2)
+}
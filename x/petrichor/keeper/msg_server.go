@@ -3,9 +3,12 @@ package keeper
 import (
 	"context"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"strconv"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 type MsgServer struct {
@@ -170,6 +173,575 @@ func (m MsgServer) ClaimDelegationRewards(ctx context.Context, msg *types.MsgCla
 	return &types.MsgClaimDelegationRewardsResponse{}, err
 }
 
+func (m MsgServer) LiquidDelegate(ctx context.Context, msg *types.MsgLiquidDelegate) (*types.MsgLiquidDelegateResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := m.Keeper.GetPetrichorValidator(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt, err := m.Keeper.LiquidDelegate(sdkCtx, delAddr, validator, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeLiquidDelegate,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReceipt, receipt.String()),
+		),
+	})
+
+	return &types.MsgLiquidDelegateResponse{Receipt: receipt}, nil
+}
+
+func (m MsgServer) RedeemLiquidReceipt(ctx context.Context, msg *types.MsgRedeemLiquidReceipt) (*types.MsgRedeemLiquidReceiptResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	holderAddr, err := sdk.AccAddressFromBech32(msg.HolderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := m.Keeper.RedeemLiquidReceipt(sdkCtx, holderAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedeemLiquidReceipt,
+			sdk.NewAttribute(types.AttributeKeyReceipt, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+	})
+	return &types.MsgRedeemLiquidReceiptResponse{}, nil
+}
+
+func (m MsgServer) ClaimLiquidReceiptRewards(ctx context.Context, msg *types.MsgClaimLiquidReceiptRewards) (*types.MsgClaimLiquidReceiptRewardsResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	holderAddr, err := sdk.AccAddressFromBech32(msg.HolderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	coins, err := m.Keeper.ClaimLiquidReceiptRewards(sdkCtx, holderAddr, msg.ReceiptDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClaimReceiptRewards,
+			sdk.NewAttribute(types.AttributeKeyReceipt, msg.ReceiptDenom),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+		),
+	})
+	return &types.MsgClaimLiquidReceiptRewardsResponse{}, nil
+}
+
+func (m MsgServer) SetAutoCompound(ctx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := m.Keeper.GetPetrichorValidator(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.SetAutoCompound(sdkCtx, delAddr, validator, msg.Denom, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetAutoCompound,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(msg.Enabled)),
+		),
+	})
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
+
+func (m MsgServer) SetRewardWithdrawAddress(ctx context.Context, msg *types.MsgSetRewardWithdrawAddress) (*types.MsgSetRewardWithdrawAddressResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	withdrawAddr, err := sdk.AccAddressFromBech32(msg.WithdrawAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.SetRewardWithdrawAddress(sdkCtx, delAddr, withdrawAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetWithdrawAddress,
+			sdk.NewAttribute(types.AttributeKeyWithdrawAddr, msg.WithdrawAddress),
+		),
+	})
+	return &types.MsgSetRewardWithdrawAddressResponse{}, nil
+}
+
+func (m MsgServer) ClaimAllDelegationRewards(ctx context.Context, msg *types.MsgClaimAllDelegationRewards) (*types.MsgClaimAllDelegationRewardsResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var valAddr sdk.ValAddress
+	if msg.ValidatorAddress != "" {
+		valAddr, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	delegations, rewards, nextKey, err := m.Keeper.ClaimAllDelegationRewards(sdkCtx, delAddr, valAddr, msg.Denom, msg.Limit, msg.StartKey)
+	if err != nil {
+		return nil, err
+	}
+
+	total := sdk.NewCoins()
+	for i, delegation := range delegations {
+		total = total.Add(rewards[i]...)
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeClaimDelegationRewards,
+				sdk.NewAttribute(types.AttributeKeyValidator, delegation.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDenom, delegation.Denom),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, rewards[i].String()),
+			),
+		)
+	}
+	return &types.MsgClaimAllDelegationRewardsResponse{
+		Rewards: total,
+		Claimed: uint32(len(delegations)),
+		NextKey: nextKey,
+	}, nil
+}
+
+func (m MsgServer) CancelUndelegation(ctx context.Context, msg *types.MsgCancelUndelegation) (*types.MsgCancelUndelegationResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validator, err := m.Keeper.GetPetrichorValidator(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.CancelUndelegation(sdkCtx, delAddr, validator, msg.Amount, msg.CompletionTime)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCancelUndelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, msg.CompletionTime.Format(time.RFC3339)),
+		),
+	})
+	return &types.MsgCancelUndelegationResponse{}, nil
+}
+
+func (m MsgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	err := m.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.UpdateParams(sdkCtx, msg.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdateParams,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyRewardDelay, msg.Params.RewardDelayTime.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimInterval, msg.Params.TakeRateClaimInterval.String()),
+			sdk.NewAttribute(types.AttributeKeyCompoundPeriod, msg.Params.AutoCompoundInterval.String()),
+		),
+	})
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (m MsgServer) CreatePetrichor(ctx context.Context, msg *types.MsgCreatePetrichor) (*types.MsgCreatePetrichorResponse, error) {
+	err := m.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.createPetrichor(sdkCtx, msg.AssetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreatePetrichor,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	})
+	return &types.MsgCreatePetrichorResponse{}, nil
+}
+
+func (m MsgServer) UpdatePetrichor(ctx context.Context, msg *types.MsgUpdatePetrichor) (*types.MsgUpdatePetrichorResponse, error) {
+	err := m.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.updatePetrichor(sdkCtx, msg.AssetConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUpdatePetrichor,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	})
+	return &types.MsgUpdatePetrichorResponse{}, nil
+}
+
+func (m MsgServer) DeletePetrichor(ctx context.Context, msg *types.MsgDeletePetrichor) (*types.MsgDeletePetrichorResponse, error) {
+	err := m.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.DeletePetrichor(sdkCtx, &types.MsgDeletePetrichorProposal{
+		Denom: msg.Denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDeletePetrichor,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	})
+	return &types.MsgDeletePetrichorResponse{}, nil
+}
+
+func (m MsgServer) BatchUpdatePetrichors(ctx context.Context, msg *types.MsgBatchUpdatePetrichors) (*types.MsgBatchUpdatePetrichorsResponse, error) {
+	err := m.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.BatchUpdatePetrichors(sdkCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var events sdk.Events
+	for _, asset := range msg.CreateAssets {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeCreatePetrichor,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+		))
+	}
+	for _, asset := range msg.UpdateAssets {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeUpdatePetrichor,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+		))
+	}
+	for _, denom := range msg.DeleteDenoms {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeDeletePetrichor,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		))
+	}
+	sdkCtx.EventManager().EmitEvents(events)
+	return &types.MsgBatchUpdatePetrichorsResponse{}, nil
+}
+
+func (m MsgServer) DelistPetrichor(ctx context.Context, msg *types.MsgDelistPetrichor) (*types.MsgDelistPetrichorResponse, error) {
+	err := m.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	err = m.Keeper.DelistPetrichor(sdkCtx, &types.MsgDelistPetrichorProposal{
+		Denom: msg.Denom,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelistPetrichor,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		),
+	})
+	return &types.MsgDelistPetrichorResponse{}, nil
+}
+
+func (m MsgServer) SweepDustDelegations(ctx context.Context, msg *types.MsgSweepDustDelegations) (*types.MsgSweepDustDelegationsResponse, error) {
+	err := m.checkAuthority(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	swept, err := m.Keeper.SweepDustDelegations(sdkCtx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSweepDustDelegations,
+			sdk.NewAttribute(types.AttributeKeyAuthority, msg.Authority),
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeySwept, strconv.FormatUint(swept, 10)),
+		),
+	})
+	return &types.MsgSweepDustDelegationsResponse{Swept: swept}, nil
+}
+
+func (m MsgServer) SetValidatorDenomFilter(ctx context.Context, msg *types.MsgSetValidatorDenomFilter) (*types.MsgSetValidatorDenomFilterResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.SetDenomFilter(sdkCtx, valAddr, msg.Mode, msg.Denoms, msg.OptOutPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetDenomFilter,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyFilterMode, msg.Mode.String()),
+			sdk.NewAttribute(types.AttributeKeyDenom, strings.Join(msg.Denoms, ",")),
+			sdk.NewAttribute(types.AttributeKeyOptOutPolicy, msg.OptOutPolicy.String()),
+		),
+	})
+	return &types.MsgSetValidatorDenomFilterResponse{}, nil
+}
+
+func (m MsgServer) SetPetrichorCommission(ctx context.Context, msg *types.MsgSetPetrichorCommission) (*types.MsgSetPetrichorCommissionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	err = m.Keeper.SetValidatorCommission(sdkCtx, valAddr, msg.Rate, msg.MaxRate, msg.MaxChangeRate)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetCommission,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.Rate.String()),
+		),
+	})
+	return &types.MsgSetPetrichorCommissionResponse{}, nil
+}
+
+func (m MsgServer) WithdrawPetrichorCommission(ctx context.Context, msg *types.MsgWithdrawPetrichorCommission) (*types.MsgWithdrawPetrichorCommissionResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	commission, err := m.Keeper.WithdrawValidatorCommission(sdkCtx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeWithdrawCommission,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, commission.String()),
+		),
+	})
+	return &types.MsgWithdrawPetrichorCommissionResponse{Amount: commission}, nil
+}
+
+func (m MsgServer) SetFallbackValidator(ctx context.Context, msg *types.MsgSetFallbackValidator) (*types.MsgSetFallbackValidatorResponse, error) {
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	delAddr, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	var valAddr sdk.ValAddress
+	if msg.ValidatorAddress != "" {
+		valAddr, err = sdk.ValAddressFromBech32(msg.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = m.Keeper.SetDelegatorFallbackValidator(sdkCtx, delAddr, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetFallbackValidator,
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	})
+	return &types.MsgSetFallbackValidatorResponse{}, nil
+}
+
+// checkAuthority makes sure governance gated messages are signed by the module authority
+func (m MsgServer) checkAuthority(authority string) error {
+	if m.Keeper.GetAuthority() != authority {
+		return govtypes.ErrInvalidSigner.Wrapf("invalid authority; expected %s, got %s", m.Keeper.GetAuthority(), authority)
+	}
+	return nil
+}
+
 // NewMsgServerImpl returns an implementation of the MsgServer interface
 // for the provided Keeper.
 func NewMsgServerImpl(keeper Keeper) types.MsgServer {
@@ -25,3 +25,113 @@ func (k Keeper) LastRewardClaimTime(ctx sdk.Context) (res time.Time) {
 func (k Keeper) SetLastRewardClaimTime(ctx sdk.Context, lastTime time.Time) {
 	k.paramstore.Set(ctx, types.LastTakeRateClaimTime, &lastTime)
 }
+
+func (k Keeper) AutoCompoundInterval(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.Get(ctx, types.AutoCompoundInterval, &res)
+	return
+}
+
+func (k Keeper) LastAutoCompoundTime(ctx sdk.Context) (res time.Time) {
+	k.paramstore.Get(ctx, types.LastAutoCompoundTime, &res)
+	return
+}
+
+func (k Keeper) SetLastAutoCompoundTime(ctx sdk.Context, lastTime time.Time) {
+	k.paramstore.Set(ctx, types.LastAutoCompoundTime, &lastTime)
+}
+
+// TakeRateSplits returns the module wide split of take rate proceeds. The param did not exist in earlier versions
+// so a missing value means everything goes to the fee collector
+func (k Keeper) TakeRateSplits(ctx sdk.Context) (res []types.TakeRateSplit) {
+	k.paramstore.GetIfExists(ctx, types.TakeRateSplits, &res)
+	return
+}
+
+// AutoRedelegateTombstoned returns whether delegations are moved away from tombstoned validators. The param did not
+// exist in earlier versions so a missing value means it is disabled
+func (k Keeper) AutoRedelegateTombstoned(ctx sdk.Context) (res bool) {
+	k.paramstore.GetIfExists(ctx, types.AutoRedelegateTombstoned, &res)
+	return
+}
+
+// MaxTotalRewardWeight returns the cap on the sum of the reward weights of all assets. The param did not exist in
+// earlier versions so a missing value means there is no cap, the same as zero
+func (k Keeper) MaxTotalRewardWeight(ctx sdk.Context) sdk.Dec {
+	var res sdk.Dec
+	k.paramstore.GetIfExists(ctx, types.MaxTotalRewardWeight, &res)
+	if res.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return res
+}
+
+// TakeRateHistoryRetention returns how long take rate records are kept. The param did not exist in earlier versions
+// so a missing value means the history is not pruned
+func (k Keeper) TakeRateHistoryRetention(ctx sdk.Context) (res time.Duration) {
+	k.paramstore.GetIfExists(ctx, types.TakeRateHistoryRetention, &res)
+	return
+}
+
+// UpdateParams validates the new params against the current module state and stores them.
+// The last take rate claim and auto-compound times are bookkeeping values and are kept from the current params.
+// When the take rate claim interval or the module wide take rate splits change, the take rate accrued under the old
+// values is deducted first
+func (k Keeper) UpdateParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidParams.Wrap(err.Error())
+	}
+	if err := k.ValidateParams(ctx, params); err != nil {
+		return err
+	}
+
+	if params.TakeRateClaimInterval != k.RewardClaimInterval(ctx) || !takeRateSplitsEqual(params.TakeRateSplits, k.TakeRateSplits(ctx)) {
+		if _, err := k.DeductAssetsHook(ctx, k.GetAllAssets(ctx)); err != nil {
+			return err
+		}
+	}
+
+	params.LastTakeRateClaimTime = k.LastRewardClaimTime(ctx)
+	params.LastAutoCompoundTime = k.LastAutoCompoundTime(ctx)
+	k.SetParams(ctx, params)
+	return nil
+}
+
+// ValidateParams checks the params against the current module state. Stateless checks, such as the auto-compound
+// interval, are done by Params.Validate.
+// Assets without their own take rate splits route their take rate through the module wide splits, so an insured asset
+// that relies on them must keep funding the insurance fund once the module wide splits do
+func (k Keeper) ValidateParams(ctx sdk.Context, params types.Params) error {
+	if !fundsInsurance(k.TakeRateSplits(ctx)) || fundsInsurance(params.TakeRateSplits) {
+		return nil
+	}
+	for _, asset := range k.GetAllAssets(ctx) {
+		if len(asset.TakeRateSplits) > 0 || !asset.TakeRate.IsPositive() {
+			continue
+		}
+		if asset.InsuranceCoverageCeiling != nil && !asset.InsuranceCoverageCeiling.IsNil() && asset.InsuranceCoverageCeiling.IsPositive() {
+			return types.ErrInvalidParams.Wrapf("take_rate_splits must fund the insurance fund that covers %s", asset.Denom)
+		}
+	}
+	return nil
+}
+
+func fundsInsurance(splits []types.TakeRateSplit) bool {
+	for _, split := range splits {
+		if split.Destination == types.TakeRateDestinationInsuranceFund {
+			return true
+		}
+	}
+	return false
+}
+
+func takeRateSplitsEqual(a, b []types.TakeRateSplit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Destination != b[i].Destination || !a[i].Ratio.Equal(b[i].Ratio) {
+			return false
+		}
+	}
+	return true
+}
@@ -4,36 +4,127 @@ import (
 	"context"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 func (k Keeper) CreatePetrichor(ctx context.Context, req *types.MsgCreatePetrichorProposal) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	_, found := k.GetAssetByDenom(sdkCtx, req.Denom)
+	return k.createPetrichor(sdk.UnwrapSDKContext(ctx), req.AssetConfig())
+}
 
+func (k Keeper) UpdatePetrichor(ctx context.Context, req *types.MsgUpdatePetrichorProposal) error {
+	return k.updatePetrichor(sdk.UnwrapSDKContext(ctx), req.AssetConfig())
+}
+
+// createPetrichor creates a new asset from the fields set by a message or a proposal
+func (k Keeper) createPetrichor(ctx sdk.Context, config types.PetrichorAssetConfig) error {
+	totalWeight := k.totalRewardWeight(ctx)
+	err := k.checkTotalRewardWeight(ctx, totalWeight, totalWeight.Add(config.RewardWeight))
+	if err != nil {
+		return err
+	}
+	return k.addAsset(ctx, config)
+}
+
+// updatePetrichor updates an existing asset with the fields set by a message or a proposal
+func (k Keeper) updatePetrichor(ctx sdk.Context, config types.PetrichorAssetConfig) error {
+	totalWeight := k.totalRewardWeight(ctx)
+	newTotalWeight := totalWeight.Add(config.RewardWeight)
+	if asset, found := k.GetAssetByDenom(ctx, config.Denom); found {
+		newTotalWeight = newTotalWeight.Sub(asset.RewardWeight)
+	}
+	err := k.checkTotalRewardWeight(ctx, totalWeight, newTotalWeight)
+	if err != nil {
+		return err
+	}
+	return k.updateAsset(ctx, config)
+}
+
+func (k Keeper) addAsset(ctx sdk.Context, config types.PetrichorAssetConfig) error {
+	_, found := k.GetAssetByDenom(ctx, config.Denom)
 	if found {
-		return status.Errorf(codes.AlreadyExists, "Asset with denom: %s already exists", req.Denom)
+		return status.Errorf(codes.AlreadyExists, "Asset with denom: %s already exists", config.Denom)
+	}
+	err := k.checkPriceSource(config)
+	if err != nil {
+		return err
+	}
+	err = k.PetrichorHooks().BeforeCreatePetrichorAsset(ctx, config.Denom)
+	if err != nil {
+		return err
 	}
 
-	rewardStartTime := sdkCtx.BlockTime().Add(k.RewardDelayTime(sdkCtx))
+	rewardStartTime := ctx.BlockTime().Add(k.RewardDelayTime(ctx))
 	asset := types.PetrichorAsset{
-		Denom:                req.Denom,
-		RewardWeight:         req.RewardWeight,
-		TakeRate:             req.TakeRate,
+		Denom:                config.Denom,
 		TotalTokens:          sdk.ZeroInt(),
 		TotalValidatorShares: sdk.ZeroDec(),
 		RewardStartTime:      rewardStartTime,
-		RewardChangeRate:     req.RewardChangeRate,
-		RewardChangeInterval: req.RewardChangeInterval,
 		LastRewardChangeTime: rewardStartTime,
 	}
-	k.SetAsset(sdkCtx, asset)
-	return nil
+	applyAssetConfig(&asset, config)
+	k.SetAsset(ctx, asset)
+	return k.PetrichorHooks().AfterCreatePetrichorAsset(ctx, asset)
 }
 
-func (k Keeper) UpdatePetrichor(ctx context.Context, req *types.MsgUpdatePetrichorProposal) error {
+func (k Keeper) updateAsset(ctx sdk.Context, config types.PetrichorAssetConfig) error {
+	asset, found := k.GetAssetByDenom(ctx, config.Denom)
+	if !found {
+		return status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", config.Denom)
+	}
+	if asset.IsDelisting {
+		return types.ErrAssetDelisting.Wrapf("denom: %s", config.Denom)
+	}
+	err := k.checkPriceSource(config)
+	if err != nil {
+		return err
+	}
+
+	applyAssetConfig(&asset, config)
+	return k.UpdatePetrichorAsset(ctx, asset)
+}
+
+func (k Keeper) totalRewardWeight(ctx sdk.Context) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, asset := range k.GetAllAssets(ctx) {
+		total = total.Add(asset.RewardWeight)
+	}
+	return total
+}
+
+// checkTotalRewardWeight rejects a change that raises the sum of the reward weights of all assets above the
+// MaxTotalRewardWeight param. Changes that do not raise the sum are allowed so that weights can still be lowered after
+// the param has been lowered
+func (k Keeper) checkTotalRewardWeight(ctx sdk.Context, currentTotal, newTotal sdk.Dec) error {
+	maxWeight := k.MaxTotalRewardWeight(ctx)
+	if maxWeight.IsZero() || !newTotal.GT(maxWeight) || !newTotal.GT(currentTotal) {
+		return nil
+	}
+	return types.ErrMaxTotalRewardWeight.Wrapf("%s is more than %s", newTotal, maxWeight)
+}
+
+// applyAssetConfig copies the fields that governance can set from the config to the asset. The denom and the
+// bookkeeping fields of the asset are left untouched
+func applyAssetConfig(asset *types.PetrichorAsset, config types.PetrichorAssetConfig) {
+	asset.RewardWeight = config.RewardWeight
+	asset.TakeRate = config.TakeRate
+	asset.RewardChangeRate = config.RewardChangeRate
+	asset.RewardChangeInterval = config.RewardChangeInterval
+	asset.MaxTotalTokens = config.MaxTotalTokens
+	asset.MaxValidatorShare = config.MaxValidatorShare
+	asset.MinDelegationAmount = config.MinDelegationAmount
+	asset.RewardWeightMode = config.RewardWeightMode
+	asset.MinRewardWeight = config.MinRewardWeight
+	asset.MaxRewardWeight = config.MaxRewardWeight
+	asset.TakeRateSplits = config.TakeRateSplits
+	asset.RewardWeightSchedule = config.RewardWeightSchedule
+	asset.RewardWeightInterpolation = config.RewardWeightInterpolation
+	asset.InsuranceCoverageCeiling = config.InsuranceCoverageCeiling
+}
+
+func (k Keeper) DeletePetrichor(ctx context.Context, req *types.MsgDeletePetrichorProposal) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	asset, found := k.GetAssetByDenom(sdkCtx, req.Denom)
 
@@ -41,32 +132,253 @@ func (k Keeper) UpdatePetrichor(ctx context.Context, req *types.MsgUpdatePetrich
 		return status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", req.Denom)
 	}
 
-	asset.RewardWeight = req.RewardWeight
-	asset.TakeRate = req.TakeRate
-	asset.RewardChangeRate = req.RewardChangeRate
-	asset.RewardChangeInterval = req.RewardChangeInterval
+	if asset.TotalTokens.GT(math.ZeroInt()) {
+		return status.Errorf(codes.Internal, "Asset cannot be deleted because there are still %s delegations associated with it", asset.TotalTokens)
+	}
+
+	k.DeleteAsset(sdkCtx, req.Denom)
 
+	return nil
+}
+
+// DelistPetrichor winds down an asset that may still have delegations.
+// The reward weight is set to zero so that rewards up to this block are snapshotted, new delegations are rejected and
+// the asset is queued for ProcessDelistingAssets which force-undelegates its delegations over the following blocks.
+// An asset without tokens is removed right away
+func (k Keeper) DelistPetrichor(ctx context.Context, req *types.MsgDelistPetrichorProposal) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	asset, found := k.GetAssetByDenom(sdkCtx, req.Denom)
+
+	if !found {
+		return status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", req.Denom)
+	}
+	if asset.IsDelisting {
+		return types.ErrAssetDelisting.Wrapf("denom: %s", req.Denom)
+	}
+
+	asset.RewardWeight = sdk.ZeroDec()
 	err := k.UpdatePetrichorAsset(sdkCtx, asset)
 	if err != nil {
 		return err
 	}
+	asset, _ = k.GetAssetByDenom(sdkCtx, req.Denom)
+	if asset.TotalTokens.IsZero() {
+		k.DeleteAsset(sdkCtx, req.Denom)
+		return nil
+	}
+	asset.IsDelisting = true
+	k.SetAsset(sdkCtx, asset)
+	sdkCtx.KVStore(k.storeKey).Set(types.GetDelistingAssetQueueKey(req.Denom), []byte{})
+	return nil
+}
 
+// ProcessDelistingAssets force-undelegates up to MaxDelistUndelegationsPerBlock delegations of queued delisted assets.
+// The delegation of the liquid staking pool is left for receipt holders to redeem. Once every delegation has been
+// visited, tokens left without any delegation are rounding dust that is sent to the fee collector like the take rate
+// and the asset is removed
+func (k Keeper) ProcessDelistingAssets(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var denoms []string
+	iter := sdk.KVStorePrefixIterator(store, types.DelistingAssetQueueKey)
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, types.ParseDelistingAssetQueueKey(iter.Key()))
+	}
+	iter.Close()
+	if len(denoms) == 0 {
+		return
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName).String()
+	budget := types.MaxDelistUndelegationsPerBlock
+	for _, denom := range denoms {
+		queueKey := types.GetDelistingAssetQueueKey(denom)
+		if asset, found := k.GetAssetByDenom(ctx, denom); !found || !asset.IsDelisting {
+			store.Delete(queueKey)
+			continue
+		}
+		done := k.windDown(ctx, queueKey, [][]byte{types.GetDelegationsByDenomIndexKey(denom)}, &budget,
+			types.EventTypeDelistUndelegateFailed, func(ctx sdk.Context, d types.Delegation) error {
+				// The asset is removed with its last tokens, which can leave delegations without any tokens behind
+				if _, found := k.GetAssetByDenom(ctx, denom); !found || d.DelegatorAddress == poolAddr {
+					return nil
+				}
+				return k.forceUndelegateAll(ctx, []types.Delegation{d})
+			})
+		if !done {
+			continue
+		}
+
+		asset, found := k.GetAssetByDenom(ctx, denom)
+		if !found || !asset.TotalTokens.IsPositive() {
+			continue
+		}
+		delegationsIter := sdk.KVStorePrefixIterator(store, types.GetDelegationsByDenomIndexKey(denom))
+		hasDelegations := delegationsIter.Valid()
+		delegationsIter.Close()
+		if hasDelegations {
+			continue
+		}
+		dust := sdk.NewCoins(sdk.NewCoin(asset.Denom, asset.TotalTokens))
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, dust)
+		if err != nil {
+			k.Logger(ctx).Error("failed to send the dust of a delisted petrichor asset to the fee collector",
+				"denom", denom, "error", err)
+			continue
+		}
+		k.DeleteAsset(ctx, denom)
+	}
+}
+
+// SweepDustDelegations undelegates every delegation of the asset that holds less than the minimum delegation amount.
+// Delegations of the liquid staking pool back receipts and are never swept. Returns the number of swept delegations
+func (k Keeper) SweepDustDelegations(ctx sdk.Context, denom string) (uint64, error) {
+	asset, found := k.GetAssetByDenom(ctx, denom)
+	if !found {
+		return 0, status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", denom)
+	}
+	if !asset.HasMinDelegationAmount() {
+		return 0, status.Errorf(codes.FailedPrecondition, "Asset with denom: %s does not have a minimum delegation amount", denom)
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName)
+	validators := map[string]types.PetrichorValidator{}
+	var dust []types.Delegation
+	var iterErr error
+	k.IterateDelegations(ctx, func(d types.Delegation) (stop bool) {
+		if d.Denom != denom || d.DelegatorAddress == poolAddr.String() {
+			return false
+		}
+		validator, found := validators[d.ValidatorAddress]
+		if !found {
+			valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+			validator, err = k.GetPetrichorValidator(ctx, valAddr)
+			if err != nil {
+				iterErr = err
+				return true
+			}
+			validators[d.ValidatorAddress] = validator
+		}
+		if asset.IsBelowMinDelegation(types.GetDelegationTokens(d, validator, asset).Amount) {
+			dust = append(dust, d)
+		}
+		return false
+	})
+	if iterErr != nil {
+		return 0, iterErr
+	}
+
+	err := k.forceUndelegateAll(ctx, dust)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(len(dust)), nil
+}
+
+// forceUndelegateAll moves every given delegation into the undelegation queue and emits an event for each of them
+func (k Keeper) forceUndelegateAll(ctx sdk.Context, delegations []types.Delegation) error {
+	denoms := map[string]bool{}
+	for _, d := range delegations {
+		denoms[d.Denom] = true
+		delAddr, err := sdk.AccAddressFromBech32(d.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		valAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		// The validator is queried for every delegation since its shares change after each undelegation
+		validator, err := k.GetPetrichorValidator(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		tokens, err := k.forceUndelegate(ctx, delAddr, validator, d.Denom)
+		if err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeForceUndelegate,
+			sdk.NewAttribute(types.AttributeKeyDelegator, d.DelegatorAddress),
+			sdk.NewAttribute(types.AttributeKeyValidator, d.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, tokens.String()),
+		))
+	}
+
+	// A delisted asset is removed once its last tokens are undelegated, whichever force undelegation drained it
+	for _, asset := range k.GetAllAssets(ctx) {
+		if denoms[asset.Denom] && asset.IsDelisting && asset.TotalTokens.IsZero() {
+			k.DeleteAsset(ctx, asset.Denom)
+		}
+	}
 	return nil
 }
 
-func (k Keeper) DeletePetrichor(ctx context.Context, req *types.MsgDeletePetrichorProposal) error {
+// BatchUpdatePetrichors creates, updates and deletes several petrichor assets at once.
+// Every entry is checked against the current assets before anything is written so that the batch either applies
+// completely or not at all, and a single rebalance is queued for the whole batch
+func (k Keeper) BatchUpdatePetrichors(ctx context.Context, req *types.MsgBatchUpdatePetrichors) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	asset, found := k.GetAssetByDenom(sdkCtx, req.Denom)
 
-	if !found {
-		return status.Errorf(codes.NotFound, "Asset with denom: %s does not exist", req.Denom)
+	weights := make(map[string]sdk.Dec)
+	for _, asset := range k.GetAllAssets(sdkCtx) {
+		weights[asset.Denom] = asset.RewardWeight
+	}
+	for _, asset := range req.CreateAssets {
+		if _, found := weights[asset.Denom]; found {
+			return types.ErrAssetAlreadyExists.Wrapf("denom: %s", asset.Denom)
+		}
+		weights[asset.Denom] = asset.RewardWeight
+	}
+	for _, asset := range req.UpdateAssets {
+		if _, found := weights[asset.Denom]; !found {
+			return types.ErrUnknownAsset.Wrapf("denom: %s", asset.Denom)
+		}
+		weights[asset.Denom] = asset.RewardWeight
+	}
+	for _, denom := range req.DeleteDenoms {
+		asset, found := k.GetAssetByDenom(sdkCtx, denom)
+		if !found {
+			return types.ErrUnknownAsset.Wrapf("denom: %s", denom)
+		}
+		if asset.TotalTokens.IsPositive() {
+			return types.ErrAssetHasDelegations.Wrapf("denom: %s, tokens: %s", denom, asset.TotalTokens)
+		}
+		delete(weights, denom)
 	}
 
-	if asset.TotalTokens.GT(math.ZeroInt()) {
-		return status.Errorf(codes.Internal, "Asset cannot be deleted because there are still %s delegations associated with it", asset.TotalTokens)
+	newTotalWeight := sdk.ZeroDec()
+	for _, weight := range weights {
+		newTotalWeight = newTotalWeight.Add(weight)
+	}
+	err := k.checkTotalRewardWeight(sdkCtx, k.totalRewardWeight(sdkCtx), newTotalWeight)
+	if err != nil {
+		return err
 	}
 
-	k.DeleteAsset(sdkCtx, req.Denom)
+	// The total reward weight is checked for the batch as a whole, not after every entry
+	for _, asset := range req.CreateAssets {
+		err := k.addAsset(sdkCtx, asset)
+		if err != nil {
+			return err
+		}
+	}
+	for _, asset := range req.UpdateAssets {
+		err := k.updateAsset(sdkCtx, asset)
+		if err != nil {
+			return err
+		}
+	}
+	for _, denom := range req.DeleteDenoms {
+		err := k.DeletePetrichor(ctx, &types.MsgDeletePetrichorProposal{Denom: denom})
+		if err != nil {
+			return err
+		}
+	}
 
+	k.QueueAssetRebalanceEvent(sdkCtx)
 	return nil
 }
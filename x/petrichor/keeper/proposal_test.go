@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	test_helpers "github.com/petrinetwork/petrichor/app"
 	"github.com/petrinetwork/petrichor/x/petrichor/keeper"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
 	"testing"
@@ -8,6 +9,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	"github.com/stretchr/testify/require"
 )
 
@@ -166,3 +170,663 @@ func TestDeletePetrichor(t *testing.T) {
 		},
 	})
 }
+
+func TestUpdateParams(t *testing.T) {
+	// GIVEN
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.Params{
+			RewardDelayTime:          time.Minute * 60,
+			TakeRateClaimInterval:    time.Minute * 5,
+			LastTakeRateClaimTime:    startTime,
+			AutoCompoundInterval:     time.Hour,
+			TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
+			LastAutoCompoundTime:     startTime,
+		},
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.MustNewDecFromStr("0.5"), startTime),
+		},
+	})
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	feeCollectorAddr := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.SetAutoCompound(ctx, addrs[0], val, PETRICHOR_TOKEN_DENOM, true)
+	require.NoError(t, err)
+	newParams := types.Params{
+		RewardDelayTime:          time.Minute * 30,
+		TakeRateClaimInterval:    time.Minute * 10,
+		AutoCompoundInterval:     time.Hour * 2,
+		TakeRateHistoryRetention: types.DefaultParams().TakeRateHistoryRetention,
+	}
+
+	// WHEN the signer is not the gov authority
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: addrs[0].String(),
+		Params:    newParams,
+	})
+	// THEN
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// WHEN the take rate claim interval is zero
+	invalidParams := newParams
+	invalidParams.TakeRateClaimInterval = 0
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    invalidParams,
+	})
+	// THEN
+	require.Error(t, err)
+
+	// WHEN the auto-compound interval is zero
+	invalidParams = newParams
+	invalidParams.AutoCompoundInterval = 0
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    invalidParams,
+	})
+	// THEN
+	require.ErrorContains(t, err, types.ErrInvalidParams.Error())
+
+	// WHEN the interval changes after two take rate intervals have passed
+	ctx = ctx.WithBlockTime(startTime.Add(time.Minute * 11)).WithBlockHeight(2)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    newParams,
+	})
+
+	// THEN the take rate accrued under the old interval is deducted and bookkeeping times are kept
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(750_000), app.BankKeeper.GetBalance(ctx, feeCollectorAddr, PETRICHOR_TOKEN_DENOM).Amount)
+	params := app.PetrichorKeeper.ExportGenesis(ctx).Params
+	require.Equal(t, time.Minute*30, params.RewardDelayTime)
+	require.Equal(t, time.Minute*10, params.TakeRateClaimInterval)
+	require.Equal(t, time.Hour*2, params.AutoCompoundInterval)
+	require.Equal(t, startTime.Add(time.Minute*10), params.LastTakeRateClaimTime)
+	require.Equal(t, startTime, params.LastAutoCompoundTime)
+}
+
+func TestUpdateParamsDeductsBeforeTakeRateSplitsChange(t *testing.T) {
+	// GIVEN an asset whose take rate goes to the fee collector through the module wide splits
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.LastTakeRateClaimTime = startTime
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.MustNewDecFromStr("0.5"), startTime),
+		},
+	})
+	feeCollectorAddr := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	// WHEN the module wide splits change after a take rate interval has passed
+	ctx = ctx.WithBlockTime(startTime.Add(params.TakeRateClaimInterval + time.Minute)).WithBlockHeight(2)
+	newParams := params
+	newParams.TakeRateSplits = []types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationBurn, Ratio: sdk.OneDec()},
+	}
+	err = app.PetrichorKeeper.UpdateParams(ctx, newParams)
+
+	// THEN the take rate accrued under the old splits is paid to the fee collector
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(500_000), app.BankKeeper.GetBalance(ctx, feeCollectorAddr, PETRICHOR_TOKEN_DENOM).Amount)
+	require.Equal(t, newParams.TakeRateSplits, app.PetrichorKeeper.TakeRateSplits(ctx))
+	require.Equal(t, startTime.Add(params.TakeRateClaimInterval), app.PetrichorKeeper.LastRewardClaimTime(ctx))
+}
+
+func TestUpdateParamsKeepsInsuranceFunded(t *testing.T) {
+	// GIVEN an insured asset that routes its take rate through the module wide splits
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.LastTakeRateClaimTime = startTime
+	params.TakeRateSplits = []types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationInsuranceFund, Ratio: sdk.OneDec()},
+	}
+	ceiling := sdk.NewInt(1000)
+	asset := types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.MustNewDecFromStr("0.1"), startTime)
+	asset.InsuranceCoverageCeiling = &ceiling
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.PetrichorAsset{asset},
+	})
+
+	// WHEN the module wide splits stop funding the insurance fund
+	newParams := params
+	newParams.TakeRateSplits = nil
+	err := app.PetrichorKeeper.UpdateParams(ctx, newParams)
+
+	// THEN
+	require.ErrorIs(t, err, types.ErrInvalidParams)
+
+	// WHEN the insurance fund keeps a share
+	newParams.TakeRateSplits = []types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationFeeCollector, Ratio: sdk.MustNewDecFromStr("0.5")},
+		{Destination: types.TakeRateDestinationInsuranceFund, Ratio: sdk.MustNewDecFromStr("0.5")},
+	}
+	err = app.PetrichorKeeper.UpdateParams(ctx, newParams)
+
+	// THEN
+	require.NoError(t, err)
+	require.Equal(t, newParams.TakeRateSplits, app.PetrichorKeeper.TakeRateSplits(ctx))
+}
+
+func TestPetrichorAssetGovMsgs(t *testing.T) {
+	// GIVEN
+	app, ctx := createTestContext(t)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	createMsg := &types.MsgCreatePetrichor{
+		Authority:            authority,
+		Denom:                "upetri",
+		RewardWeight:         sdk.OneDec(),
+		TakeRate:             sdk.ZeroDec(),
+		RewardChangeRate:     sdk.OneDec(),
+		RewardChangeInterval: time.Hour,
+	}
+
+	// WHEN the signer is not the gov authority
+	invalidMsg := *createMsg
+	invalidMsg.Authority = test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.Coins{})[0].String()
+	_, err := msgServer.CreatePetrichor(ctx, &invalidMsg)
+	// THEN
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// WHEN creating the asset
+	_, err = msgServer.CreatePetrichor(ctx, createMsg)
+	// THEN
+	require.NoError(t, err)
+	asset, found := app.PetrichorKeeper.GetAssetByDenom(ctx, "upetri")
+	require.True(t, found)
+	require.Equal(t, sdk.OneDec(), asset.RewardWeight)
+	require.Equal(t, time.Hour, asset.RewardChangeInterval)
+
+	// WHEN creating the same asset twice
+	_, err = msgServer.CreatePetrichor(ctx, createMsg)
+	// THEN
+	require.Error(t, err)
+
+	// WHEN updating the asset
+	_, err = msgServer.UpdatePetrichor(ctx, &types.MsgUpdatePetrichor{
+		Authority:            authority,
+		Denom:                "upetri",
+		RewardWeight:         sdk.NewDec(2),
+		TakeRate:             sdk.MustNewDecFromStr("0.1"),
+		RewardChangeRate:     sdk.OneDec(),
+		RewardChangeInterval: time.Hour,
+	})
+	// THEN
+	require.NoError(t, err)
+	asset, _ = app.PetrichorKeeper.GetAssetByDenom(ctx, "upetri")
+	require.Equal(t, sdk.NewDec(2), asset.RewardWeight)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), asset.TakeRate)
+
+	// WHEN deleting the asset
+	_, err = msgServer.DeletePetrichor(ctx, &types.MsgDeletePetrichor{
+		Authority: authority,
+		Denom:     "upetri",
+	})
+	// THEN
+	require.NoError(t, err)
+	_, found = app.PetrichorKeeper.GetAssetByDenom(ctx, "upetri")
+	require.False(t, found)
+}
+
+func TestBatchUpdatePetrichors(t *testing.T) {
+	// GIVEN
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+			types.NewPetrichorAsset(PETRICHOR_2_TOKEN_DENOM, sdk.NewDec(10), sdk.ZeroDec(), startTime),
+		},
+	})
+	app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	newAsset := func(denom string, weight int64) types.PetrichorAssetConfig {
+		return types.PetrichorAssetConfig{
+			Denom:            denom,
+			RewardWeight:     sdk.NewDec(weight),
+			TakeRate:         sdk.ZeroDec(),
+			RewardChangeRate: sdk.OneDec(),
+		}
+	}
+
+	// WHEN a denom appears twice in the batch
+	_, err := msgServer.BatchUpdatePetrichors(ctx, &types.MsgBatchUpdatePetrichors{
+		Authority:    authority,
+		CreateAssets: []types.PetrichorAssetConfig{newAsset("ibc/A", 1)},
+		UpdateAssets: []types.PetrichorAssetConfig{newAsset("ibc/A", 1)},
+	})
+	// THEN
+	require.Error(t, err)
+
+	// WHEN the take rate is out of bounds
+	invalidTakeRate := newAsset("ibc/A", 1)
+	invalidTakeRate.TakeRate = sdk.OneDec()
+	_, err = msgServer.BatchUpdatePetrichors(ctx, &types.MsgBatchUpdatePetrichors{
+		Authority:    authority,
+		CreateAssets: []types.PetrichorAssetConfig{invalidTakeRate},
+	})
+	// THEN
+	require.Error(t, err)
+
+	// WHEN the total reward weight after the batch is too high
+	_, err = msgServer.BatchUpdatePetrichors(ctx, &types.MsgBatchUpdatePetrichors{
+		Authority:    authority,
+		CreateAssets: []types.PetrichorAssetConfig{newAsset("ibc/A", 40), newAsset("ibc/B", 40)},
+		UpdateAssets: []types.PetrichorAssetConfig{newAsset(PETRICHOR_2_TOKEN_DENOM, 20)},
+	})
+	// THEN nothing is applied
+	require.ErrorIs(t, err, types.ErrMaxTotalRewardWeight)
+	_, found := app.PetrichorKeeper.GetAssetByDenom(ctx, "ibc/A")
+	require.False(t, found)
+	asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_2_TOKEN_DENOM)
+	require.Equal(t, sdk.NewDec(10), asset.RewardWeight)
+
+	// WHEN one of the entries refers to an unknown asset
+	_, err = msgServer.BatchUpdatePetrichors(ctx, &types.MsgBatchUpdatePetrichors{
+		Authority:    authority,
+		CreateAssets: []types.PetrichorAssetConfig{newAsset("ibc/A", 1)},
+		DeleteDenoms: []string{"ibc/unknown"},
+	})
+	// THEN nothing is applied
+	require.ErrorIs(t, err, types.ErrUnknownAsset)
+	_, found = app.PetrichorKeeper.GetAssetByDenom(ctx, "ibc/A")
+	require.False(t, found)
+
+	// WHEN the batch is valid
+	_, err = msgServer.BatchUpdatePetrichors(ctx, &types.MsgBatchUpdatePetrichors{
+		Authority:    authority,
+		CreateAssets: []types.PetrichorAssetConfig{newAsset("ibc/A", 40), newAsset("ibc/B", 40)},
+		UpdateAssets: []types.PetrichorAssetConfig{newAsset(PETRICHOR_2_TOKEN_DENOM, 20)},
+		DeleteDenoms: []string{PETRICHOR_TOKEN_DENOM},
+	})
+
+	// THEN every entry is applied and a single rebalance is queued
+	require.NoError(t, err)
+	assets := app.PetrichorKeeper.GetAllAssets(ctx)
+	require.Len(t, assets, 3)
+	asset, _ = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_2_TOKEN_DENOM)
+	require.Equal(t, sdk.NewDec(20), asset.RewardWeight)
+	_, found = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+	require.True(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+	require.False(t, app.PetrichorKeeper.ConsumeAssetRebalanceEvent(ctx))
+}
+
+func TestMaxTotalRewardWeight(t *testing.T) {
+	// GIVEN a total reward weight capped at 10 and an asset with a weight of 2
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	params := types.DefaultParams()
+	params.MaxTotalRewardWeight = sdk.NewDec(10)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: params,
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// WHEN a proposal creates an asset that takes the total above the cap
+	err := app.PetrichorKeeper.CreatePetrichor(ctx, &types.MsgCreatePetrichorProposal{
+		Denom:            "upetri",
+		RewardWeight:     sdk.NewDec(9),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+	})
+	// THEN
+	require.ErrorIs(t, err, types.ErrMaxTotalRewardWeight)
+	_, found := app.PetrichorKeeper.GetAssetByDenom(ctx, "upetri")
+	require.False(t, found)
+
+	// WHEN a message creates an asset that reaches the cap
+	_, err = msgServer.CreatePetrichor(ctx, &types.MsgCreatePetrichor{
+		Authority:        authority,
+		Denom:            "upetri",
+		RewardWeight:     sdk.NewDec(8),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+	})
+	// THEN
+	require.NoError(t, err)
+
+	// WHEN the cap is lowered below the current total and an update raises a weight
+	params.MaxTotalRewardWeight = sdk.NewDec(5)
+	app.PetrichorKeeper.SetParams(ctx, params)
+	_, err = msgServer.UpdatePetrichor(ctx, &types.MsgUpdatePetrichor{
+		Authority:        authority,
+		Denom:            "upetri",
+		RewardWeight:     sdk.NewDec(9),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+	})
+	// THEN
+	require.ErrorIs(t, err, types.ErrMaxTotalRewardWeight)
+
+	// WHEN an update lowers a weight without getting the total under the cap
+	err = app.PetrichorKeeper.UpdatePetrichor(ctx, &types.MsgUpdatePetrichorProposal{
+		Denom:            "upetri",
+		RewardWeight:     sdk.NewDec(4),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+	})
+	// THEN it is still allowed
+	require.NoError(t, err)
+	asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, "upetri")
+	require.Equal(t, sdk.NewDec(4), asset.RewardWeight)
+}
+
+func TestDelistPetrichor(t *testing.T) {
+	// GIVEN
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1, user2, user3 := addrs[0], addrs[1], addrs[2]
+	_, err = app.PetrichorKeeper.Delegate(ctx, user1, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user2, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	receipt, err := app.PetrichorKeeper.LiquidDelegate(ctx, user3, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+
+	// WHEN
+	ctx = ctx.WithBlockHeight(2)
+	_, err = msgServer.DelistPetrichor(ctx, &types.MsgDelistPetrichor{
+		Authority: authority,
+		Denom:     PETRICHOR_TOKEN_DENOM,
+	})
+
+	// THEN the asset is only flagged and its delegations are left for the end blocker
+	require.NoError(t, err)
+	asset, found := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	require.True(t, asset.IsDelisting)
+	require.True(t, asset.RewardWeight.IsZero())
+	require.Equal(t, sdk.NewInt(2500_000), asset.TotalTokens)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user1, val, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+
+	// WHEN the end blocker processes the delisted asset
+	app.PetrichorKeeper.ProcessDelistingAssets(ctx)
+
+	// THEN direct delegations are force-undelegated and the liquid staking pool position is kept for receipt holders
+	asset, found = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(1000_000), asset.TotalTokens)
+	require.Nil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.GetDelistingAssetQueueKey(PETRICHOR_TOKEN_DENOM)))
+	val, err = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user1, val, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user2, val, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+	undelegated := sdk.NewCoins()
+	app.PetrichorKeeper.IterateUndelegations(ctx, func(undelegation types.QueuedUndelegation, completionTime time.Time) (stop bool) {
+		for _, entry := range undelegation.Entries {
+			undelegated = undelegated.Add(entry.Balance)
+		}
+		return false
+	})
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1500_000))), undelegated)
+
+	// WHEN delegating or updating a delisting asset
+	_, err = app.PetrichorKeeper.Delegate(ctx, user2, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000)))
+	// THEN
+	require.ErrorIs(t, err, types.ErrAssetDelisting)
+	err = app.PetrichorKeeper.UpdatePetrichor(ctx, &types.MsgUpdatePetrichorProposal{
+		Denom:            PETRICHOR_TOKEN_DENOM,
+		RewardWeight:     sdk.OneDec(),
+		TakeRate:         sdk.ZeroDec(),
+		RewardChangeRate: sdk.OneDec(),
+	})
+	require.ErrorIs(t, err, types.ErrAssetDelisting)
+
+	// WHEN the last tokens are redeemed
+	_, err = app.PetrichorKeeper.RedeemLiquidReceipt(ctx, user3, receipt)
+
+	// THEN the asset is removed
+	require.NoError(t, err)
+	_, found = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+
+	// WHEN the undelegations mature
+	ctx = ctx.WithBlockTime(startTime.Add(app.StakingKeeper.UnbondingTime(ctx)).Add(time.Second))
+	err = app.PetrichorKeeper.CompleteUndelegations(ctx)
+
+	// THEN delegators get their tokens back
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000_000), app.BankKeeper.GetBalance(ctx, user1, PETRICHOR_TOKEN_DENOM).Amount)
+	require.Equal(t, sdk.NewInt(1000_000), app.BankKeeper.GetBalance(ctx, user2, PETRICHOR_TOKEN_DENOM).Amount)
+	require.Equal(t, sdk.NewInt(1000_000), app.BankKeeper.GetBalance(ctx, user3, PETRICHOR_TOKEN_DENOM).Amount)
+}
+
+func TestDelistPetrichorInBatches(t *testing.T) {
+	// GIVEN more delegations than can be undelegated in one block
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	delegators := types.MaxDelistUndelegationsPerBlock + 5
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, delegators, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)),
+	))
+	for _, addr := range addrs {
+		_, err = app.PetrichorKeeper.Delegate(ctx, addr, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000)))
+		require.NoError(t, err)
+	}
+	countDelegations := func() int {
+		count := 0
+		app.PetrichorKeeper.IterateDelegationsByDenom(ctx, PETRICHOR_TOKEN_DENOM, func(d types.Delegation) (stop bool) {
+			count++
+			return false
+		})
+		return count
+	}
+
+	// WHEN the asset is delisted and the first block is processed
+	err = app.PetrichorKeeper.DelistPetrichor(ctx, &types.MsgDelistPetrichorProposal{Denom: PETRICHOR_TOKEN_DENOM})
+	require.NoError(t, err)
+	app.PetrichorKeeper.ProcessDelistingAssets(ctx)
+
+	// THEN only a batch is undelegated and the asset stays queued
+	require.Equal(t, delegators-types.MaxDelistUndelegationsPerBlock, countDelegations())
+	asset, found := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	require.Equal(t, sdk.NewInt(int64(delegators-types.MaxDelistUndelegationsPerBlock)*1000), asset.TotalTokens)
+
+	// WHEN the next block is processed
+	app.PetrichorKeeper.ProcessDelistingAssets(ctx)
+
+	// THEN the remaining delegations are undelegated and the asset is removed
+	require.Zero(t, countDelegations())
+	_, found = app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+	require.Nil(t, ctx.KVStore(app.GetKey(types.StoreKey)).Get(types.GetDelistingAssetQueueKey(PETRICHOR_TOKEN_DENOM)))
+}
+
+func TestSweepDustDelegations(t *testing.T) {
+	// GIVEN delegations made before the asset had a minimum delegation amount
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	user1, user2, user3 := addrs[0], addrs[1], addrs[2]
+	_, err = app.PetrichorKeeper.Delegate(ctx, user1, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user2, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.LiquidDelegate(ctx, user3, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100)))
+	require.NoError(t, err)
+
+	// WHEN sweeping an asset without a minimum
+	_, err = msgServer.SweepDustDelegations(ctx, &types.MsgSweepDustDelegations{
+		Authority: authority,
+		Denom:     PETRICHOR_TOKEN_DENOM,
+	})
+	// THEN
+	require.Error(t, err)
+
+	// WHEN the minimum is set and the dust is swept
+	minDelegationAmount := sdk.NewInt(1000)
+	err = app.PetrichorKeeper.UpdatePetrichor(ctx, &types.MsgUpdatePetrichorProposal{
+		Denom:               PETRICHOR_TOKEN_DENOM,
+		RewardWeight:        sdk.NewDec(2),
+		TakeRate:            sdk.ZeroDec(),
+		RewardChangeRate:    sdk.OneDec(),
+		MinDelegationAmount: &minDelegationAmount,
+	})
+	require.NoError(t, err)
+	_, err = msgServer.SweepDustDelegations(ctx, &types.MsgSweepDustDelegations{
+		Authority: user1.String(),
+		Denom:     PETRICHOR_TOKEN_DENOM,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	res, err := msgServer.SweepDustDelegations(ctx, &types.MsgSweepDustDelegations{
+		Authority: authority,
+		Denom:     PETRICHOR_TOKEN_DENOM,
+	})
+
+	// THEN only the direct delegation below the minimum is undelegated
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Swept)
+	val, err = app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	_, found := app.PetrichorKeeper.GetDelegation(ctx, user1, val, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, user2, val, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	_, found = app.PetrichorKeeper.GetDelegation(ctx, app.AccountKeeper.GetModuleAddress(types.LiquidStakingPoolName), val, PETRICHOR_TOKEN_DENOM)
+	require.True(t, found)
+	asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.Equal(t, sdk.NewInt(500_100), asset.TotalTokens)
+	undelegated := sdk.NewCoins()
+	app.PetrichorKeeper.IterateUndelegations(ctx, func(undelegation types.QueuedUndelegation, completionTime time.Time) (stop bool) {
+		for _, entry := range undelegation.Entries {
+			undelegated = undelegated.Add(entry.Balance)
+		}
+		return false
+	})
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100))), undelegated)
+}
+
+func TestForceUndelegationRemovesDrainedDelistingAsset(t *testing.T) {
+	// GIVEN a delisting asset whose last delegation is below the minimum delegation amount
+	app, ctx := createTestContext(t)
+	startTime := time.Now().UTC()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr1, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val1, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	valAddr2 := sdk.ValAddress(addrs[0])
+	_val2 := teststaking.NewValidator(t, valAddr2, test_helpers.CreateTestPubKeys(1)[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, _val2)
+	val2, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	user := addrs[1]
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100)))
+	require.NoError(t, err)
+	minDelegationAmount := sdk.NewInt(1000)
+	asset, _ := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	asset.MinDelegationAmount = &minDelegationAmount
+	asset.IsDelisting = true
+	app.PetrichorKeeper.SetAsset(ctx, asset)
+
+	// WHEN the delegation is redelegated
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user, val1, val2, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100)))
+	// THEN
+	require.ErrorIs(t, err, types.ErrAssetDelisting)
+
+	// WHEN the dust sweep undelegates the last tokens
+	swept, err := app.PetrichorKeeper.SweepDustDelegations(ctx, PETRICHOR_TOKEN_DENOM)
+
+	// THEN the asset is removed
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), swept)
+	_, found := app.PetrichorKeeper.GetAssetByDenom(ctx, PETRICHOR_TOKEN_DENOM)
+	require.False(t, found)
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
 )
@@ -34,9 +36,74 @@ func (k Keeper) ClaimValidatorRewards(ctx sdk.Context, val types.PetrichorValida
 	return coins, nil
 }
 
-// ClaimDelegationRewards claims delegation rewards and transfers to the delegator account
+// pendingValidatorRewards returns the rewards ClaimValidatorRewards would withdraw from the distribution module
+// The distribution query increments the validator period so it is run against a discarded cache context
+func (k Keeper) pendingValidatorRewards(ctx sdk.Context, val types.PetrichorValidator) (sdk.Coins, error) {
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	_, found := k.stakingKeeper.GetDelegation(ctx, moduleAddr, val.GetOperator())
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	res, err := k.distributionKeeper.DelegationRewards(sdk.WrapSDKContext(cacheCtx), &distrtypes.QueryDelegationRewardsRequest{
+		DelegatorAddress: moduleAddr.String(),
+		ValidatorAddress: val.GetOperator().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	coins, _ := res.Rewards.TruncateDecimal()
+	return coins, nil
+}
+
+// EstimateDelegationRewards returns the rewards ClaimDelegationRewards would pay out at the current height without
+// writing to the store. Pending validator rewards are added to a copy of the validator reward history, then the
+// rewards are calculated together with the reward weight segments they were accrued in
+func (k Keeper) EstimateDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, val types.PetrichorValidator, denom string) (sdk.Coins, []types.DelegationRewardSegment, error) {
+	asset, found := k.GetAssetByDenom(ctx, denom)
+	if !found {
+		return nil, nil, types.ErrUnknownAsset
+	}
+	delegation, found := k.GetDelegation(ctx, delAddr, val, denom)
+	if !found {
+		return sdk.Coins{}, nil, stakingtypes.ErrNoDelegatorForAddress
+	}
+
+	val, err := k.withPendingValidatorRewards(ctx, val)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	coins, _, segments := k.calculateDelegationRewards(ctx, delegation, val, asset)
+	return coins, segments, nil
+}
+
+// withPendingValidatorRewards returns the validator with its reward history as it would be after ClaimValidatorRewards
+func (k Keeper) withPendingValidatorRewards(ctx sdk.Context, val types.PetrichorValidator) (types.PetrichorValidator, error) {
+	pending, err := k.pendingValidatorRewards(ctx, val)
+	if err != nil {
+		return val, err
+	}
+	// Same as AddAssetsToRewardPool, pending rewards belong to no one if there are no delegations.
+	// The validator info is shared by pointer so the rewards are added to a copy
+	totalAssetWeight := k.totalAssetWeight(ctx, val)
+	if !pending.IsZero() && !totalAssetWeight.IsZero() {
+		info := *val.PetrichorValidatorInfo
+		addRewardsToValidator(&info, pending, totalAssetWeight)
+		val.PetrichorValidatorInfo = &info
+	}
+	return val, nil
+}
+
+// ClaimDelegationRewards claims delegation rewards and transfers to the reward withdraw address of the delegator
 // This method updates the delegation so you will need to re-query an updated version from the database
 func (k Keeper) ClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, val types.PetrichorValidator, denom string) (sdk.Coins, error) {
+	return k.claimDelegationRewards(ctx, delAddr, val, denom, k.GetRewardWithdrawAddress(ctx, delAddr))
+}
+
+func (k Keeper) claimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, val types.PetrichorValidator, denom string, recipient sdk.AccAddress) (sdk.Coins, error) {
 	asset, found := k.GetAssetByDenom(ctx, denom)
 	if !found {
 		return nil, types.ErrUnknownAsset
@@ -45,8 +112,12 @@ func (k Keeper) ClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress,
 	if !found {
 		return sdk.Coins{}, stakingtypes.ErrNoDelegatorForAddress
 	}
+	err := k.PetrichorHooks().BeforeClaimDelegationRewards(ctx, delAddr, val.GetOperator(), denom)
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := k.ClaimValidatorRewards(ctx, val)
+	_, err = k.ClaimValidatorRewards(ctx, val)
 	if err != nil {
 		return nil, err
 	}
@@ -60,22 +131,96 @@ func (k Keeper) ClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress,
 	delegation.LastRewardClaimHeight = uint64(ctx.BlockHeight())
 	k.SetDelegation(ctx, delAddr, val.GetOperator(), denom, delegation)
 
-	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, delAddr, coins)
+	err = k.sendDelegationRewards(ctx, recipient, coins)
 	if err != nil {
 		return nil, err
 	}
 
+	err = k.PetrichorHooks().AfterClaimDelegationRewards(ctx, delAddr, val.GetOperator(), denom, coins)
+	if err != nil {
+		return nil, err
+	}
 	return coins, nil
 }
 
+// sendDelegationRewards pays out of the rewards pool. The liquid staking pool is a blocked address so its rewards
+// are moved between module accounts
+func (k Keeper) sendDelegationRewards(ctx sdk.Context, recipient sdk.AccAddress, coins sdk.Coins) error {
+	if recipient.Equals(k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName)) {
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RewardsPoolName, types.LiquidStakingPoolName, coins)
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.RewardsPoolName, recipient, coins)
+}
+
+// ClaimAllDelegationRewards claims the rewards of the delegations under the delegator's prefix.
+// An empty validator address or denom claims for all validators or denoms. At most limit delegations are claimed
+// so that the gas used by a single call stays bounded. Iteration starts at startKey, relative to the delegator's
+// prefix, and the returned next key continues where this call stopped. It is nil once every delegation was reached.
+// Returns the claimed delegations and their rewards
+func (k Keeper) ClaimAllDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, limit uint32, startKey []byte) ([]types.Delegation, []sdk.Coins, []byte, error) {
+	if limit == 0 || limit > types.MaxClaimAllDelegations {
+		limit = types.MaxClaimAllDelegations
+	}
+	keyPrefix := types.GetDelegationsKey(delAddr)
+	if !valAddr.Empty() {
+		keyPrefix = types.GetDelegationsKeyForAllDenoms(delAddr, valAddr)
+	}
+
+	// Delegations are collected first since claiming rewards writes to the store being iterated
+	var delegations []types.Delegation
+	var nextKey []byte
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(startKey, nil)
+	for ; iter.Valid(); iter.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshal(iter.Value(), &delegation)
+		if denom != "" && delegation.Denom != denom {
+			continue
+		}
+		if uint32(len(delegations)) >= limit {
+			nextKey = iter.Key()
+			break
+		}
+		delegations = append(delegations, delegation)
+	}
+	iter.Close()
+
+	rewards := make([]sdk.Coins, 0, len(delegations))
+	for _, delegation := range delegations {
+		delValAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		validator, err := k.GetPetrichorValidator(ctx, delValAddr)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		coins, err := k.ClaimDelegationRewards(ctx, delAddr, validator, delegation.Denom)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		rewards = append(rewards, coins)
+	}
+	return delegations, rewards, nextKey, nil
+}
+
 // CalculateDelegationRewards calculates the rewards that can be claimed for a delegation
 // It takes past reward_rate changes into account by using the RewardRateChangeSnapshot entry
 func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delegation types.Delegation, val types.PetrichorValidator, asset types.PetrichorAsset) (sdk.Coins, types.RewardHistories, error) {
+	totalRewards, currentRewardHistory, _ := k.calculateDelegationRewards(ctx, delegation, val, asset)
+	return totalRewards, currentRewardHistory, nil
+}
+
+// calculateDelegationRewards also returns the rewards accrued under each reward weight,
+// one segment per snapshot iterated and a last segment for the current reward weight
+func (k Keeper) calculateDelegationRewards(ctx sdk.Context, delegation types.Delegation, val types.PetrichorValidator, asset types.PetrichorAsset) (sdk.Coins, types.RewardHistories, []types.DelegationRewardSegment) {
 	var totalRewards sdk.Coins
+	var segments []types.DelegationRewardSegment
 	currentRewardHistory := types.NewRewardHistories(val.GlobalRewardHistory)
 	delegationRewardHistories := types.NewRewardHistories(delegation.RewardHistory)
 	// If there are reward rate changes between last and current claim, sequentially claim with the help of the snapshots
 	snapshotIter := k.IterateWeightChangeSnapshot(ctx, asset.Denom, val.GetOperator(), delegation.LastRewardClaimHeight)
+	defer snapshotIter.Close()
 	for ; snapshotIter.Valid(); snapshotIter.Next() {
 		var snapshot types.RewardWeightChangeSnapshot
 		b := snapshotIter.Value()
@@ -83,10 +228,21 @@ func (k Keeper) CalculateDelegationRewards(ctx sdk.Context, delegation types.Del
 		var rewards sdk.Coins
 		rewards, delegationRewardHistories = accumulateRewards(types.NewRewardHistories(snapshot.RewardHistories), delegationRewardHistories, asset, snapshot.PrevRewardWeight, delegation, val)
 		totalRewards = totalRewards.Add(rewards...)
+		_, _, height := types.ParseRewardWeightChangeSnapshotKey(snapshotIter.Key())
+		segments = append(segments, types.DelegationRewardSegment{
+			Height:       height,
+			RewardWeight: snapshot.PrevRewardWeight,
+			Rewards:      rewards,
+		})
 	}
 	rewards, _ := accumulateRewards(currentRewardHistory, delegationRewardHistories, asset, asset.RewardWeight, delegation, val)
 	totalRewards = totalRewards.Add(rewards...)
-	return totalRewards, currentRewardHistory, nil
+	segments = append(segments, types.DelegationRewardSegment{
+		Height:       uint64(ctx.BlockHeight()),
+		RewardWeight: asset.RewardWeight,
+		Rewards:      rewards,
+	})
+	return totalRewards, currentRewardHistory, segments
 }
 
 // accumulateRewards compares the latest reward history with the delegation's reward history
@@ -119,13 +275,34 @@ func accumulateRewards(latestRewardHistories types.RewardHistories, rewardHistor
 // AddAssetsToRewardPool increments a reward history array. A reward history stores the average reward per token/reward_weight.
 // To calculate the number of rewards claimable, take reward_history * petrichor_token_amount * reward_weight
 func (k Keeper) AddAssetsToRewardPool(ctx sdk.Context, from sdk.AccAddress, val types.PetrichorValidator, coins sdk.Coins) error {
-	rewardHistories := types.NewRewardHistories(val.GlobalRewardHistory)
 	totalAssetWeight := k.totalAssetWeight(ctx, val)
 	// We need some delegations before we can split rewards. Else rewards belong to no one
 	if totalAssetWeight.IsZero() {
 		return types.ErrZeroDelegations
 	}
 
+	addRewardsToValidator(val.PetrichorValidatorInfo, coins, totalAssetWeight)
+	k.SetValidator(ctx, val)
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.RewardsPoolName, coins)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// addRewardsToValidator separates the commission from the rewards and adds the rest to the reward histories of the
+// validator. The commission stays in the rewards pool until the operator withdraws it
+func addRewardsToValidator(info *types.PetrichorValidatorInfo, coins sdk.Coins, totalAssetWeight sdk.Dec) {
+	commission, rewards := info.SplitCommission(coins)
+	info.AccumulatedCommission = info.AccumulatedCommission.Add(commission...)
+	info.GlobalRewardHistory = addRewardsToHistories(types.NewRewardHistories(info.GlobalRewardHistory), rewards, totalAssetWeight)
+}
+
+// addRewardsToHistories returns a copy of the reward histories with the coins split over the total asset weight
+func addRewardsToHistories(histories types.RewardHistories, coins sdk.Coins, totalAssetWeight sdk.Dec) types.RewardHistories {
+	rewardHistories := make(types.RewardHistories, len(histories))
+	copy(rewardHistories, histories)
 	for _, c := range coins {
 		rewardHistory, found := rewardHistories.GetIndexByDenom(c.Denom)
 		if !found {
@@ -137,15 +314,7 @@ func (k Keeper) AddAssetsToRewardPool(ctx sdk.Context, from sdk.AccAddress, val
 			rewardHistory.Index = rewardHistory.Index.Add(sdk.NewDecFromInt(c.Amount).Quo(totalAssetWeight))
 		}
 	}
-
-	val.GlobalRewardHistory = rewardHistories
-	k.SetValidator(ctx, val)
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.RewardsPoolName, coins)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return rewardHistories
 }
 
 func (k Keeper) totalAssetWeight(ctx sdk.Context, val types.PetrichorValidator) sdk.Dec {
@@ -2,12 +2,14 @@ package keeper_test
 
 import (
 	test_helpers "github.com/petrinetwork/petrichor/app"
+	"github.com/petrinetwork/petrichor/x/petrichor/keeper"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -124,6 +126,104 @@ func TestRewardPoolAndGlobalIndex(t *testing.T) {
 	}, globalIndices)
 }
 
+func TestValidatorCommission(t *testing.T) {
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(2), sdk.ZeroDec(), startTime),
+		},
+	})
+
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	getVal := func() types.PetrichorValidator {
+		val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+		require.NoError(t, err)
+		return val
+	}
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 1, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)),
+	))
+	_, err = app.PetrichorKeeper.Delegate(ctx, addrs[0], getVal(), sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(4000_000))))
+	require.NoError(t, err)
+
+	// The first commission must set its bounds
+	msgServer := keeper.NewMsgServerImpl(app.PetrichorKeeper)
+	rate := sdk.MustNewDecFromStr("0.1")
+	maxRate := sdk.MustNewDecFromStr("0.2")
+	maxChangeRate := sdk.MustNewDecFromStr("0.05")
+	err = app.PetrichorKeeper.SetValidatorCommission(ctx, valAddr, rate, nil, nil)
+	require.Error(t, err)
+	_, err = msgServer.SetPetrichorCommission(ctx, &types.MsgSetPetrichorCommission{
+		ValidatorAddress: valAddr.String(),
+		Rate:             rate,
+		MaxRate:          &maxRate,
+		MaxChangeRate:    &maxChangeRate,
+	})
+	require.NoError(t, err)
+
+	// The commission is taken before the global index is incremented
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, getVal(), sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+	require.NoError(t, err)
+	val := getVal()
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(200_000))), val.AccumulatedCommission)
+	require.Equal(t, types.RewardHistories{
+		types.RewardHistory{
+			Denom: "stake",
+			Index: sdk.MustNewDecFromStr("0.9"),
+		},
+	}, types.NewRewardHistories(val.GlobalRewardHistory))
+
+	// The rate follows the x/staking rules once set
+	err = app.PetrichorKeeper.SetValidatorCommission(ctx, valAddr, sdk.MustNewDecFromStr("0.15"), nil, nil)
+	require.ErrorIs(t, err, stakingtypes.ErrCommissionUpdateTime)
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour * 25))
+	err = app.PetrichorKeeper.SetValidatorCommission(ctx, valAddr, sdk.MustNewDecFromStr("0.2"), nil, nil)
+	require.ErrorIs(t, err, stakingtypes.ErrCommissionGTMaxChangeRate)
+	err = app.PetrichorKeeper.SetValidatorCommission(ctx, valAddr, sdk.MustNewDecFromStr("0.15"), &maxRate, &maxChangeRate)
+	require.Error(t, err)
+	err = app.PetrichorKeeper.SetValidatorCommission(ctx, valAddr, sdk.MustNewDecFromStr("0.15"), nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.15"), getVal().PetrichorCommission.Rate)
+
+	// The operator withdraws the accumulated commission from the rewards pool
+	operator := sdk.AccAddress(valAddr)
+	balanceBefore := app.BankKeeper.GetBalance(ctx, operator, "stake")
+	res, err := msgServer.WithdrawPetrichorCommission(ctx, &types.MsgWithdrawPetrichorCommission{
+		ValidatorAddress: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(200_000))), res.Amount)
+	require.Equal(t, balanceBefore.Amount.Add(sdk.NewInt(200_000)), app.BankKeeper.GetBalance(ctx, operator, "stake").Amount)
+	require.True(t, getVal().AccumulatedCommission.IsZero())
+	_, err = app.PetrichorKeeper.WithdrawValidatorCommission(ctx, valAddr)
+	require.ErrorIs(t, err, types.ErrNoValidatorCommission)
+
+	// The estimate takes the commission from pending rewards the same way claiming does
+	err = app.PetrichorKeeper.RebalanceBondTokenWeights(ctx, app.PetrichorKeeper.GetAllAssets(ctx))
+	require.NoError(t, err)
+	pending := sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000)))
+	err = app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, distrtypes.ModuleName, pending)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	stakingVal, found := app.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	app.DistrKeeper.AllocateTokensToValidator(ctx, stakingVal, sdk.NewDecCoinsFromCoins(pending...))
+	estimate, _, err := app.PetrichorKeeper.EstimateDelegationRewards(ctx, addrs[0], getVal(), PETRICHOR_TOKEN_DENOM)
+	require.NoError(t, err)
+	claimed, err := app.PetrichorKeeper.ClaimDelegationRewards(ctx, addrs[0], getVal(), PETRICHOR_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.False(t, getVal().AccumulatedCommission.IsZero())
+	require.Equal(t, estimate, claimed)
+}
+
 func TestClaimRewards(t *testing.T) {
 	app, ctx := createTestContext(t)
 	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
@@ -523,9 +623,26 @@ func TestClaimRewardsAfterRewardsRatesChange(t *testing.T) {
 		},
 	})
 
+	// Estimating rewards breaks them down by the reward weight they were accrued under
+	estimate, segments, err := app.PetrichorKeeper.EstimateDelegationRewards(ctx, user1, val1, PETRICHOR_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, []types.DelegationRewardSegment{
+		{
+			Height:       2,
+			RewardWeight: sdk.NewDec(2),
+			Rewards:      sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(1_666_666))),
+		},
+		{
+			Height:       3,
+			RewardWeight: sdk.NewDec(10),
+			Rewards:      sdk.NewCoins(sdk.NewCoin(bondDenom, sdk.NewInt(5_000_000))),
+		},
+	}, segments)
+
 	rewards1, err := app.PetrichorKeeper.ClaimDelegationRewards(ctx, user1, val1, PETRICHOR_TOKEN_DENOM)
 	require.NoError(t, err)
 	require.Equal(t, sdk.NewInt(5_000_000+1_666_666), rewards1.AmountOf(bondDenom))
+	require.Equal(t, estimate, rewards1)
 
 	rewards2, err := app.PetrichorKeeper.ClaimDelegationRewards(ctx, user2, val2, PETRICHOR_2_TOKEN_DENOM)
 	require.NoError(t, err)
@@ -659,3 +776,71 @@ func TestRewardClaimingAfterRatesDecay(t *testing.T) {
 	// Expect total claimed rewards to be whatever that was added
 	require.Equal(t, sdk.NewInt(2000_000), coins.Add(coins2...).AmountOf(bondDenom))
 }
+
+func TestClaimAllDelegationRewards(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+			types.NewPetrichorAsset(PETRICHOR_2_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(2000_000)),
+		sdk.NewCoin(PETRICHOR_2_TOKEN_DENOM, sdk.NewInt(2000_000)),
+	))
+	pks := test_helpers.CreateTestPubKeys(2)
+	valAddr1 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr1, pks[0]))
+	valAddr2 := sdk.ValAddress(addrs[1])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, pks[1]))
+	val1, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	val2, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	user := addrs[2]
+
+	// Four positions across two validators and two denoms
+	for _, val := range []types.PetrichorValidator{val1, val2} {
+		for _, denom := range []string{PETRICHOR_TOKEN_DENOM, PETRICHOR_2_TOKEN_DENOM} {
+			_, err = app.PetrichorKeeper.Delegate(ctx, user, val, sdk.NewCoin(denom, sdk.NewInt(1000_000)))
+			require.NoError(t, err)
+		}
+		err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+		require.NoError(t, err)
+		err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(2000_000))))
+		require.NoError(t, err)
+	}
+
+	// Filter by validator and denom
+	delegations, rewards, nextKey, err := app.PetrichorKeeper.ClaimAllDelegationRewards(ctx, user, valAddr1, PETRICHOR_TOKEN_DENOM, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.Nil(t, nextKey)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))), rewards[0])
+
+	// Limit bounds the number of claimed positions and the next key continues after them
+	var claimed []types.Delegation
+	delegations, _, nextKey, err = app.PetrichorKeeper.ClaimAllDelegationRewards(ctx, user, nil, "", 1, nil)
+	require.NoError(t, err)
+	require.Len(t, delegations, 1)
+	require.NotNil(t, nextKey)
+	claimed = append(claimed, delegations...)
+	for nextKey != nil {
+		delegations, _, nextKey, err = app.PetrichorKeeper.ClaimAllDelegationRewards(ctx, user, nil, "", 2, nextKey)
+		require.NoError(t, err)
+		claimed = append(claimed, delegations...)
+	}
+
+	// Every position is reached exactly once
+	require.Len(t, claimed, 4)
+	seen := map[string]bool{}
+	for _, d := range claimed {
+		seen[d.ValidatorAddress+d.Denom] = true
+	}
+	require.Len(t, seen, 4)
+	require.Equal(t, sdk.NewInt(4000_000), app.BankKeeper.GetBalance(ctx, user, "stake").Amount)
+}
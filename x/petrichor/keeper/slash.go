@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
@@ -11,6 +13,11 @@ func (k Keeper) SlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction
 	if err != nil {
 		return err
 	}
+	err = k.PetrichorHooks().BeforeSlashValidator(ctx, valAddr, fraction)
+	if err != nil {
+		return err
+	}
+	slashedTokens := sdk.NewDecCoins()
 	slashedValidatorShares := sdk.NewDecCoins()
 	for _, share := range val.ValidatorShares {
 		sharesToSlash := share.Amount.Mul(fraction)
@@ -19,32 +26,56 @@ func (k Keeper) SlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction
 		if !found {
 			return types.ErrUnknownAsset
 		}
+		// Value the slash before the shares are reduced
+		slashedTokens = slashedTokens.Add(sdk.NewDecCoinFromDec(share.Denom, val.TotalDecTokensWithAsset(asset).Mul(fraction)))
 		asset.TotalValidatorShares = asset.TotalValidatorShares.Sub(sharesToSlash)
 		k.SetAsset(ctx, asset)
 	}
 	val.ValidatorShares = slashedValidatorShares
 	k.SetValidator(ctx, val)
 
-	err = k.SlashRedelegations(ctx, valAddr, fraction)
+	redelegations, err := k.SlashRedelegations(ctx, valAddr, fraction)
 	if err != nil {
 		return err
 	}
 
-	err = k.SlashUndelegations(ctx, valAddr, fraction)
+	undelegations, err := k.SlashUndelegations(ctx, valAddr, fraction)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	k.SetSlashRecord(ctx, types.SlashRecord{
+		ValidatorAddress: valAddr.String(),
+		Height:           uint64(ctx.BlockHeight()),
+		Time:             ctx.BlockTime(),
+		Fraction:         fraction,
+		SlashedTokens:    slashedTokens,
+		Redelegations:    redelegations,
+		Undelegations:    undelegations,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyFraction, fraction.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, slashedTokens.String()),
+		),
+	)
+	return k.PetrichorHooks().AfterSlashValidator(ctx, valAddr, fraction)
 }
 
-func (k Keeper) SlashRedelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+// SlashRedelegations slashes the immature redelegations away from the validator and returns the slashed entries
+// with the balance set to the slashed amount
+func (k Keeper) SlashRedelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) ([]types.Redelegation, error) {
 	store := ctx.KVStore(k.storeKey)
+	var slashed []types.Redelegation
 	// Slash all immature re-delegations
 	redelegationIterator := k.IterateRedelegationsBySrcValidator(ctx, valAddr)
 	for ; redelegationIterator.Valid(); redelegationIterator.Next() {
 		redelegationKey, completion, err := types.ParseRedelegationIndexForRedelegationKey(redelegationIterator.Key())
 		if err != nil {
-			return err
+			return nil, err
 		}
 		// Skip if redelegation is already mature
 		if completion.Before(ctx.BlockTime()) {
@@ -56,20 +87,20 @@ func (k Keeper) SlashRedelegations(ctx sdk.Context, valAddr sdk.ValAddress, frac
 
 		delAddr, err := sdk.AccAddressFromBech32(redelegation.DelegatorAddress)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		dstValAddr, err := sdk.ValAddressFromBech32(redelegation.DstValidatorAddress)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		dstVal, err := k.GetPetrichorValidator(ctx, dstValAddr)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		_, err = k.ClaimDelegationRewards(ctx, delAddr, dstVal, redelegation.Balance.Denom)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		delegation, found := k.GetDelegation(ctx, delAddr, dstVal, redelegation.Balance.Denom)
@@ -86,25 +117,48 @@ func (k Keeper) SlashRedelegations(ctx sdk.Context, valAddr sdk.ValAddress, frac
 		tokensToSlash := fraction.MulInt(redelegation.Balance.Amount).TruncateInt()
 		sharesToSlash, err := k.ValidateDelegatedAmount(delegation, sdk.NewCoin(redelegation.Balance.Denom, tokensToSlash), dstVal, asset)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		dstVal.TotalDelegatorShares = sdk.NewDecCoins(dstVal.TotalDelegatorShares...).Sub(sdk.NewDecCoins(sdk.NewDecCoinFromDec(asset.Denom, sharesToSlash)))
 		k.SetValidator(ctx, dstVal)
 
 		delegation.Shares = delegation.Shares.Sub(sharesToSlash)
 		k.SetDelegation(ctx, delAddr, dstVal.GetOperator(), asset.Denom, delegation)
+
+		slashedRedelegation := types.Redelegation{
+			DelegatorAddress:    redelegation.DelegatorAddress,
+			SrcValidatorAddress: redelegation.SrcValidatorAddress,
+			DstValidatorAddress: redelegation.DstValidatorAddress,
+			Balance:             sdk.NewCoin(asset.Denom, tokensToSlash),
+		}
+		slashed = append(slashed, slashedRedelegation)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSlashRedelegation,
+				sdk.NewAttribute(types.AttributeKeyDelegator, slashedRedelegation.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeySrcValidator, slashedRedelegation.SrcValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, slashedRedelegation.DstValidatorAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, slashedRedelegation.Balance.String()),
+			),
+		)
 	}
-	return nil
+	return slashed, nil
 }
 
-func (k Keeper) SlashUndelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+// SlashUndelegations slashes the immature undelegations from the validator and returns the slashed entries
+// with the balance set to the slashed amount. Assets with an insurance coverage ceiling have the slashed tokens paid
+// back from the insurance fund up to the ceiling
+func (k Keeper) SlashUndelegations(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) ([]types.Undelegation, error) {
 	store := ctx.KVStore(k.storeKey)
+	var slashed []types.Undelegation
+	covered := map[string]math.Int{}
 	// Slash all immature re-delegations
 	undelegationIterator := k.IterateUndelegationsBySrcValidator(ctx, valAddr)
 	for ; undelegationIterator.Valid(); undelegationIterator.Next() {
 		undelegationKey, completion, err := types.ParseUnbondingIndexKeyToUndelegationKey(undelegationIterator.Key())
 		if err != nil {
-			return err
+			return nil, err
 		}
 		// Skip if undelegation is already mature
 		if completion.Before(ctx.BlockTime()) {
@@ -121,11 +175,141 @@ func (k Keeper) SlashUndelegations(ctx sdk.Context, valAddr sdk.ValAddress, frac
 			coinToSlash := sdk.NewCoin(entry.Balance.Denom, tokensToSlash)
 			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(coinToSlash))
 			if err != nil {
-				return err
+				return nil, err
+			}
+
+			slashedUndelegation := types.Undelegation{
+				DelegatorAddress: entry.DelegatorAddress,
+				ValidatorAddress: entry.ValidatorAddress,
+				Balance:          coinToSlash,
+			}
+			slashed = append(slashed, slashedUndelegation)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSlashUndelegation,
+					sdk.NewAttribute(types.AttributeKeyDelegator, slashedUndelegation.DelegatorAddress),
+					sdk.NewAttribute(types.AttributeKeyValidator, slashedUndelegation.ValidatorAddress),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, coinToSlash.String()),
+				),
+			)
+
+			alreadyCovered, ok := covered[entry.Balance.Denom]
+			if !ok {
+				alreadyCovered = math.ZeroInt()
 			}
+			coverage, err := k.coverSlashedUndelegation(ctx, entry, coinToSlash, alreadyCovered)
+			if err != nil {
+				return nil, err
+			}
+			covered[entry.Balance.Denom] = alreadyCovered.Add(coverage)
 		}
 		b = k.cdc.MustMarshal(&undelegations)
 		store.Set(undelegationKey, b)
 	}
-	return nil
+	return slashed, nil
+}
+
+// SetSlashRecord stores a slash record, combining it with any existing record of the validator at the same height
+func (k Keeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	key := types.GetSlashRecordKey(valAddr, record.Height)
+	if b := store.Get(key); b != nil {
+		var existing types.SlashRecord
+		k.cdc.MustUnmarshal(b, &existing)
+		// Successive slashes compound on what is left after the earlier ones
+		remaining := sdk.OneDec().Sub(existing.Fraction).Mul(sdk.OneDec().Sub(record.Fraction))
+		record.Fraction = sdk.OneDec().Sub(remaining)
+		record.SlashedTokens = existing.SlashedTokens.Add(record.SlashedTokens...)
+		record.Redelegations = append(existing.Redelegations, record.Redelegations...)
+		record.Undelegations = append(existing.Undelegations, record.Undelegations...)
+	}
+	store.Set(key, k.cdc.MustMarshal(&record))
+	k.setSlashRecordIndexes(ctx, record)
+}
+
+// setSlashRecordIndexes indexes the slash record under every delegation position it affected. Slashed tokens affect
+// all delegations to the validator in the denom, while slashed redelegations only affect the delegator's position
+// with the destination validator
+func (k Keeper) setSlashRecordIndexes(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	valAddr, err := sdk.ValAddressFromBech32(record.ValidatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	for _, coin := range record.SlashedTokens {
+		if coin.IsPositive() {
+			store.Set(types.GetSlashRecordByDelegationIndexKey(valAddr, coin.Denom, record.Height, valAddr, nil), []byte{})
+		}
+	}
+	for _, redelegation := range record.Redelegations {
+		delAddr := sdk.MustAccAddressFromBech32(redelegation.DelegatorAddress)
+		dstValAddr, err := sdk.ValAddressFromBech32(redelegation.DstValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.GetSlashRecordByDelegationIndexKey(dstValAddr, redelegation.Balance.Denom, record.Height, valAddr, delAddr), []byte{})
+	}
+}
+
+// GetSlashRecord returns the slash record of the validator at the height
+func (k Keeper) GetSlashRecord(ctx sdk.Context, valAddr sdk.ValAddress, height uint64) (record types.SlashRecord, found bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetSlashRecordKey(valAddr, height))
+	if b == nil {
+		return record, false
+	}
+	k.cdc.MustUnmarshal(b, &record)
+	return record, true
+}
+
+// IterateSlashRecords iterates over the slash records of all validators ordered by validator and height
+func (k Keeper) IterateSlashRecords(ctx sdk.Context, cb func(record types.SlashRecord) (stop bool)) {
+	k.iterateSlashRecords(prefix.NewStore(ctx.KVStore(k.storeKey), types.SlashRecordKey), cb)
+}
+
+// IterateValidatorSlashRecords iterates over the slash records of a validator ordered by height
+func (k Keeper) IterateValidatorSlashRecords(ctx sdk.Context, valAddr sdk.ValAddress, cb func(record types.SlashRecord) (stop bool)) {
+	k.iterateSlashRecords(prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSlashRecordsKeyForValidator(valAddr)), cb)
+}
+
+func (k Keeper) iterateSlashRecords(store prefix.Store, cb func(record types.SlashRecord) (stop bool)) {
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
+
+// GetDelegationSlashRecords returns the slash records since startHeight that affected the delegation, ordered by
+// height. This covers slashes of the validator that touched the asset and slashes of other validators that hit an
+// immature redelegation into the delegation
+func (k Keeper) GetDelegationSlashRecords(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, startHeight uint64) []types.SlashRecord {
+	records := []types.SlashRecord{}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSlashRecordsByDelegationIndexKey(valAddr, denom))
+	iter := store.Iterator(sdk.Uint64ToBigEndian(startHeight), nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if record, found := k.getDelegationSlashRecord(ctx, iter.Key(), delAddr); found {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// getDelegationSlashRecord returns the slash record a delegation slash index key points to, unless the record only
+// affected another delegator
+func (k Keeper) getDelegationSlashRecord(ctx sdk.Context, indexSuffix []byte, delAddr sdk.AccAddress) (types.SlashRecord, bool) {
+	height, slashedVal, recordDelAddr := types.ParseSlashRecordByDelegationIndexSuffix(indexSuffix)
+	if len(recordDelAddr) > 0 && !recordDelAddr.Equals(delAddr) {
+		return types.SlashRecord{}, false
+	}
+	return k.GetSlashRecord(ctx, slashedVal, height)
 }
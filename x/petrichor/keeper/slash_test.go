@@ -3,11 +3,13 @@ package keeper_test
 import (
 	test_helpers "github.com/petrinetwork/petrichor/app"
 	"github.com/petrinetwork/petrichor/x/petrichor"
+	"github.com/petrinetwork/petrichor/x/petrichor/keeper"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
 	"testing"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/staking/teststaking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
@@ -426,3 +428,242 @@ func TestSlashingAfterUndelegation(t *testing.T) {
 	_, stop := petrichor.RunAllInvariants(ctx, app.PetrichorKeeper)
 	require.False(t, stop)
 }
+
+func TestSlashingHistory(t *testing.T) {
+	var err error
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			{
+				Denom:        PETRICHOR_TOKEN_DENOM,
+				RewardWeight: sdk.NewDec(2),
+				TakeRate:     sdk.NewDec(0),
+				TotalTokens:  sdk.ZeroInt(),
+			},
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.PetrichorKeeper)
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 4, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(20_000_000)),
+	))
+	pks := test_helpers.CreateTestPubKeys(2)
+	valAddr1 := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr1, pks[0]))
+	val1, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr1)
+	require.NoError(t, err)
+	valAddr2 := sdk.ValAddress(addrs[1])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr2, pks[1]))
+	val2, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr2)
+	require.NoError(t, err)
+	user1 := addrs[2]
+	user2 := addrs[3]
+
+	_, err = app.PetrichorKeeper.Delegate(ctx, user1, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user2, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Redelegate(ctx, user1, val1, val2, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(5_000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user2, val1, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(5_000_000)))
+	require.NoError(t, err)
+
+	// Slash val 1 twice in the same block
+	ctx = ctx.WithBlockHeight(5)
+	err = app.PetrichorKeeper.SlashValidator(ctx, valAddr1, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.SlashValidator(ctx, valAddr1, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+
+	var eventTypes []string
+	for _, event := range ctx.EventManager().Events() {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Contains(t, eventTypes, types.EventTypeSlashValidator)
+	require.Contains(t, eventTypes, types.EventTypeSlashRedelegation)
+	require.Contains(t, eventTypes, types.EventTypeSlashUndelegation)
+
+	// Slashes at the same height are combined into a single record
+	res, err := queryServer.PetrichorValidatorSlashes(ctx, &types.QueryPetrichorValidatorSlashesRequest{
+		ValidatorAddr: valAddr1.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 1)
+	record := res.Slashes[0]
+	require.Equal(t, uint64(5), record.Height)
+	require.Equal(t, sdk.MustNewDecFromStr("0.19"), record.Fraction)
+	require.True(t, record.SlashedTokens.AmountOf(PETRICHOR_TOKEN_DENOM).IsPositive())
+	require.Len(t, record.Redelegations, 2)
+	require.Equal(t, user1.String(), record.Redelegations[0].DelegatorAddress)
+	require.Equal(t, sdk.NewInt(500_000), record.Redelegations[0].Balance.Amount)
+	require.Len(t, record.Undelegations, 2)
+	require.Equal(t, sdk.NewInt(500_000), record.Undelegations[0].Balance.Amount)
+
+	// A later start height excludes the slash
+	res, err = queryServer.PetrichorValidatorSlashes(ctx, &types.QueryPetrichorValidatorSlashesRequest{
+		ValidatorAddr: valAddr1.String(),
+		StartHeight:   6,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 0)
+
+	// The start height is where the first page begins so it cannot be combined with an offset
+	_, err = queryServer.PetrichorValidatorSlashes(ctx, &types.QueryPetrichorValidatorSlashesRequest{
+		ValidatorAddr: valAddr1.String(),
+		StartHeight:   5,
+		Pagination:    &query.PageRequest{Offset: 1},
+	})
+	require.Error(t, err)
+	res, err = queryServer.PetrichorValidatorSlashes(ctx, &types.QueryPetrichorValidatorSlashesRequest{
+		ValidatorAddr: valAddr1.String(),
+		StartHeight:   5,
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 1)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// The redelegated position with val 2 was affected by the slash of val 1
+	res, err = queryServer.PetrichorDelegationSlashes(ctx, &types.QueryPetrichorDelegationSlashesRequest{
+		DelegatorAddr: user1.String(),
+		ValidatorAddr: valAddr2.String(),
+		Denom:         PETRICHOR_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 1)
+	require.Equal(t, valAddr1.String(), res.Slashes[0].ValidatorAddress)
+
+	res, err = queryServer.PetrichorDelegationSlashes(ctx, &types.QueryPetrichorDelegationSlashesRequest{
+		DelegatorAddr: user2.String(),
+		ValidatorAddr: valAddr2.String(),
+		Denom:         PETRICHOR_TOKEN_DENOM,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 0)
+
+	// Slashes of the validator itself affect every delegation to it in the slashed asset
+	res, err = queryServer.PetrichorDelegationSlashes(ctx, &types.QueryPetrichorDelegationSlashesRequest{
+		DelegatorAddr: user2.String(),
+		ValidatorAddr: valAddr1.String(),
+		Denom:         PETRICHOR_TOKEN_DENOM,
+		Pagination:    &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 1)
+	require.Equal(t, valAddr1.String(), res.Slashes[0].ValidatorAddress)
+	res, err = queryServer.PetrichorDelegationSlashes(ctx, &types.QueryPetrichorDelegationSlashesRequest{
+		DelegatorAddr: user1.String(),
+		ValidatorAddr: valAddr2.String(),
+		Denom:         PETRICHOR_TOKEN_DENOM,
+		StartHeight:   6,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Slashes, 0)
+
+	// Slash records are exported and imported with genesis
+	genesis := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.SlashRecords, 1)
+	require.NoError(t, petrichor.ValidateGenesis(genesis))
+}
+
+func TestSlashingInsuranceCoverage(t *testing.T) {
+	var err error
+	app, ctx := createTestContext(t)
+	startTime := time.Now()
+	ctx = ctx.WithBlockTime(startTime).WithBlockHeight(1)
+	ceiling := sdk.NewInt(600_000)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			{
+				Denom:        PETRICHOR_TOKEN_DENOM,
+				RewardWeight: sdk.NewDec(2),
+				TakeRate:     sdk.MustNewDecFromStr("0.01"),
+				TotalTokens:  sdk.ZeroInt(),
+				TakeRateSplits: []types.TakeRateSplit{
+					{Destination: types.TakeRateDestinationInsuranceFund, Ratio: sdk.OneDec()},
+				},
+				InsuranceCoverageCeiling: &ceiling,
+			},
+		},
+	})
+	queryServer := keeper.NewQueryServerImpl(app.PetrichorKeeper)
+
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 3, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(20_000_000)),
+	))
+	valAddr := sdk.ValAddress(addrs[0])
+	test_helpers.RegisterNewValidator(t, app, ctx, teststaking.NewValidator(t, valAddr, test_helpers.CreateTestPubKeys(1)[0]))
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	user1 := addrs[1]
+	user2 := addrs[2]
+
+	_, err = app.PetrichorKeeper.Delegate(ctx, user1, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user2, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(10_000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user1, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(5_000_000)))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user2, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(5_000_000)))
+	require.NoError(t, err)
+
+	// The take rate of the remaining 10_000_000 tokens funds the insurance
+	assets := app.PetrichorKeeper.GetAllAssets(ctx)
+	_, err = app.PetrichorKeeper.DeductAssetsWithTakeRate(ctx, ctx.BlockTime().Add(-app.PetrichorKeeper.RewardClaimInterval(ctx)), assets)
+	require.NoError(t, err)
+	res, err := queryServer.PetrichorInsuranceFund(ctx, &types.QueryPetrichorInsuranceFundRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(100_000))), res.Balance)
+
+	// Top up the fund so that the ceiling limits the coverage
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, addrs[0], types.InsuranceFundName, sdk.NewCoins(sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1_000_000))))
+	require.NoError(t, err)
+
+	// Each undelegation loses 500_000 tokens and only 600_000 tokens are covered in total
+	ctx = ctx.WithBlockHeight(5)
+	err = app.PetrichorKeeper.SlashValidator(ctx, valAddr, sdk.MustNewDecFromStr("0.1"))
+	require.NoError(t, err)
+
+	res, err = queryServer.PetrichorInsuranceFund(ctx, &types.QueryPetrichorInsuranceFundRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(500_000))), res.Balance)
+
+	claims, err := queryServer.PetrichorInsuranceClaims(ctx, &types.QueryPetrichorInsuranceClaimsRequest{})
+	require.NoError(t, err)
+	require.Len(t, claims.Claims, 2)
+	totalCovered := sdk.ZeroInt()
+	for _, claim := range claims.Claims {
+		require.Equal(t, uint64(5), claim.Height)
+		require.Equal(t, sdk.NewInt(500_000), claim.Slashed.Amount)
+		totalCovered = totalCovered.Add(claim.Covered.Amount)
+	}
+	require.Equal(t, ceiling, totalCovered)
+
+	claims, err = queryServer.PetrichorInsuranceClaims(ctx, &types.QueryPetrichorInsuranceClaimsRequest{
+		DelegatorAddr: user1.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, claims.Claims, 1)
+	require.Equal(t, user1.String(), claims.Claims[0].DelegatorAddress)
+
+	// The covered tokens are added back to the undelegations
+	balances := sdk.ZeroInt()
+	app.PetrichorKeeper.IterateUndelegations(ctx, func(undelegation types.QueuedUndelegation, completionTime time.Time) (stop bool) {
+		for _, entry := range undelegation.Entries {
+			balances = balances.Add(entry.Balance.Amount)
+		}
+		return false
+	})
+	require.Equal(t, sdk.NewInt(9_600_000), balances)
+
+	genesis := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.InsuranceClaims, 2)
+	require.NoError(t, petrichor.ValidateGenesis(genesis))
+
+	_, stop := petrichor.RunAllInvariants(ctx, app.PetrichorKeeper)
+	require.False(t, stop)
+}
@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// recordTakeRate adds the take rate deducted from an asset to its cumulative total and history
+func (k Keeper) recordTakeRate(ctx sdk.Context, denom string, amount math.Int) types.TakeRateAccrual {
+	accrual := k.GetTakeRateAccrual(ctx, denom)
+	accrual.TotalAmount = accrual.TotalAmount.Add(amount)
+	accrual.LastClaimTime = ctx.BlockTime()
+	k.SetTakeRateAccrual(ctx, accrual)
+	k.SetTakeRateRecord(ctx, types.TakeRateRecord{
+		Denom:  denom,
+		Height: uint64(ctx.BlockHeight()),
+		Time:   ctx.BlockTime(),
+		Amount: amount,
+	})
+	return accrual
+}
+
+// GetTakeRateAccrual returns the cumulative take rate deducted from an asset. Defaults to zero
+func (k Keeper) GetTakeRateAccrual(ctx sdk.Context, denom string) types.TakeRateAccrual {
+	b := ctx.KVStore(k.storeKey).Get(types.GetTakeRateAccrualKey(denom))
+	if b == nil {
+		return types.TakeRateAccrual{
+			Denom:       denom,
+			TotalAmount: sdk.ZeroInt(),
+		}
+	}
+	var accrual types.TakeRateAccrual
+	k.cdc.MustUnmarshal(b, &accrual)
+	return accrual
+}
+
+func (k Keeper) SetTakeRateAccrual(ctx sdk.Context, accrual types.TakeRateAccrual) {
+	b := k.cdc.MustMarshal(&accrual)
+	ctx.KVStore(k.storeKey).Set(types.GetTakeRateAccrualKey(accrual.Denom), b)
+}
+
+func (k Keeper) IterateTakeRateAccruals(ctx sdk.Context, cb func(accrual types.TakeRateAccrual) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TakeRateAccrualKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var accrual types.TakeRateAccrual
+		k.cdc.MustUnmarshal(iter.Value(), &accrual)
+		if cb(accrual) {
+			return
+		}
+	}
+}
+
+// SetTakeRateRecord stores the take rate deducted from an asset at a height. Deductions at the same height are
+// merged since the take rate is claimed at most once per block
+func (k Keeper) SetTakeRateRecord(ctx sdk.Context, record types.TakeRateRecord) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetTakeRateHistoryKey(record.Denom, record.Height)
+	if b := store.Get(key); b != nil {
+		var existing types.TakeRateRecord
+		k.cdc.MustUnmarshal(b, &existing)
+		record.Amount = record.Amount.Add(existing.Amount)
+	}
+	store.Set(key, k.cdc.MustMarshal(&record))
+}
+
+// PruneTakeRateHistory removes the take rate records that are older than the TakeRateHistoryRetention param. Records
+// of an asset are sorted by height, so only the expired records and the first one that is kept are read
+func (k Keeper) PruneTakeRateHistory(ctx sdk.Context) {
+	retention := k.TakeRateHistoryRetention(ctx)
+	if retention <= 0 {
+		return
+	}
+	cutoff := ctx.BlockTime().Add(-retention)
+	store := ctx.KVStore(k.storeKey)
+	k.IterateTakeRateAccruals(ctx, func(accrual types.TakeRateAccrual) (stop bool) {
+		var expired [][]byte
+		iter := sdk.KVStorePrefixIterator(store, types.GetTakeRateHistoryKeyForDenom(accrual.Denom))
+		for ; iter.Valid(); iter.Next() {
+			var record types.TakeRateRecord
+			k.cdc.MustUnmarshal(iter.Value(), &record)
+			if !record.Time.Before(cutoff) {
+				break
+			}
+			expired = append(expired, iter.Key())
+		}
+		iter.Close()
+		for _, key := range expired {
+			store.Delete(key)
+		}
+		return false
+	})
+}
+
+func (k Keeper) IterateTakeRateHistory(ctx sdk.Context, cb func(record types.TakeRateRecord) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TakeRateHistoryKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record types.TakeRateRecord
+		k.cdc.MustUnmarshal(iter.Value(), &record)
+		if cb(record) {
+			return
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// SetDelegatorFallbackValidator sets the validator that petrichor delegations of the delegator are moved to when
+// their validator is tombstoned. An empty validator address removes the entry
+func (k Keeper) SetDelegatorFallbackValidator(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if valAddr.Empty() {
+		ctx.KVStore(k.storeKey).Delete(types.GetFallbackValidatorKey(delAddr))
+		return nil
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return types.ErrValidatorNotFound.Wrapf("validator: %s", valAddr)
+	}
+	k.setFallbackValidator(ctx, delAddr, valAddr)
+	return nil
+}
+
+func (k Keeper) setFallbackValidator(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
+	ctx.KVStore(k.storeKey).Set(types.GetFallbackValidatorKey(delAddr), valAddr.Bytes())
+}
+
+// GetDelegatorFallbackValidator returns the fallback validator of the delegator if one is set
+func (k Keeper) GetDelegatorFallbackValidator(ctx sdk.Context, delAddr sdk.AccAddress) (sdk.ValAddress, bool) {
+	b := ctx.KVStore(k.storeKey).Get(types.GetFallbackValidatorKey(delAddr))
+	if b == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(b), true
+}
+
+func (k Keeper) IterateFallbackValidators(ctx sdk.Context, cb func(delAddr sdk.AccAddress, valAddr sdk.ValAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.FallbackValidatorKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr := types.ParseFallbackValidatorKey(iter.Key())
+		if cb(delAddr, iter.Value()) {
+			return
+		}
+	}
+}
+
+// queueTombstonedValidator queues the validator for ProcessTombstonedValidators if it is tombstoned and automatic
+// redelegation is enabled
+func (k Keeper) queueTombstonedValidator(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress) {
+	if !k.AutoRedelegateTombstoned(ctx) || !k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(types.GetTombstonedValidatorQueueKey(valAddr), []byte{})
+}
+
+// ProcessTombstonedValidators redelegates up to MaxAutoRedelegationsPerBlock delegations away from queued tombstoned
+// validators. A validator leaves the queue once all of its delegations have been visited, while failed redelegations
+// are retried in up to MaxWindDownPasses passes without holding back the other delegations.
+// Delegations of the liquid staking pool are kept since they back outstanding receipts
+func (k Keeper) ProcessTombstonedValidators(ctx sdk.Context) {
+	if !k.AutoRedelegateTombstoned(ctx) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	var queuedAddrs []sdk.ValAddress
+	iter := sdk.KVStorePrefixIterator(store, types.TombstonedValidatorQueueKey)
+	for ; iter.Valid(); iter.Next() {
+		queuedAddrs = append(queuedAddrs, types.ParsePetrichorValidatorKey(iter.Key()))
+	}
+	iter.Close()
+	if len(queuedAddrs) == 0 {
+		return
+	}
+
+	poolAddr := k.accountKeeper.GetModuleAddress(types.LiquidStakingPoolName).String()
+	budget := types.MaxAutoRedelegationsPerBlock
+	for _, valAddr := range queuedAddrs {
+		if budget <= 0 {
+			return
+		}
+		queueKey := types.GetTombstonedValidatorQueueKey(valAddr)
+		if _, err := k.GetPetrichorValidator(ctx, valAddr); err != nil {
+			store.Delete(queueKey)
+			continue
+		}
+		k.windDown(ctx, queueKey, [][]byte{types.GetDelegationsByValidatorIndexKey(valAddr)}, &budget,
+			types.EventTypeAutoRedelegateFailed, func(ctx sdk.Context, d types.Delegation) error {
+				if d.DelegatorAddress == poolAddr {
+					return nil
+				}
+				// Rounding dust worth less than a token cannot be moved and is left behind
+				val, err := k.GetPetrichorValidator(ctx, valAddr)
+				if err != nil {
+					return err
+				}
+				asset, found := k.GetAssetByDenom(ctx, d.Denom)
+				if !found || !types.GetDelegationTokens(d, val, asset).Amount.IsPositive() {
+					return nil
+				}
+				return k.autoRedelegate(ctx, d)
+			})
+	}
+}
+
+// autoRedelegate moves a whole delegation to the fallback validator of the delegator, or spreads it evenly across
+// the active validators that can take a share within the asset caps and minimum delegation amount. The delegation
+// is undelegated when no validator can take it
+func (k Keeper) autoRedelegate(ctx sdk.Context, d types.Delegation) error {
+	delAddr, err := sdk.AccAddressFromBech32(d.DelegatorAddress)
+	if err != nil {
+		return err
+	}
+	srcValAddr, err := sdk.ValAddressFromBech32(d.ValidatorAddress)
+	if err != nil {
+		return err
+	}
+	srcVal, err := k.GetPetrichorValidator(ctx, srcValAddr)
+	if err != nil {
+		return err
+	}
+	asset, found := k.GetAssetByDenom(ctx, d.Denom)
+	if !found {
+		return types.ErrUnknownAsset
+	}
+	tokens := types.GetDelegationTokens(d, srcVal, asset).Amount
+	if !tokens.IsPositive() {
+		return nil
+	}
+
+	targets := k.autoRedelegationTargets(ctx, delAddr, srcVal, asset, tokens)
+	if len(targets) == 0 {
+		return k.forceUndelegateAll(ctx, []types.Delegation{d})
+	}
+
+	perTarget := tokens.QuoRaw(int64(len(targets)))
+	for i, dstVal := range targets {
+		// re-query validator since it was updated by the previous redelegation
+		srcVal, _ = k.GetPetrichorValidator(ctx, srcValAddr)
+		amount := perTarget
+		if i == len(targets)-1 {
+			// The last target receives whatever is left so that no dust stays with the tombstoned validator
+			delegation, found := k.GetDelegation(ctx, delAddr, srcVal, d.Denom)
+			if !found {
+				break
+			}
+			amount = types.GetDelegationTokens(delegation, srcVal, asset).Amount
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		coin := sdk.NewCoin(d.Denom, amount)
+		completionTime, err := k.redelegate(ctx, delAddr, srcVal, dstVal, coin, false)
+		if err != nil {
+			return err
+		}
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoRedelegate,
+				sdk.NewAttribute(types.AttributeKeyDelegator, d.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeySrcValidator, d.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDstValidator, dstVal.GetOperator().String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionTime, completionTime.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// autoRedelegationTargets returns the fallback validator of the delegator when it is active and can take all of
+// tokens. Otherwise it returns the active validators that tokens can be spread evenly across, using no more targets
+// than the minimum delegation amount allows and dropping validators whose share would exceed the asset caps
+func (k Keeper) autoRedelegationTargets(ctx sdk.Context, delAddr sdk.AccAddress, srcVal types.PetrichorValidator, asset types.PetrichorAsset, tokens math.Int) []types.PetrichorValidator {
+	isTarget := func(val types.PetrichorValidator) bool {
+		return val.IsBonded() && !val.IsJailed() && !val.GetOperator().Equals(srcVal.GetOperator()) && val.AcceptsDenom(asset.Denom)
+	}
+	accepts := func(val types.PetrichorValidator, amount math.Int) bool {
+		return k.checkAssetCaps(asset, val, amount, false) == nil && k.checkMinDelegation(ctx, delAddr, val, asset, amount) == nil
+	}
+	if fallbackAddr, found := k.GetDelegatorFallbackValidator(ctx, delAddr); found {
+		fallback, err := k.GetPetrichorValidator(ctx, fallbackAddr)
+		if err == nil && isTarget(fallback) && accepts(fallback, tokens) {
+			return []types.PetrichorValidator{fallback}
+		}
+	}
+
+	var candidates []types.PetrichorValidator
+	for _, validator := range k.stakingKeeper.GetAllValidators(ctx) {
+		if !validator.IsBonded() {
+			continue
+		}
+		val, err := k.GetPetrichorValidator(ctx, validator.GetOperator())
+		if err != nil || !isTarget(val) {
+			continue
+		}
+		candidates = append(candidates, val)
+	}
+
+	// Dropping a validator raises the share of the others, so the split is checked again until every target accepts
+	// its share
+	for len(candidates) > 0 {
+		count := int64(len(candidates))
+		if asset.HasMinDelegationAmount() && asset.MinDelegationAmount.IsPositive() {
+			maxTargets := tokens.Quo(*asset.MinDelegationAmount)
+			if maxTargets.LT(sdk.NewInt(count)) {
+				count = maxTargets.Int64()
+			}
+		}
+		if count == 0 {
+			count = 1
+		}
+		// The last target also receives the remainder of the split
+		perTarget := tokens.QuoRaw(count)
+		remainder := tokens.ModRaw(count)
+		var rejected []types.PetrichorValidator
+		var targets []types.PetrichorValidator
+		for i, val := range candidates[:count] {
+			amount := perTarget
+			if int64(i) == count-1 {
+				amount = amount.Add(remainder)
+			}
+			if accepts(val, amount) {
+				targets = append(targets, val)
+			} else {
+				rejected = append(rejected, val)
+			}
+		}
+		if len(rejected) == 0 {
+			return targets
+		}
+		candidates = append(targets, candidates[count:]...)
+	}
+	return nil
+}
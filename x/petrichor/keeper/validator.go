@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/petrinetwork/petrichor/x/petrichor/types"
 )
 
@@ -71,3 +72,121 @@ func (k Keeper) GetAllPetrichorValidatorInfo(ctx sdk.Context) []types.PetrichorV
 	}
 	return infos
 }
+
+// initializeValidatorInfo creates the petrichor info of a validator that has none yet
+func (k Keeper) initializeValidatorInfo(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	if _, found := k.GetPetrichorValidatorInfo(ctx, valAddr); found {
+		return nil
+	}
+	k.createPetrichorValidatorInfo(ctx, valAddr)
+	return k.PetrichorHooks().AfterPetrichorValidatorCreated(ctx, valAddr)
+}
+
+// removeValidator pays out the pending rewards of the delegations and the accumulated commission of a validator
+// removed from x/staking. The info is archived when no petrichor delegations are left and kept for the remaining
+// delegations otherwise
+func (k Keeper) removeValidator(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	info, found := k.GetPetrichorValidatorInfo(ctx, valAddr)
+	if !found {
+		return nil
+	}
+	if err := k.PetrichorHooks().BeforePetrichorValidatorRemoved(ctx, valAddr); err != nil {
+		return err
+	}
+
+	// The validator is already deleted from x/staking so it is rebuilt from its petrichor info
+	val := types.PetrichorValidator{
+		Validator:              &stakingtypes.Validator{OperatorAddress: valAddr.String()},
+		PetrichorValidatorInfo: &info,
+	}
+	var delegations []types.Delegation
+	k.IterateDelegationsByValidator(ctx, valAddr, func(d types.Delegation) (stop bool) {
+		delegations = append(delegations, d)
+		return false
+	})
+	for _, d := range delegations {
+		if _, found := k.GetAssetByDenom(ctx, d.Denom); !found {
+			continue
+		}
+		delAddr, err := sdk.AccAddressFromBech32(d.DelegatorAddress)
+		if err != nil {
+			return err
+		}
+		_, err = k.ClaimDelegationRewards(ctx, delAddr, val, d.Denom)
+		if err != nil {
+			return err
+		}
+	}
+	if !info.AccumulatedCommission.IsZero() {
+		_, err := k.WithdrawValidatorCommission(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+	}
+	// The info is intentionally kept without retrying the archive later. It holds the shares of the remaining
+	// delegations, which become reachable again if the operator creates the validator anew
+	if len(delegations) > 0 {
+		return nil
+	}
+	return k.archiveValidatorInfo(ctx, valAddr)
+}
+
+// archiveValidatorInfo moves the info of a validator without petrichor delegations to the archive and removes the
+// state that only existed for its delegations
+func (k Keeper) archiveValidatorInfo(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	info, _ := k.GetPetrichorValidatorInfo(ctx, valAddr)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPetrichorValidatorInfoKey(valAddr))
+	store.Delete(types.GetValidatorOptOutQueueKey(valAddr))
+	store.Delete(types.GetTombstonedValidatorQueueKey(valAddr))
+	k.setArchivedValidatorInfo(ctx, valAddr, info)
+
+	for _, asset := range k.GetAllAssets(ctx) {
+		var keys [][]byte
+		iter := k.IterateWeightChangeSnapshot(ctx, asset.Denom, valAddr, 0)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeArchiveValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		),
+	)
+	return k.PetrichorHooks().AfterPetrichorValidatorArchived(ctx, valAddr)
+}
+
+// GetArchivedValidatorInfo returns the last petrichor info of a validator removed from x/staking
+func (k Keeper) GetArchivedValidatorInfo(ctx sdk.Context, valAddr sdk.ValAddress) (types.PetrichorValidatorInfo, bool) {
+	var info types.PetrichorValidatorInfo
+	b := ctx.KVStore(k.storeKey).Get(types.GetArchivedValidatorInfoKey(valAddr))
+	if b == nil {
+		return info, false
+	}
+	k.cdc.MustUnmarshal(b, &info)
+	return info, true
+}
+
+func (k Keeper) setArchivedValidatorInfo(ctx sdk.Context, valAddr sdk.ValAddress, info types.PetrichorValidatorInfo) {
+	ctx.KVStore(k.storeKey).Set(types.GetArchivedValidatorInfoKey(valAddr), k.cdc.MustMarshal(&info))
+}
+
+func (k Keeper) IterateArchivedValidatorInfo(ctx sdk.Context, cb func(valAddr sdk.ValAddress, info types.PetrichorValidatorInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ArchivedValidatorInfoKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var info types.PetrichorValidatorInfo
+		k.cdc.MustUnmarshal(iter.Value(), &info)
+		valAddr := types.ParsePetrichorValidatorKey(iter.Key())
+		if cb(valAddr, info) {
+			return
+		}
+	}
+}
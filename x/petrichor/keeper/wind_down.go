@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// windDown processes the delegations of a queued delisted asset, opted out or tombstoned validator. indexPrefixes
+// are delegation index prefixes whose values are delegation keys, and every visited index entry uses up one unit of
+// budget. The entry keeps a cursor so that the next block continues after the last visited delegation instead of
+// picking up failing delegations again. A pass that had failures is followed by another one until MaxWindDownPasses
+// is reached. Returns true once the entry has been removed from the queue
+func (k Keeper) windDown(ctx sdk.Context, queueKey []byte, indexPrefixes [][]byte, budget *int, failedEventType string,
+	process func(ctx sdk.Context, d types.Delegation) error,
+) bool {
+	if *budget <= 0 {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	passes, failed, cursor := types.ParseWindDownProgress(store.Get(queueKey))
+
+	sort.Slice(indexPrefixes, func(i, j int) bool {
+		return bytes.Compare(indexPrefixes[i], indexPrefixes[j]) < 0
+	})
+	var indexKeys, delegationKeys [][]byte
+	exhausted := true
+	for _, indexPrefix := range indexPrefixes {
+		start, end := indexPrefix, sdk.PrefixEndBytes(indexPrefix)
+		if bytes.Compare(cursor, end) >= 0 {
+			continue
+		}
+		if bytes.Compare(cursor, start) > 0 {
+			start = cursor
+		}
+		iter := store.Iterator(start, end)
+		for ; iter.Valid() && len(indexKeys) < *budget; iter.Next() {
+			indexKeys = append(indexKeys, iter.Key())
+			delegationKeys = append(delegationKeys, iter.Value())
+		}
+		more := iter.Valid()
+		iter.Close()
+		if more {
+			exhausted = false
+			break
+		}
+	}
+	*budget -= len(indexKeys)
+
+	for _, key := range delegationKeys {
+		b := store.Get(key)
+		if b == nil {
+			continue
+		}
+		var d types.Delegation
+		k.cdc.MustUnmarshal(b, &d)
+
+		// Each delegation is processed in isolation so that a failing delegation does not halt the others
+		cacheCtx, write := ctx.CacheContext()
+		err := process(cacheCtx, d)
+		if err != nil {
+			failed = true
+			k.Logger(ctx).Error("failed to wind down petrichor delegation", "event", failedEventType,
+				"delegator", d.DelegatorAddress, "validator", d.ValidatorAddress, "denom", d.Denom, "error", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				failedEventType,
+				sdk.NewAttribute(types.AttributeKeyDelegator, d.DelegatorAddress),
+				sdk.NewAttribute(types.AttributeKeyValidator, d.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyDenom, d.Denom),
+				sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+			))
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	if !exhausted {
+		if len(indexKeys) > 0 {
+			// The smallest key after the last visited one
+			cursor = append(append([]byte{}, indexKeys[len(indexKeys)-1]...), 0x00)
+		}
+		store.Set(queueKey, types.GetWindDownProgress(passes, failed, cursor))
+		return false
+	}
+	if failed && int(passes)+1 < types.MaxWindDownPasses {
+		store.Set(queueKey, types.GetWindDownProgress(passes+1, false, nil))
+		return false
+	}
+	store.Delete(queueKey)
+	return true
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+)
+
+// SetRewardWithdrawAddress sets the address that petrichor rewards of the delegator are paid to.
+// Setting it back to the delegator address removes the entry
+func (k Keeper) SetRewardWithdrawAddress(ctx sdk.Context, delAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) error {
+	if k.bankKeeper.BlockedAddr(withdrawAddr) {
+		return types.ErrWithdrawAddressBlocked
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetWithdrawAddressKey(delAddr)
+	if withdrawAddr.Equals(delAddr) {
+		store.Delete(key)
+		return nil
+	}
+	store.Set(key, withdrawAddr.Bytes())
+	return nil
+}
+
+// GetRewardWithdrawAddress returns the address that petrichor rewards of the delegator are paid to
+// Defaults to the delegator address
+func (k Keeper) GetRewardWithdrawAddress(ctx sdk.Context, delAddr sdk.AccAddress) sdk.AccAddress {
+	b := ctx.KVStore(k.storeKey).Get(types.GetWithdrawAddressKey(delAddr))
+	if b == nil {
+		return delAddr
+	}
+	return sdk.AccAddress(b)
+}
+
+func (k Keeper) IterateRewardWithdrawAddresses(ctx sdk.Context, cb func(delAddr sdk.AccAddress, withdrawAddr sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.WithdrawAddressKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		delAddr := types.ParseWithdrawAddressKey(iter.Key())
+		if cb(delAddr, iter.Value()) {
+			return
+		}
+	}
+}
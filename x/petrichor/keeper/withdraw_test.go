@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	test_helpers "github.com/petrinetwork/petrichor/app"
+	"github.com/petrinetwork/petrichor/x/petrichor"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewardWithdrawAddress(t *testing.T) {
+	app, ctx := createTestContext(t)
+	app.PetrichorKeeper.InitGenesis(ctx, &types.GenesisState{
+		Params: types.DefaultParams(),
+		Assets: []types.PetrichorAsset{
+			types.NewPetrichorAsset(PETRICHOR_TOKEN_DENOM, sdk.NewDec(1), sdk.NewDec(0), ctx.BlockTime()),
+		},
+	})
+
+	mintPoolAddr := app.AccountKeeper.GetModuleAddress(minttypes.ModuleName)
+	delegations := app.StakingKeeper.GetAllDelegations(ctx)
+	valAddr, err := sdk.ValAddressFromBech32(delegations[0].ValidatorAddress)
+	require.NoError(t, err)
+	val, err := app.PetrichorKeeper.GetPetrichorValidator(ctx, valAddr)
+	require.NoError(t, err)
+	addrs := test_helpers.AddTestAddrsIncremental(app, ctx, 2, sdk.NewCoins(
+		sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(2000_000)),
+	))
+	user := addrs[0]
+	withdrawAddr := addrs[1]
+	err = app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(4000_000))))
+	require.NoError(t, err)
+
+	// Module accounts cannot receive rewards
+	err = app.PetrichorKeeper.SetRewardWithdrawAddress(ctx, user, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	require.ErrorIs(t, err, types.ErrWithdrawAddressBlocked)
+
+	require.Equal(t, user, app.PetrichorKeeper.GetRewardWithdrawAddress(ctx, user))
+	err = app.PetrichorKeeper.SetRewardWithdrawAddress(ctx, user, withdrawAddr)
+	require.NoError(t, err)
+	require.Equal(t, withdrawAddr, app.PetrichorKeeper.GetRewardWithdrawAddress(ctx, user))
+
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+
+	// Explicit claims are paid to the withdraw address
+	coins, err := app.PetrichorKeeper.ClaimDelegationRewards(ctx, user, val, PETRICHOR_TOKEN_DENOM)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))), coins)
+	require.True(t, app.BankKeeper.GetBalance(ctx, user, "stake").IsZero())
+	require.Equal(t, sdk.NewInt(1000_000), app.BankKeeper.GetBalance(ctx, withdrawAddr, "stake").Amount)
+
+	// Implicit claims when delegating again are paid to the withdraw address
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Delegate(ctx, user, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, user, "stake").IsZero())
+	require.Equal(t, sdk.NewInt(2000_000), app.BankKeeper.GetBalance(ctx, withdrawAddr, "stake").Amount)
+
+	// Implicit claims when undelegating are paid to the withdraw address
+	err = app.PetrichorKeeper.AddAssetsToRewardPool(ctx, mintPoolAddr, val, sdk.NewCoins(sdk.NewCoin("stake", sdk.NewInt(1000_000))))
+	require.NoError(t, err)
+	_, err = app.PetrichorKeeper.Undelegate(ctx, user, val, sdk.NewCoin(PETRICHOR_TOKEN_DENOM, sdk.NewInt(1000_000)))
+	require.NoError(t, err)
+	require.True(t, app.BankKeeper.GetBalance(ctx, user, "stake").IsZero())
+	require.Equal(t, sdk.NewInt(3000_000), app.BankKeeper.GetBalance(ctx, withdrawAddr, "stake").Amount)
+
+	state := app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Equal(t, []types.DelegatorWithdrawAddress{
+		{
+			DelegatorAddress: user.String(),
+			WithdrawAddress:  withdrawAddr.String(),
+		},
+	}, state.WithdrawAddresses)
+	require.NoError(t, petrichor.ValidateGenesis(state))
+
+	// Withdraw addresses that cannot be parsed are rejected
+	invalid := *state
+	invalid.WithdrawAddresses = []types.DelegatorWithdrawAddress{
+		{DelegatorAddress: user.String(), WithdrawAddress: "invalid"},
+	}
+	require.ErrorIs(t, petrichor.ValidateGenesis(&invalid), types.ErrInvalidGenesisState)
+	require.Panics(t, func() { app.PetrichorKeeper.InitGenesis(ctx, &invalid) })
+
+	// Setting the withdraw address back to the delegator removes the entry
+	err = app.PetrichorKeeper.SetRewardWithdrawAddress(ctx, user, user)
+	require.NoError(t, err)
+	state = app.PetrichorKeeper.ExportGenesis(ctx)
+	require.Len(t, state.WithdrawAddresses, 0)
+}
@@ -18,6 +18,8 @@ func NewPetrichorProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return k.UpdatePetrichor(ctx, c)
 		case *types.MsgDeletePetrichorProposal:
 			return k.DeletePetrichor(ctx, c)
+		case *types.MsgDelistPetrichorProposal:
+			return k.DelistPetrichor(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized petrichor proposal content type: %T", c)
@@ -19,6 +19,10 @@ func genTakeRateClaimInterval(r *rand.Rand) time.Duration {
 	return time.Duration(simulation.RandIntBetween(r, 1, 60*60)) * time.Second
 }
 
+func genAutoCompoundInterval(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 60, 60*60*24)) * time.Second
+}
+
 func genNumOfPetrichorAssets(r *rand.Rand) int {
 	return simulation.RandIntBetween(r, 0, 50)
 }
@@ -27,12 +31,14 @@ func RandomizedGenesisState(simState *module.SimulationState) {
 	var (
 		rewardDelayTime     time.Duration
 		rewardClaimInterval time.Duration
+		autoCompoundInterval time.Duration
 		numOfPetrichorAssets int
 	)
 
 	r := simState.Rand
 	rewardDelayTime = genRewardDelayTime(r)
 	rewardClaimInterval = genTakeRateClaimInterval(r)
+	autoCompoundInterval = genAutoCompoundInterval(r)
 	numOfPetrichorAssets = genNumOfPetrichorAssets(r)
 
 	var petrichorAssets []types.PetrichorAsset
@@ -48,6 +54,8 @@ func RandomizedGenesisState(simState *module.SimulationState) {
 			RewardDelayTime:       rewardDelayTime,
 			TakeRateClaimInterval: rewardClaimInterval,
 			LastTakeRateClaimTime: simState.GenTimestamp,
+			AutoCompoundInterval:  autoCompoundInterval,
+			LastAutoCompoundTime:  simState.GenTimestamp,
 		},
 		Assets: petrichorAssets,
 	}
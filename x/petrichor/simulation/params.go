@@ -20,5 +20,10 @@ func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 				return fmt.Sprintf("\"%d\"", genTakeRateClaimInterval(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.AutoCompoundInterval),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", genAutoCompoundInterval(r))
+			},
+		),
 	}
 }
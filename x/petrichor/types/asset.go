@@ -3,9 +3,23 @@ package types
 import (
 	cosmosmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"reflect"
 	"time"
 )
 
+// MaxDelistUndelegationsPerBlock limits how many delegations of delisted assets are undelegated each block so that
+// delisting a large asset is spread over several blocks
+const MaxDelistUndelegationsPerBlock = 50
+
+// MaxWindDownPasses limits how many times the delegations of a delisted asset, an opted out validator or a tombstoned
+// validator are walked while some of them keep failing. The failing delegations are left in place after the last pass
+const MaxWindDownPasses = 3
+
+// MinRewardWeightUpdateInterval limits how often the reward weight of an oracle priced or linearly scheduled asset
+// changes. Every change snapshots the rewards of each validator and queues a rebalance, so it must not happen on
+// every block
+const MinRewardWeightUpdateInterval = time.Hour
+
 func NewPetrichorAsset(denom string, rewardWeight sdk.Dec, takeRate sdk.Dec, rewardStartTime time.Time) PetrichorAsset {
 	return PetrichorAsset{
 		Denom:                denom,
@@ -20,6 +34,23 @@ func NewPetrichorAsset(denom string, rewardWeight sdk.Dec, takeRate sdk.Dec, rew
 	}
 }
 
+// AssetConfig returns the fields of the asset that are set by governance
+func (a PetrichorAsset) AssetConfig() PetrichorAssetConfig {
+	return newAssetConfig(a)
+}
+
+// newAssetConfig copies the governance set fields out of an asset or out of a message or proposal creating or
+// updating one. Every field of PetrichorAssetConfig has to exist with the same name and type on src
+func newAssetConfig(src interface{}) PetrichorAssetConfig {
+	var config PetrichorAssetConfig
+	dst := reflect.ValueOf(&config).Elem()
+	srcVal := reflect.Indirect(reflect.ValueOf(src))
+	for i := 0; i < dst.NumField(); i++ {
+		dst.Field(i).Set(srcVal.FieldByName(dst.Type().Field(i).Name))
+	}
+	return config
+}
+
 func ConvertNewTokenToShares(totalTokens sdk.Dec, totalShares sdk.Dec, newTokens cosmosmath.Int) (shares sdk.Dec) {
 	if totalShares.IsZero() {
 		return sdk.NewDecFromInt(newTokens)
@@ -57,3 +88,83 @@ func GetValidatorShares(asset PetrichorAsset, token cosmosmath.Int) sdk.Dec {
 func (a PetrichorAsset) HasPositiveDecay() bool {
 	return a.RewardChangeInterval > 0 && a.RewardChangeRate.IsPositive()
 }
+
+func (a PetrichorAsset) HasMaxTotalTokens() bool {
+	return a.MaxTotalTokens != nil && !a.MaxTotalTokens.IsNil()
+}
+
+func (a PetrichorAsset) HasMaxValidatorShare() bool {
+	return a.MaxValidatorShare != nil && !a.MaxValidatorShare.IsNil()
+}
+
+func (a PetrichorAsset) HasMinDelegationAmount() bool {
+	return a.MinDelegationAmount != nil && !a.MinDelegationAmount.IsNil()
+}
+
+// IsBelowMinDelegation returns true if a delegation holding tokens would be left as dust. An empty delegation is
+// never below the minimum since it gets removed
+func (a PetrichorAsset) IsBelowMinDelegation(tokens cosmosmath.Int) bool {
+	return a.HasMinDelegationAmount() && tokens.IsPositive() && tokens.LT(*a.MinDelegationAmount)
+}
+
+func (a PetrichorAsset) IsOracleWeighted() bool {
+	return a.RewardWeightMode == RewardWeightModeOracle
+}
+
+// RewardWeightUpdateInterval returns how long an oracle priced or linearly scheduled reward weight is kept before it
+// is updated again. It is the reward change interval of the asset but never less than MinRewardWeightUpdateInterval
+func (a PetrichorAsset) RewardWeightUpdateInterval() time.Duration {
+	if a.RewardChangeInterval > MinRewardWeightUpdateInterval {
+		return a.RewardChangeInterval
+	}
+	return MinRewardWeightUpdateInterval
+}
+
+// ClampRewardWeight bounds a reward weight derived from a price or a schedule within min_reward_weight and max_reward_weight
+func (a PetrichorAsset) ClampRewardWeight(weight sdk.Dec) sdk.Dec {
+	if a.MinRewardWeight != nil && !a.MinRewardWeight.IsNil() && weight.LT(*a.MinRewardWeight) {
+		return *a.MinRewardWeight
+	}
+	if a.MaxRewardWeight != nil && !a.MaxRewardWeight.IsNil() && weight.GT(*a.MaxRewardWeight) {
+		return *a.MaxRewardWeight
+	}
+	return weight
+}
+
+// RemainingTotalTokens returns how many tokens can still be delegated before max_total_tokens is reached
+func (a PetrichorAsset) RemainingTotalTokens() cosmosmath.Int {
+	if !a.HasMaxTotalTokens() {
+		return cosmosmath.Int{}
+	}
+	if a.TotalTokens.GTE(*a.MaxTotalTokens) {
+		return cosmosmath.ZeroInt()
+	}
+	return a.MaxTotalTokens.Sub(a.TotalTokens)
+}
+
+// RemainingValidatorTokens returns how many more tokens of the asset the validator can receive before reaching
+// max_validator_share. When the asset has no total cap the share is measured against the total tokens after the
+// delegation, so the new tokens also count towards the total. newTokens is false for redelegations since the total
+// does not change
+func (a PetrichorAsset) RemainingValidatorTokens(validatorTokens sdk.Dec, newTokens bool) cosmosmath.Int {
+	if !a.HasMaxValidatorShare() {
+		return cosmosmath.Int{}
+	}
+	share := *a.MaxValidatorShare
+	var remaining sdk.Dec
+	switch {
+	case a.HasMaxTotalTokens():
+		remaining = share.MulInt(*a.MaxTotalTokens).Sub(validatorTokens)
+	case !newTokens:
+		remaining = share.MulInt(a.TotalTokens).Sub(validatorTokens)
+	case share.GTE(sdk.OneDec()):
+		return cosmosmath.Int{}
+	default:
+		// v + x <= share * (total + x)  =>  x <= (share * total - v) / (1 - share)
+		remaining = share.MulInt(a.TotalTokens).Sub(validatorTokens).Quo(sdk.OneDec().Sub(share))
+	}
+	if !remaining.IsPositive() {
+		return cosmosmath.ZeroInt()
+	}
+	return remaining.TruncateInt()
+}
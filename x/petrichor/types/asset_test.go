@@ -0,0 +1,74 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAssetConfig(t *testing.T) {
+	maxTotalTokens := sdk.NewInt(1000)
+	maxValidatorShare := sdk.NewDecWithPrec(5, 1)
+	minDelegationAmount := sdk.NewInt(10)
+	minRewardWeight := sdk.NewDecWithPrec(1, 1)
+	maxRewardWeight := sdk.NewDec(2)
+	insuranceCoverageCeiling := sdk.NewInt(100)
+	asset := types.NewPetrichorAsset("petrichor", sdk.OneDec(), sdk.NewDecWithPrec(1, 2), time.Unix(0, 0))
+	asset.RewardChangeInterval = time.Hour
+	asset.MaxTotalTokens = &maxTotalTokens
+	asset.MaxValidatorShare = &maxValidatorShare
+	asset.MinDelegationAmount = &minDelegationAmount
+	asset.RewardWeightMode = types.RewardWeightModeSchedule
+	asset.MinRewardWeight = &minRewardWeight
+	asset.MaxRewardWeight = &maxRewardWeight
+	asset.TakeRateSplits = []types.TakeRateSplit{{Destination: types.TakeRateDestinationCommunityPool, Ratio: sdk.OneDec()}}
+	asset.RewardWeightSchedule = []types.RewardWeightSchedulePoint{{Time: time.Unix(100, 0), TargetWeight: sdk.NewDec(2)}}
+	asset.RewardWeightInterpolation = types.RewardWeightInterpolationStep
+	asset.InsuranceCoverageCeiling = &insuranceCoverageCeiling
+
+	// Every governance set field of the asset is carried over
+	config := asset.AssetConfig()
+	require.Equal(t, types.PetrichorAssetConfig{
+		Denom:                     "petrichor",
+		RewardWeight:              sdk.OneDec(),
+		TakeRate:                  sdk.NewDecWithPrec(1, 2),
+		RewardChangeRate:          sdk.OneDec(),
+		RewardChangeInterval:      time.Hour,
+		MaxTotalTokens:            &maxTotalTokens,
+		MaxValidatorShare:         &maxValidatorShare,
+		MinDelegationAmount:       &minDelegationAmount,
+		RewardWeightMode:          types.RewardWeightModeSchedule,
+		MinRewardWeight:           &minRewardWeight,
+		MaxRewardWeight:           &maxRewardWeight,
+		TakeRateSplits:            asset.TakeRateSplits,
+		RewardWeightSchedule:      asset.RewardWeightSchedule,
+		RewardWeightInterpolation: types.RewardWeightInterpolationStep,
+		InsuranceCoverageCeiling:  &insuranceCoverageCeiling,
+	}, config)
+
+	// Messages and proposals hold the same fields
+	require.Equal(t, config, (&types.MsgCreatePetrichor{
+		Authority:                 "authority",
+		Denom:                     config.Denom,
+		RewardWeight:              config.RewardWeight,
+		TakeRate:                  config.TakeRate,
+		RewardChangeRate:          config.RewardChangeRate,
+		RewardChangeInterval:      config.RewardChangeInterval,
+		MaxTotalTokens:            config.MaxTotalTokens,
+		MaxValidatorShare:         config.MaxValidatorShare,
+		MinDelegationAmount:       config.MinDelegationAmount,
+		RewardWeightMode:          config.RewardWeightMode,
+		MinRewardWeight:           config.MinRewardWeight,
+		MaxRewardWeight:           config.MaxRewardWeight,
+		TakeRateSplits:            config.TakeRateSplits,
+		RewardWeightSchedule:      config.RewardWeightSchedule,
+		RewardWeightInterpolation: config.RewardWeightInterpolation,
+		InsuranceCoverageCeiling:  config.InsuranceCoverageCeiling,
+	}).AssetConfig())
+	require.Equal(t, types.PetrichorAssetConfig{Denom: "petrichor"}, (&types.MsgUpdatePetrichor{Denom: "petrichor"}).AssetConfig())
+	require.Equal(t, types.PetrichorAssetConfig{Denom: "petrichor"}, (&types.MsgCreatePetrichorProposal{Denom: "petrichor"}).AssetConfig())
+	require.Equal(t, types.PetrichorAssetConfig{Denom: "petrichor"}, (&types.MsgUpdatePetrichorProposal{Denom: "petrichor"}).AssetConfig())
+}
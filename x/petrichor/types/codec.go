@@ -15,12 +15,31 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgDelegate{},
 		&MsgRedelegate{},
 		&MsgUndelegate{},
+		&MsgLiquidDelegate{},
+		&MsgRedeemLiquidReceipt{},
+		&MsgClaimLiquidReceiptRewards{},
+		&MsgSetAutoCompound{},
+		&MsgSetRewardWithdrawAddress{},
+		&MsgClaimAllDelegationRewards{},
+		&MsgCancelUndelegation{},
+		&MsgUpdateParams{},
+		&MsgCreatePetrichor{},
+		&MsgUpdatePetrichor{},
+		&MsgDeletePetrichor{},
+		&MsgBatchUpdatePetrichors{},
+		&MsgDelistPetrichor{},
+		&MsgSweepDustDelegations{},
+		&MsgSetValidatorDenomFilter{},
+		&MsgSetPetrichorCommission{},
+		&MsgWithdrawPetrichorCommission{},
+		&MsgSetFallbackValidator{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
 		&MsgCreatePetrichorProposal{},
 		&MsgUpdatePetrichorProposal{},
 		&MsgDeletePetrichorProposal{},
+		&MsgDelistPetrichorProposal{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
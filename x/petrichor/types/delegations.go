@@ -4,6 +4,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxClaimAllDelegations bounds the number of delegations, and therefore the gas, a single MsgClaimAllDelegationRewards claims
+const MaxClaimAllDelegations uint32 = 100
+
+// MaxAutoCompoundsPerBlock limits how many auto-compounding delegations are compounded each block. A round that does
+// not fit in one block continues in the next ones
+const MaxAutoCompoundsPerBlock = 100
+
 func NewDelegation(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, shares sdk.Dec, rewardHistory []RewardHistory) Delegation {
 	return Delegation{
 		DelegatorAddress:      delAddr.String(),
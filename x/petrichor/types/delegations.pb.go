@@ -8,17 +8,22 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -26,6 +31,66 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DenomFilterMode defines which petrichor assets a validator accepts delegations in
+type DenomFilterMode int32
+
+const (
+	// Every whitelisted petrichor asset is accepted
+	DenomFilterModeNone DenomFilterMode = 0
+	// Only the filtered denoms are accepted
+	DenomFilterModeAllowlist DenomFilterMode = 1
+	// Every asset but the filtered denoms is accepted
+	DenomFilterModeDenylist DenomFilterMode = 2
+)
+
+var DenomFilterMode_name = map[int32]string{
+	0: "DENOM_FILTER_MODE_NONE",
+	1: "DENOM_FILTER_MODE_ALLOWLIST",
+	2: "DENOM_FILTER_MODE_DENYLIST",
+}
+
+var DenomFilterMode_value = map[string]int32{
+	"DENOM_FILTER_MODE_NONE":      0,
+	"DENOM_FILTER_MODE_ALLOWLIST": 1,
+	"DENOM_FILTER_MODE_DENYLIST":  2,
+}
+
+func (x DenomFilterMode) String() string {
+	return proto.EnumName(DenomFilterMode_name, int32(x))
+}
+
+func (DenomFilterMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{0}
+}
+
+// OptOutPolicy defines what happens to existing delegations in assets a validator no longer accepts
+type OptOutPolicy int32
+
+const (
+	// Existing delegations are kept but cannot be topped up
+	OptOutPolicyGrandfather OptOutPolicy = 0
+	// Existing delegations are gradually undelegated at the end of each block
+	OptOutPolicyUndelegate OptOutPolicy = 1
+)
+
+var OptOutPolicy_name = map[int32]string{
+	0: "OPT_OUT_POLICY_GRANDFATHER",
+	1: "OPT_OUT_POLICY_UNDELEGATE",
+}
+
+var OptOutPolicy_value = map[string]int32{
+	"OPT_OUT_POLICY_GRANDFATHER": 0,
+	"OPT_OUT_POLICY_UNDELEGATE":  1,
+}
+
+func (x OptOutPolicy) String() string {
+	return proto.EnumName(OptOutPolicy_name, int32(x))
+}
+
+func (OptOutPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{1}
+}
+
 type Delegation struct {
 	// delegator_address is the bech32-encoded address of the delegator.
 	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
@@ -72,6 +137,126 @@ func (m *Delegation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Delegation proto.InternalMessageInfo
 
+// AutoCompoundDelegation marks a delegation whose rewards in the delegated denom
+// are periodically re-delegated
+type AutoCompoundDelegation struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *AutoCompoundDelegation) Reset()         { *m = AutoCompoundDelegation{} }
+func (m *AutoCompoundDelegation) String() string { return proto.CompactTextString(m) }
+func (*AutoCompoundDelegation) ProtoMessage()    {}
+func (*AutoCompoundDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{1}
+}
+func (m *AutoCompoundDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoCompoundDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoCompoundDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AutoCompoundDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoCompoundDelegation.Merge(m, src)
+}
+func (m *AutoCompoundDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoCompoundDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoCompoundDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoCompoundDelegation proto.InternalMessageInfo
+
+// DelegatorWithdrawAddress is the address petrichor rewards of a delegator are paid to
+type DelegatorWithdrawAddress struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	WithdrawAddress  string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
+}
+
+func (m *DelegatorWithdrawAddress) Reset()         { *m = DelegatorWithdrawAddress{} }
+func (m *DelegatorWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*DelegatorWithdrawAddress) ProtoMessage()    {}
+func (*DelegatorWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{2}
+}
+func (m *DelegatorWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorWithdrawAddress.Merge(m, src)
+}
+func (m *DelegatorWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorWithdrawAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorWithdrawAddress proto.InternalMessageInfo
+
+// DelegatorFallbackValidator is the validator petrichor delegations of a delegator are moved to when their validator
+// is tombstoned
+type DelegatorFallbackValidator struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *DelegatorFallbackValidator) Reset()         { *m = DelegatorFallbackValidator{} }
+func (m *DelegatorFallbackValidator) String() string { return proto.CompactTextString(m) }
+func (*DelegatorFallbackValidator) ProtoMessage()    {}
+func (*DelegatorFallbackValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{3}
+}
+func (m *DelegatorFallbackValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorFallbackValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorFallbackValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegatorFallbackValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorFallbackValidator.Merge(m, src)
+}
+func (m *DelegatorFallbackValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorFallbackValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorFallbackValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegatorFallbackValidator proto.InternalMessageInfo
+
 type Redelegation struct {
 	DelegatorAddress    string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
 	SrcValidatorAddress string     `protobuf:"bytes,2,opt,name=src_validator_address,json=srcValidatorAddress,proto3" json:"src_validator_address,omitempty"`
@@ -83,7 +268,7 @@ func (m *Redelegation) Reset()         { *m = Redelegation{} }
 func (m *Redelegation) String() string { return proto.CompactTextString(m) }
 func (*Redelegation) ProtoMessage()    {}
 func (*Redelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5234f40c0f8f1070, []int{1}
+	return fileDescriptor_5234f40c0f8f1070, []int{4}
 }
 func (m *Redelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,7 +305,7 @@ func (m *QueuedRedelegation) Reset()         { *m = QueuedRedelegation{} }
 func (m *QueuedRedelegation) String() string { return proto.CompactTextString(m) }
 func (*QueuedRedelegation) ProtoMessage()    {}
 func (*QueuedRedelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5234f40c0f8f1070, []int{2}
+	return fileDescriptor_5234f40c0f8f1070, []int{5}
 }
 func (m *QueuedRedelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +344,7 @@ func (m *Undelegation) Reset()         { *m = Undelegation{} }
 func (m *Undelegation) String() string { return proto.CompactTextString(m) }
 func (*Undelegation) ProtoMessage()    {}
 func (*Undelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5234f40c0f8f1070, []int{3}
+	return fileDescriptor_5234f40c0f8f1070, []int{6}
 }
 func (m *Undelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,7 +381,7 @@ func (m *QueuedUndelegation) Reset()         { *m = QueuedUndelegation{} }
 func (m *QueuedUndelegation) String() string { return proto.CompactTextString(m) }
 func (*QueuedUndelegation) ProtoMessage()    {}
 func (*QueuedUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5234f40c0f8f1070, []int{4}
+	return fileDescriptor_5234f40c0f8f1070, []int{7}
 }
 func (m *QueuedUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,13 +414,21 @@ type PetrichorValidatorInfo struct {
 	GlobalRewardHistory  []RewardHistory `protobuf:"bytes,1,rep,name=global_reward_history,json=globalRewardHistory,proto3" json:"global_reward_history"`
 	TotalDelegatorShares []types.DecCoin `protobuf:"bytes,2,rep,name=total_delegator_shares,json=totalDelegatorShares,proto3" json:"total_delegator_shares"`
 	ValidatorShares      []types.DecCoin `protobuf:"bytes,3,rep,name=validator_shares,json=validatorShares,proto3" json:"validator_shares"`
+	// Petrichor assets the validator accepts delegations in, set by the validator operator
+	DenomFilterMode DenomFilterMode `protobuf:"varint,4,opt,name=denom_filter_mode,json=denomFilterMode,proto3,enum=petrichor.petrichor.DenomFilterMode" json:"denom_filter_mode,omitempty"`
+	FilteredDenoms  []string        `protobuf:"bytes,5,rep,name=filtered_denoms,json=filteredDenoms,proto3" json:"filtered_denoms,omitempty"`
+	OptOutPolicy    OptOutPolicy    `protobuf:"varint,6,opt,name=opt_out_policy,json=optOutPolicy,proto3,enum=petrichor.petrichor.OptOutPolicy" json:"opt_out_policy,omitempty"`
+	// Optional commission the validator takes on petrichor rewards. It follows the same rules as x/staking commissions
+	PetrichorCommission *types1.Commission `protobuf:"bytes,7,opt,name=petrichor_commission,json=petrichorCommission,proto3" json:"petrichor_commission,omitempty"`
+	// Commission taken on petrichor rewards that the operator has not withdrawn yet
+	AccumulatedCommission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=accumulated_commission,json=accumulatedCommission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accumulated_commission"`
 }
 
 func (m *PetrichorValidatorInfo) Reset()         { *m = PetrichorValidatorInfo{} }
 func (m *PetrichorValidatorInfo) String() string { return proto.CompactTextString(m) }
 func (*PetrichorValidatorInfo) ProtoMessage()    {}
 func (*PetrichorValidatorInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5234f40c0f8f1070, []int{5}
+	return fileDescriptor_5234f40c0f8f1070, []int{8}
 }
 func (m *PetrichorValidatorInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,59 +457,297 @@ func (m *PetrichorValidatorInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PetrichorValidatorInfo proto.InternalMessageInfo
 
+// LiquidReceipt links a transferable receipt denom to the delegation held by the
+// liquid staking pool for an asset and validator pair
+type LiquidReceipt struct {
+	Denom            string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	AssetDenom       string `protobuf:"bytes,3,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// reward_history tracks the accumulated rewards per unit of receipt
+	RewardHistory []RewardHistory `protobuf:"bytes,4,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history"`
+}
+
+func (m *LiquidReceipt) Reset()         { *m = LiquidReceipt{} }
+func (m *LiquidReceipt) String() string { return proto.CompactTextString(m) }
+func (*LiquidReceipt) ProtoMessage()    {}
+func (*LiquidReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{9}
+}
+func (m *LiquidReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidReceipt.Merge(m, src)
+}
+func (m *LiquidReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidReceipt proto.InternalMessageInfo
+
+// LiquidReceiptHolder is the reward checkpoint of a receipt holder
+type LiquidReceiptHolder struct {
+	HolderAddress string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	ReceiptDenom  string `protobuf:"bytes,2,opt,name=receipt_denom,json=receiptDenom,proto3" json:"receipt_denom,omitempty"`
+	// balance of the holder when the checkpoint was taken
+	Balance       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=balance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"balance"`
+	RewardHistory []RewardHistory                        `protobuf:"bytes,4,rep,name=reward_history,json=rewardHistory,proto3" json:"reward_history"`
+}
+
+func (m *LiquidReceiptHolder) Reset()         { *m = LiquidReceiptHolder{} }
+func (m *LiquidReceiptHolder) String() string { return proto.CompactTextString(m) }
+func (*LiquidReceiptHolder) ProtoMessage()    {}
+func (*LiquidReceiptHolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{10}
+}
+func (m *LiquidReceiptHolder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidReceiptHolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidReceiptHolder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidReceiptHolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidReceiptHolder.Merge(m, src)
+}
+func (m *LiquidReceiptHolder) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidReceiptHolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidReceiptHolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidReceiptHolder proto.InternalMessageInfo
+
+// SlashRecord is a slash of the petrichor positions of a validator. Slashes of the same validator at the same height
+// are combined into a single record
+// key: validator|height value: SlashRecord
+type SlashRecord struct {
+	ValidatorAddress string    `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           uint64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time             time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// Fraction of the validator's petrichor positions that was slashed
+	Fraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=fraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fraction"`
+	// Tokens slashed from the delegations to the validator for each petrichor asset
+	SlashedTokens github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=slashed_tokens,json=slashedTokens,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"slashed_tokens"`
+	// Immature redelegations away from the validator that were slashed. The balance is the slashed amount
+	Redelegations []Redelegation `protobuf:"bytes,6,rep,name=redelegations,proto3" json:"redelegations"`
+	// Immature undelegations from the validator that were slashed. The balance is the slashed amount
+	Undelegations []Undelegation `protobuf:"bytes,7,rep,name=undelegations,proto3" json:"undelegations"`
+}
+
+func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
+func (m *SlashRecord) String() string { return proto.CompactTextString(m) }
+func (*SlashRecord) ProtoMessage()    {}
+func (*SlashRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{11}
+}
+func (m *SlashRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlashRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlashRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlashRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlashRecord.Merge(m, src)
+}
+func (m *SlashRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlashRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlashRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlashRecord proto.InternalMessageInfo
+
+// InsuranceClaim is a payout of the insurance fund covering a slashed petrichor undelegation. Claims of a delegator
+// for the same validator and asset at the same height are combined into a single claim
+// key: delegator|height|validator|denom value: InsuranceClaim
+type InsuranceClaim struct {
+	DelegatorAddress string    `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string    `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Height           uint64    `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time             time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// Tokens slashed from the undelegation
+	Slashed types.Coin `protobuf:"bytes,5,opt,name=slashed,proto3" json:"slashed"`
+	// Tokens paid back to the undelegation by the insurance fund
+	Covered types.Coin `protobuf:"bytes,6,opt,name=covered,proto3" json:"covered"`
+}
+
+func (m *InsuranceClaim) Reset()         { *m = InsuranceClaim{} }
+func (m *InsuranceClaim) String() string { return proto.CompactTextString(m) }
+func (*InsuranceClaim) ProtoMessage()    {}
+func (*InsuranceClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5234f40c0f8f1070, []int{12}
+}
+func (m *InsuranceClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceClaim.Merge(m, src)
+}
+func (m *InsuranceClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceClaim proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("petrichor.petrichor.DenomFilterMode", DenomFilterMode_name, DenomFilterMode_value)
+	proto.RegisterEnum("petrichor.petrichor.OptOutPolicy", OptOutPolicy_name, OptOutPolicy_value)
 	proto.RegisterType((*Delegation)(nil), "petrichor.petrichor.Delegation")
+	proto.RegisterType((*AutoCompoundDelegation)(nil), "petrichor.petrichor.AutoCompoundDelegation")
+	proto.RegisterType((*DelegatorWithdrawAddress)(nil), "petrichor.petrichor.DelegatorWithdrawAddress")
+	proto.RegisterType((*DelegatorFallbackValidator)(nil), "petrichor.petrichor.DelegatorFallbackValidator")
 	proto.RegisterType((*Redelegation)(nil), "petrichor.petrichor.Redelegation")
 	proto.RegisterType((*QueuedRedelegation)(nil), "petrichor.petrichor.QueuedRedelegation")
 	proto.RegisterType((*Undelegation)(nil), "petrichor.petrichor.Undelegation")
 	proto.RegisterType((*QueuedUndelegation)(nil), "petrichor.petrichor.QueuedUndelegation")
 	proto.RegisterType((*PetrichorValidatorInfo)(nil), "petrichor.petrichor.PetrichorValidatorInfo")
+	proto.RegisterType((*LiquidReceipt)(nil), "petrichor.petrichor.LiquidReceipt")
+	proto.RegisterType((*LiquidReceiptHolder)(nil), "petrichor.petrichor.LiquidReceiptHolder")
+	proto.RegisterType((*SlashRecord)(nil), "petrichor.petrichor.SlashRecord")
+	proto.RegisterType((*InsuranceClaim)(nil), "petrichor.petrichor.InsuranceClaim")
 }
 
 func init() { proto.RegisterFile("petrichor/delegations.proto", fileDescriptor_5234f40c0f8f1070) }
 
 var fileDescriptor_5234f40c0f8f1070 = []byte{
-	// 633 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0xcb, 0x6e, 0xd3, 0x4e,
-	0x14, 0xc6, 0xed, 0xb8, 0x97, 0xff, 0x7f, 0x5a, 0x6e, 0x6e, 0x52, 0xb9, 0x05, 0x39, 0x25, 0x0b,
-	0x94, 0x4d, 0x6c, 0xb5, 0x5d, 0x20, 0x2e, 0x1b, 0xd2, 0x20, 0x15, 0xa9, 0x15, 0xe0, 0x02, 0x42,
-	0x80, 0x64, 0x8d, 0x3d, 0x83, 0x6d, 0xd5, 0xf1, 0x44, 0x33, 0x93, 0x96, 0xbe, 0x01, 0x4b, 0xd6,
-	0xac, 0xba, 0x65, 0x5f, 0xf1, 0x0c, 0x5d, 0x56, 0x5d, 0x21, 0x16, 0x15, 0x24, 0x1b, 0x1e, 0x03,
-	0xd9, 0x1e, 0x5f, 0x52, 0x82, 0xda, 0x0a, 0x16, 0xac, 0x62, 0xcf, 0x77, 0xce, 0x2f, 0xe3, 0xef,
-	0x9b, 0x39, 0xe0, 0x7a, 0x0f, 0x73, 0x1a, 0xb8, 0x3e, 0xa1, 0x26, 0xc2, 0x21, 0xf6, 0x20, 0x0f,
-	0x48, 0xc4, 0x8c, 0x1e, 0x25, 0x9c, 0xa8, 0x73, 0xb9, 0x68, 0xe4, 0x4f, 0x8b, 0x55, 0x8f, 0x78,
-	0x24, 0xd1, 0xcd, 0xf8, 0x29, 0x2d, 0x5d, 0xd4, 0x5d, 0xc2, 0xba, 0x84, 0x99, 0x0e, 0x64, 0xd8,
-	0xdc, 0x59, 0x76, 0x30, 0x87, 0xcb, 0xa6, 0x4b, 0x82, 0x48, 0xe8, 0x0b, 0xa9, 0x6e, 0xa7, 0x8d,
-	0xe9, 0x8b, 0x90, 0xe6, 0x8b, 0x2d, 0xf4, 0x20, 0x85, 0x5d, 0xb1, 0xde, 0xf8, 0xa8, 0x00, 0xd0,
-	0xc9, 0xf7, 0xa4, 0x3e, 0x04, 0xd7, 0xc4, 0x0e, 0x09, 0xb5, 0x21, 0x42, 0x14, 0x33, 0xa6, 0xc9,
-	0x4b, 0x72, 0xf3, 0xff, 0xb6, 0x76, 0x7c, 0xd0, 0xaa, 0x0a, 0xe6, 0x83, 0x54, 0xd9, 0xe2, 0x34,
-	0x88, 0x3c, 0xeb, 0x6a, 0xde, 0x22, 0xd6, 0x63, 0xcc, 0x0e, 0x0c, 0x03, 0x34, 0x82, 0xa9, 0x9c,
-	0x85, 0xc9, 0x5b, 0x32, 0x4c, 0x15, 0x4c, 0x22, 0x1c, 0x91, 0xae, 0xa6, 0xc4, 0xad, 0x56, 0xfa,
-	0xa2, 0x3e, 0x03, 0x53, 0xcc, 0x87, 0x14, 0x33, 0x6d, 0x22, 0x21, 0xde, 0x3f, 0x3c, 0xa9, 0x4b,
-	0x5f, 0x4f, 0xea, 0xb7, 0xbc, 0x80, 0xfb, 0x7d, 0xc7, 0x70, 0x49, 0x57, 0x7c, 0xbb, 0xf8, 0x69,
-	0x31, 0xb4, 0x6d, 0xf2, 0xbd, 0x1e, 0x66, 0x46, 0x07, 0xbb, 0xc7, 0x07, 0x2d, 0x20, 0xfe, 0xbf,
-	0x83, 0x5d, 0x4b, 0xb0, 0xd4, 0xc7, 0xe0, 0x32, 0xc5, 0xbb, 0x90, 0x22, 0xdb, 0x0f, 0x18, 0x27,
-	0x74, 0x4f, 0x9b, 0x5c, 0x52, 0x9a, 0x33, 0x2b, 0x0d, 0x63, 0x4c, 0x3e, 0x86, 0x95, 0x94, 0xae,
-	0xa7, 0x95, 0xed, 0x89, 0x78, 0x07, 0xd6, 0x25, 0x5a, 0x5e, 0x54, 0x6f, 0x03, 0x2d, 0x84, 0x8c,
-	0xdb, 0x82, 0xea, 0x86, 0x30, 0xe8, 0xda, 0x3e, 0x0e, 0x3c, 0x9f, 0x6b, 0x53, 0x4b, 0x72, 0x73,
-	0xc2, 0xaa, 0xc5, 0x7a, 0x4a, 0x5a, 0x8b, 0xd5, 0xf5, 0x44, 0xbc, 0xfb, 0xdf, 0xfb, 0xfd, 0xba,
-	0xf4, 0x63, 0xbf, 0x2e, 0x35, 0x3e, 0x57, 0xc0, 0xac, 0x85, 0xd1, 0x5f, 0x8f, 0x67, 0x03, 0xd4,
-	0x18, 0x75, 0xed, 0x8b, 0x47, 0x34, 0xc7, 0xa8, 0xfb, 0xe2, 0x74, 0x4a, 0x1b, 0xa0, 0x86, 0x18,
-	0x1f, 0x43, 0x53, 0xce, 0xa2, 0x21, 0xc6, 0x7f, 0xa1, 0xdd, 0x01, 0xd3, 0x0e, 0x0c, 0x61, 0xe4,
-	0xe2, 0x24, 0xde, 0x99, 0x95, 0x05, 0x43, 0x34, 0xc7, 0xa7, 0xde, 0x10, 0xa7, 0xde, 0x58, 0x23,
-	0x41, 0x24, 0x7c, 0xcf, 0xea, 0x4b, 0xc6, 0xbd, 0x06, 0xea, 0xd3, 0x3e, 0xee, 0x63, 0x34, 0xe2,
-	0xde, 0x3d, 0x30, 0x8d, 0x23, 0x4e, 0x03, 0x1c, 0x7b, 0x16, 0x67, 0x7b, 0xf3, 0x37, 0xd9, 0x16,
-	0x3d, 0x56, 0xd6, 0x51, 0x82, 0x7f, 0x97, 0xc1, 0xec, 0xf3, 0x08, 0xfd, 0xab, 0x97, 0xa6, 0x64,
-	0xa0, 0xf2, 0xe7, 0x06, 0x8e, 0x7c, 0xe8, 0x39, 0x0d, 0x2c, 0xf7, 0x8c, 0x33, 0xf0, 0x53, 0x05,
-	0xcc, 0x3f, 0xc9, 0xaa, 0xf3, 0x03, 0xf0, 0x28, 0x7a, 0x4b, 0xd4, 0x37, 0xa0, 0xe6, 0x85, 0xc4,
-	0x81, 0xa1, 0x7d, 0xea, 0x32, 0xca, 0x17, 0xbc, 0x8c, 0x73, 0x29, 0x66, 0x44, 0x52, 0x5f, 0x82,
-	0x79, 0x4e, 0x38, 0x0c, 0xed, 0x22, 0x2e, 0x31, 0x49, 0x2a, 0x09, 0xfe, 0xc6, 0x58, 0xa7, 0x3a,
-	0xd8, 0x2d, 0x99, 0x55, 0x4d, 0x08, 0x9d, 0x0c, 0xb0, 0x95, 0x4e, 0x8f, 0x4d, 0x50, 0x04, 0x91,
-	0x31, 0x95, 0x73, 0x33, 0xaf, 0xe4, 0xbd, 0x29, 0xae, 0xf0, 0xaa, 0xbd, 0x79, 0x38, 0xd0, 0xe5,
-	0xa3, 0x81, 0x2e, 0x7f, 0x1b, 0xe8, 0xf2, 0x87, 0xa1, 0x2e, 0x1d, 0x0d, 0x75, 0xe9, 0xcb, 0x50,
-	0x97, 0x5e, 0xad, 0x96, 0xc6, 0x5d, 0xe2, 0x45, 0x84, 0xf9, 0x2e, 0xa1, 0xdb, 0x66, 0x31, 0xe9,
-	0xdf, 0x95, 0x9e, 0x93, 0xf9, 0xe7, 0x4c, 0x25, 0x53, 0x7f, 0xf5, 0x67, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x82, 0x6d, 0x43, 0x36, 0x92, 0x06, 0x00, 0x00,
+	// 1392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xda, 0xce, 0x8f, 0x4e, 0x12, 0x27, 0xdf, 0x4d, 0xe2, 0x6e, 0xdd, 0xca, 0xf6, 0x37,
+	0x54, 0x10, 0x15, 0xd5, 0xa6, 0xcd, 0x01, 0x4a, 0x41, 0xc8, 0x89, 0x9d, 0xc4, 0xc8, 0xb1, 0xc3,
+	0xc6, 0x69, 0x29, 0x20, 0xad, 0xc6, 0xbb, 0x13, 0x7b, 0x95, 0xf5, 0x8e, 0xd9, 0x19, 0x37, 0xcd,
+	0x95, 0x53, 0x15, 0x71, 0xe8, 0x81, 0x13, 0x52, 0xa4, 0x4a, 0xdc, 0x38, 0x20, 0x0e, 0x15, 0x7f,
+	0x02, 0x2a, 0xb7, 0xaa, 0x27, 0xc4, 0xa1, 0x85, 0xf6, 0xd2, 0x23, 0x7f, 0x02, 0xda, 0xd9, 0xd9,
+	0xdd, 0xb1, 0xeb, 0xb6, 0xb6, 0x5a, 0xa4, 0x70, 0xf2, 0xce, 0xbc, 0xf7, 0xf9, 0xcc, 0x7b, 0x6f,
+	0xde, 0xbc, 0x79, 0x63, 0x70, 0xb6, 0x83, 0xa8, 0x63, 0xea, 0x2d, 0xec, 0xe4, 0x0d, 0x64, 0xa1,
+	0x26, 0xa4, 0x26, 0xb6, 0x49, 0xae, 0xe3, 0x60, 0x8a, 0xe5, 0xf9, 0x40, 0x98, 0x0b, 0xbe, 0x52,
+	0x0b, 0x4d, 0xdc, 0xc4, 0x4c, 0x9e, 0x77, 0xbf, 0x3c, 0xd5, 0x54, 0x5a, 0xc7, 0xa4, 0x8d, 0x49,
+	0xbe, 0x01, 0x09, 0xca, 0xdf, 0xbc, 0xd4, 0x40, 0x14, 0x5e, 0xca, 0xeb, 0xd8, 0xb4, 0xb9, 0xfc,
+	0x8c, 0x27, 0xd7, 0x3c, 0xa0, 0x37, 0xe0, 0xa2, 0x64, 0x68, 0x42, 0x07, 0x3a, 0xb0, 0xed, 0xcf,
+	0x9f, 0xe7, 0x94, 0x84, 0xc2, 0x7d, 0xd3, 0x6e, 0x06, 0xac, 0x7c, 0xcc, 0xb5, 0x32, 0x4d, 0x8c,
+	0x9b, 0x16, 0xca, 0xb3, 0x51, 0xa3, 0xbb, 0x97, 0xa7, 0x66, 0x1b, 0x11, 0x0a, 0xdb, 0x1d, 0x4f,
+	0x61, 0xe9, 0xfb, 0x18, 0x00, 0xc5, 0xc0, 0x35, 0xb9, 0x04, 0xfe, 0xc7, 0x1d, 0xc5, 0x8e, 0x06,
+	0x0d, 0xc3, 0x41, 0x84, 0x28, 0x52, 0x56, 0x5a, 0x3e, 0xb5, 0xaa, 0x3c, 0xbc, 0x77, 0x71, 0x81,
+	0x9b, 0x56, 0xf0, 0x24, 0x3b, 0xd4, 0x31, 0xed, 0xa6, 0x3a, 0x17, 0x40, 0xf8, 0xbc, 0x4b, 0x73,
+	0x13, 0x5a, 0xa6, 0xd1, 0x43, 0x13, 0x7d, 0x15, 0x4d, 0x00, 0xf1, 0x69, 0x16, 0xc0, 0x98, 0x81,
+	0x6c, 0xdc, 0x56, 0x62, 0x2e, 0x54, 0xf5, 0x06, 0x72, 0x1d, 0x8c, 0x93, 0x16, 0x74, 0x10, 0x51,
+	0xe2, 0x8c, 0xf1, 0xa3, 0xfb, 0x8f, 0x32, 0x91, 0x3f, 0x1e, 0x65, 0xde, 0x6e, 0x9a, 0xb4, 0xd5,
+	0x6d, 0xe4, 0x74, 0xdc, 0xe6, 0x21, 0xe4, 0x3f, 0x17, 0x89, 0xb1, 0x9f, 0xa7, 0x87, 0x1d, 0x44,
+	0x72, 0x45, 0xa4, 0x3f, 0xbc, 0x77, 0x11, 0xf0, 0xf5, 0x8b, 0x48, 0x57, 0x39, 0x97, 0x5c, 0x03,
+	0x09, 0x07, 0x1d, 0x40, 0xc7, 0xd0, 0x5a, 0x26, 0xa1, 0xd8, 0x39, 0x54, 0xc6, 0xb2, 0xb1, 0xe5,
+	0xa9, 0xcb, 0x4b, 0xb9, 0x01, 0xdb, 0x9c, 0x53, 0x99, 0xea, 0xa6, 0xa7, 0xb9, 0x1a, 0x77, 0x2d,
+	0x50, 0x67, 0x1c, 0x71, 0x52, 0x7e, 0x1f, 0x28, 0x16, 0x24, 0x54, 0xe3, 0xac, 0xba, 0x05, 0xcd,
+	0xb6, 0xd6, 0x42, 0x66, 0xb3, 0x45, 0x95, 0xf1, 0xac, 0xb4, 0x1c, 0x57, 0x17, 0x5d, 0xb9, 0xc7,
+	0xb4, 0xe6, 0x4a, 0x37, 0x99, 0xf0, 0xc3, 0xc9, 0xdb, 0x77, 0x33, 0x91, 0x67, 0x77, 0x33, 0x91,
+	0xa5, 0x5f, 0x25, 0x90, 0x2c, 0x74, 0x29, 0x5e, 0xc3, 0xed, 0x0e, 0xee, 0xda, 0xc6, 0x7f, 0x6b,
+	0xa3, 0x04, 0x47, 0x7e, 0x96, 0x80, 0x52, 0xf4, 0xd7, 0xbe, 0x6e, 0xd2, 0x96, 0xe1, 0xc0, 0x03,
+	0xc1, 0x86, 0x37, 0xe1, 0xca, 0x1a, 0x98, 0x3b, 0xe0, 0xcc, 0x43, 0x7b, 0x32, 0x7b, 0xd0, 0x6b,
+	0x8b, 0x60, 0xf2, 0x3d, 0x09, 0xa4, 0x02, 0x93, 0xd7, 0xa1, 0x65, 0x35, 0xa0, 0xbe, 0x7f, 0xcd,
+	0x77, 0xfc, 0x64, 0xc5, 0x5f, 0x30, 0xfb, 0x97, 0x28, 0x98, 0x56, 0x91, 0xf1, 0xc6, 0x13, 0xa5,
+	0x02, 0x16, 0x89, 0xa3, 0x6b, 0xa3, 0x1b, 0x3b, 0x4f, 0x1c, 0xfd, 0x5a, 0x7f, 0xbe, 0x54, 0xc0,
+	0xa2, 0x41, 0xe8, 0x00, 0xb6, 0xd8, 0xab, 0xd8, 0x0c, 0x42, 0x9f, 0x63, 0xbb, 0x02, 0x26, 0x1a,
+	0xd0, 0x82, 0xb6, 0x8e, 0x58, 0x45, 0x98, 0xba, 0x7c, 0x26, 0xc7, 0xc1, 0x6e, 0xbd, 0xcd, 0xf1,
+	0xca, 0x98, 0x5b, 0xc3, 0xa6, 0xcd, 0x8f, 0xaa, 0xaf, 0x2f, 0x04, 0xee, 0x4b, 0x20, 0x7f, 0xd6,
+	0x45, 0x5d, 0x64, 0xf4, 0x44, 0xef, 0x2a, 0x98, 0x40, 0x36, 0x75, 0x4c, 0xe4, 0xc6, 0xcc, 0x2d,
+	0x07, 0xff, 0x7f, 0x41, 0x39, 0x08, 0x31, 0xaa, 0x8f, 0x10, 0xc8, 0xff, 0x92, 0xc0, 0xf4, 0xae,
+	0x6d, 0x9c, 0xd4, 0xe3, 0x2b, 0x04, 0x30, 0xf6, 0xfa, 0x01, 0xdc, 0xb5, 0x47, 0x0f, 0xe0, 0xae,
+	0xfd, 0xf2, 0x00, 0xfe, 0x36, 0x06, 0x92, 0xdb, 0xbe, 0x76, 0x90, 0x00, 0x65, 0x7b, 0x0f, 0xcb,
+	0x5f, 0x81, 0xc5, 0xa6, 0x85, 0x1b, 0xd0, 0xd2, 0xfa, 0xea, 0xb7, 0x34, 0x62, 0xfd, 0x9e, 0xf7,
+	0x68, 0x7a, 0x44, 0xf2, 0xe7, 0x20, 0x49, 0x31, 0x85, 0x96, 0x16, 0x6e, 0x17, 0xbf, 0x7c, 0xa2,
+	0x8c, 0xfe, 0xdc, 0xc0, 0x48, 0x15, 0x91, 0x2e, 0x04, 0x6b, 0x81, 0x31, 0x04, 0xc5, 0x64, 0xc7,
+	0xbb, 0x70, 0xb6, 0x40, 0xb8, 0x11, 0x3e, 0x67, 0x6c, 0x68, 0xce, 0xd9, 0x00, 0xcb, 0xe9, 0xb6,
+	0xdd, 0x8c, 0xb2, 0x71, 0x5b, 0xdb, 0x33, 0x2d, 0x8a, 0x1c, 0xad, 0x8d, 0x0d, 0xef, 0x38, 0x24,
+	0x2e, 0x9f, 0x1f, 0x18, 0x82, 0xa2, 0xab, 0xbd, 0xce, 0x94, 0xb7, 0xb0, 0x81, 0xd4, 0x59, 0xa3,
+	0x77, 0x42, 0x7e, 0x07, 0xcc, 0x7a, 0x5c, 0xc8, 0xd0, 0x98, 0x8c, 0xb0, 0x2b, 0xf1, 0x94, 0x9a,
+	0xf0, 0xa7, 0x19, 0x05, 0x91, 0x37, 0x40, 0x02, 0x77, 0xa8, 0x86, 0xbb, 0x54, 0xeb, 0x60, 0xcb,
+	0xd4, 0x0f, 0xd9, 0xfd, 0x96, 0x78, 0xc1, 0x56, 0xd7, 0x3a, 0xb4, 0xd6, 0xa5, 0xdb, 0x4c, 0x51,
+	0x9d, 0xc6, 0xc2, 0x48, 0xde, 0x05, 0x0b, 0x81, 0x9e, 0xa6, 0xe3, 0x76, 0xdb, 0x24, 0xc4, 0xc4,
+	0xb6, 0x32, 0xc1, 0x92, 0x72, 0xc9, 0x0f, 0x8b, 0xdf, 0xe2, 0x84, 0x79, 0xe9, 0x6b, 0xaa, 0x61,
+	0x4f, 0x16, 0x4e, 0xca, 0xdf, 0x48, 0x20, 0x09, 0x75, 0xbd, 0xdb, 0xee, 0x5a, 0x90, 0x22, 0x43,
+	0x64, 0x9e, 0xcc, 0xc6, 0x5e, 0x9e, 0xee, 0xef, 0xb9, 0xd1, 0xfe, 0xf1, 0x71, 0x66, 0x79, 0x88,
+	0xe6, 0xc2, 0x05, 0x10, 0x75, 0x51, 0x58, 0x2a, 0x34, 0x42, 0xc8, 0xe5, 0x67, 0x12, 0x98, 0xa9,
+	0x98, 0x5f, 0x77, 0x4d, 0x43, 0x45, 0x3a, 0x32, 0x3b, 0x34, 0xbc, 0x3e, 0x25, 0xb1, 0xcf, 0x79,
+	0x43, 0x87, 0x3b, 0x03, 0xa6, 0x20, 0x21, 0x88, 0x6a, 0xe2, 0x0d, 0x0d, 0xd8, 0x14, 0xdb, 0xbf,
+	0x01, 0x9d, 0x4f, 0xfc, 0xb5, 0x3a, 0x1f, 0xc1, 0xd5, 0x9f, 0xa2, 0x60, 0xbe, 0xc7, 0xd5, 0x4d,
+	0x6c, 0x19, 0xc8, 0x91, 0x3f, 0x01, 0x89, 0x16, 0xfb, 0x1a, 0xba, 0xf6, 0xcd, 0x78, 0xfa, 0xbe,
+	0x53, 0x6f, 0x81, 0x19, 0xc7, 0x63, 0xe4, 0x6e, 0xb1, 0xb8, 0xa8, 0xd3, 0x7c, 0xd2, 0x73, 0xec,
+	0x5a, 0x6f, 0x59, 0x1b, 0xad, 0x53, 0x2c, 0xdb, 0x54, 0xe8, 0x14, 0xcb, 0x36, 0x0d, 0x6a, 0xde,
+	0xbf, 0x19, 0xb0, 0xef, 0xe2, 0x60, 0x6a, 0xc7, 0x82, 0xa4, 0xa5, 0x22, 0x1d, 0x3b, 0xc6, 0xe0,
+	0x1c, 0x90, 0x46, 0xce, 0x81, 0x24, 0x18, 0xe7, 0x9d, 0x67, 0x94, 0x75, 0x9e, 0x7c, 0x24, 0x7f,
+	0x00, 0xe2, 0xee, 0x83, 0x80, 0x57, 0xfd, 0x54, 0xce, 0x7b, 0x2d, 0xe4, 0xfc, 0xd7, 0x42, 0xae,
+	0xee, 0xbf, 0x16, 0x56, 0x27, 0x5d, 0xbb, 0xef, 0x3c, 0xce, 0x48, 0x2a, 0x43, 0xc8, 0x9f, 0x82,
+	0xc9, 0x3d, 0x07, 0xea, 0x6e, 0xbd, 0xe6, 0x6d, 0x78, 0x6e, 0xb4, 0x36, 0x5c, 0x0d, 0xf0, 0xf2,
+	0x2d, 0x90, 0x20, 0xae, 0xcf, 0xc8, 0xd0, 0x28, 0xde, 0x47, 0x36, 0x51, 0xc6, 0x86, 0xa8, 0x83,
+	0x2b, 0xfc, 0x64, 0xbe, 0x3b, 0xdc, 0x7a, 0xde, 0xe1, 0x9c, 0xe1, 0x0b, 0xd5, 0xd9, 0x3a, 0xf2,
+	0x96, 0x9b, 0x46, 0xc2, 0xcb, 0x4e, 0x19, 0x1f, 0xf2, 0x92, 0x0f, 0xf7, 0x51, 0x40, 0xbb, 0x74,
+	0x5d, 0x5b, 0xa4, 0x9b, 0x18, 0xf2, 0xca, 0xf3, 0xe9, 0x7a, 0xd0, 0x42, 0x5a, 0xfc, 0x1d, 0x05,
+	0x89, 0xb2, 0x4d, 0xba, 0x8e, 0x9b, 0x7f, 0xec, 0xad, 0x70, 0xc2, 0x3a, 0x88, 0x30, 0xc1, 0x62,
+	0x03, 0x13, 0x2c, 0x3e, 0x72, 0x82, 0x5d, 0x01, 0x13, 0x7c, 0xaf, 0x94, 0xb1, 0x21, 0x7b, 0x12,
+	0xae, 0xef, 0x42, 0x75, 0x7c, 0x13, 0x39, 0xc8, 0x50, 0xc6, 0x87, 0x84, 0x72, 0xfd, 0x30, 0xe4,
+	0x17, 0xee, 0x4b, 0x60, 0xb6, 0xef, 0x8a, 0x94, 0x57, 0x40, 0xb2, 0x58, 0xaa, 0xd6, 0xb6, 0xb4,
+	0xf5, 0x72, 0xa5, 0x5e, 0x52, 0xb5, 0xad, 0x5a, 0xb1, 0xa4, 0x55, 0x6b, 0xd5, 0xd2, 0x5c, 0x24,
+	0x75, 0xfa, 0xe8, 0x38, 0x3b, 0xdf, 0x07, 0xa8, 0x62, 0x1b, 0xc9, 0x1f, 0x83, 0xb3, 0xcf, 0x83,
+	0x0a, 0x95, 0x4a, 0xed, 0x7a, 0xa5, 0xbc, 0x53, 0x9f, 0x93, 0x52, 0xe7, 0x8e, 0x8e, 0xb3, 0x4a,
+	0x1f, 0xb2, 0x60, 0x59, 0xf8, 0xc0, 0x32, 0x09, 0x95, 0xaf, 0x82, 0xd4, 0xf3, 0xf0, 0x62, 0xa9,
+	0x7a, 0x83, 0xa1, 0xa3, 0xa9, 0xb3, 0x47, 0xc7, 0xd9, 0xd3, 0x7d, 0xe8, 0x22, 0xb2, 0x0f, 0x5d,
+	0x70, 0x2a, 0x7e, 0xfb, 0x87, 0x74, 0xe4, 0xc2, 0xb7, 0x12, 0x98, 0x16, 0x6f, 0x5d, 0x97, 0xb3,
+	0xb6, 0x5d, 0xd7, 0x6a, 0xbb, 0x75, 0x6d, 0xbb, 0x56, 0x29, 0xaf, 0xdd, 0xd0, 0x36, 0xd4, 0x42,
+	0xb5, 0xb8, 0x5e, 0xa8, 0x6f, 0x96, 0xd4, 0xb9, 0x88, 0xc7, 0x29, 0x22, 0x36, 0x1c, 0x68, 0x1b,
+	0x7b, 0x90, 0xb6, 0x90, 0x23, 0x5f, 0x01, 0x67, 0xfa, 0xc0, 0xbb, 0xd5, 0x62, 0xa9, 0x52, 0xda,
+	0x28, 0xd4, 0x4b, 0x73, 0x52, 0x2a, 0x75, 0x74, 0x9c, 0x4d, 0x8a, 0xd8, 0x20, 0xcf, 0x91, 0x67,
+	0xce, 0xea, 0xd6, 0xfd, 0x27, 0x69, 0xe9, 0xc1, 0x93, 0xb4, 0xf4, 0xe7, 0x93, 0xb4, 0x74, 0xe7,
+	0x69, 0x3a, 0xf2, 0xe0, 0x69, 0x3a, 0xf2, 0xfb, 0xd3, 0x74, 0xe4, 0x8b, 0x15, 0xe1, 0x28, 0xb3,
+	0x83, 0x62, 0x23, 0x7a, 0x80, 0x9d, 0xfd, 0x7c, 0xf8, 0x1f, 0xc8, 0x2d, 0xe1, 0x9b, 0x9d, 0xed,
+	0xc6, 0x38, 0x4b, 0xa6, 0x95, 0x7f, 0x06, 0x00, 0xf9, 0x16, 0x8e, 0x9d, 0xac, 0x11, 0x00, 0x00,
 }
 
 func (m *Delegation) Marshal() (dAtA []byte, err error) {
@@ -392,7 +823,7 @@ func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+func (m *AutoCompoundDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -402,37 +833,27 @@ func (m *Redelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *AutoCompoundDelegation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AutoCompoundDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintDelegations(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.DstValidatorAddress) > 0 {
-		i -= len(m.DstValidatorAddress)
-		copy(dAtA[i:], m.DstValidatorAddress)
-		i = encodeVarintDelegations(dAtA, i, uint64(len(m.DstValidatorAddress)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.SrcValidatorAddress) > 0 {
-		i -= len(m.SrcValidatorAddress)
-		copy(dAtA[i:], m.SrcValidatorAddress)
-		i = encodeVarintDelegations(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -446,7 +867,7 @@ func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueuedRedelegation) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorWithdrawAddress) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -456,34 +877,34 @@ func (m *QueuedRedelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueuedRedelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueuedRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintDelegations(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Undelegation) Marshal() (dAtA []byte, err error) {
+func (m *DelegatorFallbackValidator) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -493,26 +914,16 @@ func (m *Undelegation) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Undelegation) MarshalTo(dAtA []byte) (int, error) {
+func (m *DelegatorFallbackValidator) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Undelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DelegatorFallbackValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintDelegations(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
 	if len(m.ValidatorAddress) > 0 {
 		i -= len(m.ValidatorAddress)
 		copy(dAtA[i:], m.ValidatorAddress)
@@ -530,7 +941,145 @@ func (m *Undelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueuedUndelegation) Marshal() (dAtA []byte, err error) {
+func (m *Redelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Redelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Redelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.DstValidatorAddress) > 0 {
+		i -= len(m.DstValidatorAddress)
+		copy(dAtA[i:], m.DstValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.DstValidatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SrcValidatorAddress) > 0 {
+		i -= len(m.SrcValidatorAddress)
+		copy(dAtA[i:], m.SrcValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.SrcValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Undelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Undelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Undelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedUndelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -587,6 +1136,51 @@ func (m *PetrichorValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.AccumulatedCommission) > 0 {
+		for iNdEx := len(m.AccumulatedCommission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatedCommission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.PetrichorCommission != nil {
+		{
+			size, err := m.PetrichorCommission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDelegations(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OptOutPolicy != 0 {
+		i = encodeVarintDelegations(dAtA, i, uint64(m.OptOutPolicy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.FilteredDenoms) > 0 {
+		for iNdEx := len(m.FilteredDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FilteredDenoms[iNdEx])
+			copy(dAtA[i:], m.FilteredDenoms[iNdEx])
+			i = encodeVarintDelegations(dAtA, i, uint64(len(m.FilteredDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.DenomFilterMode != 0 {
+		i = encodeVarintDelegations(dAtA, i, uint64(m.DenomFilterMode))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ValidatorShares) > 0 {
 		for iNdEx := len(m.ValidatorShares) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -632,88 +1226,372 @@ func (m *PetrichorValidatorInfo) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintDelegations(dAtA []byte, offset int, v uint64) int {
-	offset -= sovDelegations(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *LiquidReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Delegation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *LiquidReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDelegations(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDelegations(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovDelegations(uint64(l))
-	}
-	l = m.Shares.Size()
-	n += 1 + l + sovDelegations(uint64(l))
 	if len(m.RewardHistory) > 0 {
-		for _, e := range m.RewardHistory {
-			l = e.Size()
-			n += 1 + l + sovDelegations(uint64(l))
+		for iNdEx := len(m.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	if m.LastRewardClaimHeight != 0 {
-		n += 1 + sovDelegations(uint64(m.LastRewardClaimHeight))
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Redelegation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidReceiptHolder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidReceiptHolder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidReceiptHolder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovDelegations(uint64(l))
-	}
-	l = len(m.SrcValidatorAddress)
+	if len(m.RewardHistory) > 0 {
+		for iNdEx := len(m.RewardHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ReceiptDenom) > 0 {
+		i -= len(m.ReceiptDenom)
+		copy(dAtA[i:], m.ReceiptDenom)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ReceiptDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HolderAddress) > 0 {
+		i -= len(m.HolderAddress)
+		copy(dAtA[i:], m.HolderAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.HolderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Undelegations) > 0 {
+		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Undelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SlashedTokens) > 0 {
+		for iNdEx := len(m.SlashedTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashedTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegations(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintDelegations(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintDelegations(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Covered.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegations(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintDelegations(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintDelegations(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintDelegations(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegations(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegations(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
 	if l > 0 {
 		n += 1 + l + sovDelegations(uint64(l))
 	}
-	l = len(m.DstValidatorAddress)
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovDelegations(uint64(l))
 	}
-	l = m.Balance.Size()
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = m.Shares.Size()
 	n += 1 + l + sovDelegations(uint64(l))
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if m.LastRewardClaimHeight != 0 {
+		n += 1 + sovDelegations(uint64(m.LastRewardClaimHeight))
+	}
 	return n
 }
 
-func (m *QueuedRedelegation) Size() (n int) {
+func (m *AutoCompoundDelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for _, e := range m.Entries {
-			l = e.Size()
-			n += 1 + l + sovDelegations(uint64(l))
-		}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
 	}
 	return n
 }
 
-func (m *Undelegation) Size() (n int) {
+func (m *DelegatorWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegatorFallbackValidator) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -727,12 +1605,33 @@ func (m *Undelegation) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDelegations(uint64(l))
 	}
+	return n
+}
+
+func (m *Redelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.SrcValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.DstValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
 	l = m.Balance.Size()
 	n += 1 + l + sovDelegations(uint64(l))
 	return n
 }
 
-func (m *QueuedUndelegation) Size() (n int) {
+func (m *QueuedRedelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -747,18 +1646,52 @@ func (m *QueuedUndelegation) Size() (n int) {
 	return n
 }
 
-func (m *PetrichorValidatorInfo) Size() (n int) {
+func (m *Undelegation) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.GlobalRewardHistory) > 0 {
-		for _, e := range m.GlobalRewardHistory {
-			l = e.Size()
-			n += 1 + l + sovDelegations(uint64(l))
-		}
-	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovDelegations(uint64(l))
+	return n
+}
+
+func (m *QueuedUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PetrichorValidatorInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.GlobalRewardHistory) > 0 {
+		for _, e := range m.GlobalRewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
 	if len(m.TotalDelegatorShares) > 0 {
 		for _, e := range m.TotalDelegatorShares {
 			l = e.Size()
@@ -771,16 +1704,1255 @@ func (m *PetrichorValidatorInfo) Size() (n int) {
 			n += 1 + l + sovDelegations(uint64(l))
 		}
 	}
-	return n
-}
+	if m.DenomFilterMode != 0 {
+		n += 1 + sovDelegations(uint64(m.DenomFilterMode))
+	}
+	if len(m.FilteredDenoms) > 0 {
+		for _, s := range m.FilteredDenoms {
+			l = len(s)
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if m.OptOutPolicy != 0 {
+		n += 1 + sovDelegations(uint64(m.OptOutPolicy))
+	}
+	if m.PetrichorCommission != nil {
+		l = m.PetrichorCommission.Size()
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if len(m.AccumulatedCommission) > 0 {
+		for _, e := range m.AccumulatedCommission {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LiquidReceiptHolder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HolderAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.ReceiptDenom)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovDelegations(uint64(l))
+	if len(m.RewardHistory) > 0 {
+		for _, e := range m.RewardHistory {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDelegations(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDelegations(uint64(l))
+	l = m.Fraction.Size()
+	n += 1 + l + sovDelegations(uint64(l))
+	if len(m.SlashedTokens) > 0 {
+		for _, e := range m.SlashedTokens {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	if len(m.Undelegations) > 0 {
+		for _, e := range m.Undelegations {
+			l = e.Size()
+			n += 1 + l + sovDelegations(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InsuranceClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovDelegations(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovDelegations(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovDelegations(uint64(l))
+	l = m.Slashed.Size()
+	n += 1 + l + sovDelegations(uint64(l))
+	l = m.Covered.Size()
+	n += 1 + l + sovDelegations(uint64(l))
+	return n
+}
+
+func sovDelegations(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegations(x uint64) (n int) {
+	return sovDelegations(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardClaimHeight", wireType)
+			}
+			m.LastRewardClaimHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRewardClaimHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoCompoundDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoCompoundDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorFallbackValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorFallbackValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorFallbackValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Redelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedRedelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &Redelegation{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Undelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Undelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Undelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegations
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &Undelegation{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegations(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovDelegations(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDelegations(x uint64) (n int) {
-	return sovDelegations(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Delegation) Unmarshal(dAtA []byte) error {
+func (m *PetrichorValidatorInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -803,17 +2975,17 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: PetrichorValidatorInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PetrichorValidatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRewardHistory", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -823,29 +2995,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.GlobalRewardHistory = append(m.GlobalRewardHistory, RewardHistory{})
+			if err := m.GlobalRewardHistory[len(m.GlobalRewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatorShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -855,29 +3029,31 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.TotalDelegatorShares = append(m.TotalDelegatorShares, types.DecCoin{})
+			if err := m.TotalDelegatorShares[len(m.TotalDelegatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -887,27 +3063,48 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthDelegations
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthDelegations
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
+			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomFilterMode", wireType)
+			}
+			m.DenomFilterMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomFilterMode |= DenomFilterMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FilteredDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -935,13 +3132,30 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FilteredDenoms = append(m.FilteredDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptOutPolicy", wireType)
+			}
+			m.OptOutPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OptOutPolicy |= OptOutPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PetrichorCommission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -968,16 +3182,18 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
-			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PetrichorCommission == nil {
+				m.PetrichorCommission = &types1.Commission{}
+			}
+			if err := m.PetrichorCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardClaimHeight", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedCommission", wireType)
 			}
-			m.LastRewardClaimHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDelegations
@@ -987,11 +3203,26 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastRewardClaimHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedCommission = append(m.AccumulatedCommission, types.Coin{})
+			if err := m.AccumulatedCommission[len(m.AccumulatedCommission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDelegations(dAtA[iNdEx:])
@@ -1013,7 +3244,7 @@ func (m *Delegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Redelegation) Unmarshal(dAtA []byte) error {
+func (m *LiquidReceipt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1036,15 +3267,15 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Redelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidReceipt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Redelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1072,11 +3303,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SrcValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1104,11 +3335,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SrcValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DstValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1136,11 +3367,11 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DstValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1167,7 +3398,8 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1192,7 +3424,7 @@ func (m *Redelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
+func (m *LiquidReceiptHolder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1205,25 +3437,123 @@ func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidReceiptHolder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidReceiptHolder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedRedelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1250,8 +3580,8 @@ func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &Redelegation{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistory = append(m.RewardHistory, RewardHistory{})
+			if err := m.RewardHistory[len(m.RewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1276,7 +3606,7 @@ func (m *QueuedRedelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Undelegation) Unmarshal(dAtA []byte) error {
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1299,15 +3629,15 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Undelegation: wiretype end group for non-group")
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Undelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1335,11 +3665,63 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1367,11 +3749,13 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedTokens", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1398,63 +3782,48 @@ func (m *Undelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashedTokens = append(m.SlashedTokens, types.DecCoin{})
+			if err := m.SlashedTokens[len(m.SlashedTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDelegations(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDelegations
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthDelegations
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowDelegations
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Redelegations = append(m.Redelegations, Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedUndelegation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1481,8 +3850,8 @@ func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Entries = append(m.Entries, &Undelegation{})
-			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Undelegations = append(m.Undelegations, Undelegation{})
+			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1507,7 +3876,7 @@ func (m *QueuedUndelegation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PetrichorValidatorInfo) Unmarshal(dAtA []byte) error {
+func (m *InsuranceClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1530,15 +3899,98 @@ func (m *PetrichorValidatorInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PetrichorValidatorInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: InsuranceClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PetrichorValidatorInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InsuranceClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalRewardHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegations
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegations
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1565,14 +4017,13 @@ func (m *PetrichorValidatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalRewardHistory = append(m.GlobalRewardHistory, RewardHistory{})
-			if err := m.GlobalRewardHistory[len(m.GlobalRewardHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1599,14 +4050,13 @@ func (m *PetrichorValidatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalDelegatorShares = append(m.TotalDelegatorShares, types.DecCoin{})
-			if err := m.TotalDelegatorShares[len(m.TotalDelegatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Covered", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1633,8 +4083,7 @@ func (m *PetrichorValidatorInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
-			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Covered.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
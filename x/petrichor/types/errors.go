@@ -5,10 +5,27 @@ import sdkerrors "cosmossdk.io/errors"
 var (
 	ErrInvalidGenesisState = sdkerrors.Register(ModuleName, 0, "invalid genesis state")
 
-	ErrEmptyValidatorAddr = sdkerrors.Register(ModuleName, 10, "empty validator address")
-	ErrValidatorNotFound  = sdkerrors.Register(ModuleName, 11, "validator not found")
+	ErrEmptyValidatorAddr    = sdkerrors.Register(ModuleName, 10, "empty validator address")
+	ErrValidatorNotFound     = sdkerrors.Register(ModuleName, 11, "validator not found")
+	ErrDenomNotAccepted      = sdkerrors.Register(ModuleName, 12, "validator does not accept delegations in the petrichor asset")
+	ErrNoValidatorCommission = sdkerrors.Register(ModuleName, 13, "no petrichor commission to withdraw")
 
-	ErrZeroDelegations = sdkerrors.Register(ModuleName, 20, "there are no delegations yet")
+	ErrZeroDelegations        = sdkerrors.Register(ModuleName, 20, "there are no delegations yet")
+	ErrWithdrawAddressBlocked = sdkerrors.Register(ModuleName, 21, "withdraw address is not allowed to receive rewards")
+	ErrUnknownUndelegation    = sdkerrors.Register(ModuleName, 22, "no immature undelegation found")
 
-	ErrUnknownAsset = sdkerrors.Register(ModuleName, 30, "petrichor asset is not whitelisted")
+	ErrUnknownAsset         = sdkerrors.Register(ModuleName, 30, "petrichor asset is not whitelisted")
+	ErrAssetAlreadyExists   = sdkerrors.Register(ModuleName, 31, "petrichor asset is already whitelisted")
+	ErrAssetHasDelegations  = sdkerrors.Register(ModuleName, 32, "petrichor asset still has delegations")
+	ErrMaxTotalRewardWeight = sdkerrors.Register(ModuleName, 33, "total reward weight of petrichor assets is too high")
+	ErrAssetDelisting       = sdkerrors.Register(ModuleName, 34, "petrichor asset is being delisted")
+	ErrMaxTotalTokens       = sdkerrors.Register(ModuleName, 35, "delegation exceeds the total tokens cap of the petrichor asset")
+	ErrMaxValidatorShare    = sdkerrors.Register(ModuleName, 36, "delegation exceeds the validator share cap of the petrichor asset")
+	ErrBelowMinDelegation   = sdkerrors.Register(ModuleName, 37, "delegation is below the minimum amount of the petrichor asset")
+	ErrNoPriceSource        = sdkerrors.Register(ModuleName, 38, "no price source is configured for oracle priced reward weights")
+
+	ErrUnknownLiquidReceipt = sdkerrors.Register(ModuleName, 40, "liquid staking receipt does not exist")
+	ErrInsufficientReceipt  = sdkerrors.Register(ModuleName, 41, "receipt amount is too small to redeem")
+
+	ErrInvalidParams = sdkerrors.Register(ModuleName, 50, "invalid petrichor params")
 )
@@ -5,10 +5,60 @@ const (
 	EventTypeUndelegate             = "undelegate"
 	EventTypeRedelegate             = "redelegate"
 	EventTypeClaimDelegationRewards = "claim_delegation_rewards"
+	EventTypeLiquidDelegate         = "liquid_delegate"
+	EventTypeRedeemLiquidReceipt    = "redeem_liquid_receipt"
+	EventTypeClaimReceiptRewards    = "claim_liquid_receipt_rewards"
+	EventTypeSetAutoCompound        = "set_auto_compound"
+	EventTypeAutoCompound           = "auto_compound"
+	EventTypeAutoCompoundFailed     = "auto_compound_failed"
+	EventTypeSetWithdrawAddress     = "set_reward_withdraw_address"
+	EventTypeCancelUndelegation     = "cancel_undelegation"
+	EventTypeUpdateParams           = "update_params"
+	EventTypeCreatePetrichor        = "create_petrichor"
+	EventTypeUpdatePetrichor        = "update_petrichor"
+	EventTypeDeletePetrichor        = "delete_petrichor"
+	EventTypeDelistPetrichor        = "delist_petrichor"
+	EventTypeForceUndelegate        = "force_undelegate"
+	EventTypeSweepDustDelegations   = "sweep_dust_delegations"
+	EventTypeRewardWeightChange     = "reward_weight_change"
+	EventTypeTakeRateDistribution   = "take_rate_distribution"
+	EventTypeTakeRateDeduction      = "take_rate_deduction"
+	EventTypeSetDenomFilter         = "set_validator_denom_filter"
+	EventTypeSetCommission          = "set_petrichor_commission"
+	EventTypeWithdrawCommission     = "withdraw_petrichor_commission"
+	EventTypeSlashValidator         = "slash_petrichor_validator"
+	EventTypeSlashRedelegation      = "slash_petrichor_redelegation"
+	EventTypeSlashUndelegation      = "slash_petrichor_undelegation"
+	EventTypeInsuranceClaim         = "petrichor_insurance_claim"
+	EventTypeSetFallbackValidator   = "set_fallback_validator"
+	EventTypeAutoRedelegate         = "auto_redelegate"
+	EventTypeArchiveValidator       = "archive_petrichor_validator"
+	EventTypeOptOutUndelegateFailed = "opt_out_undelegate_failed"
+	EventTypeAutoRedelegateFailed   = "auto_redelegate_failed"
+	EventTypeDelistUndelegateFailed = "delist_undelegate_failed"
 
 	AttributeKeyValidator      = "validator"
 	AttributeKeySrcValidator   = "source_validator"
 	AttributeKeyDstValidator   = "destination_validator"
 	AttributeKeyCompletionTime = "completion_time"
 	AttributeKeyNewShares      = "new_shares"
+	AttributeKeyReceipt        = "receipt"
+	AttributeKeyDelegator      = "delegator"
+	AttributeKeyEnabled        = "enabled"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyWithdrawAddr   = "withdraw_address"
+	AttributeKeyAuthority      = "authority"
+	AttributeKeyRewardDelay    = "reward_delay_time"
+	AttributeKeyClaimInterval  = "take_rate_claim_interval"
+	AttributeKeyCompoundPeriod = "auto_compound_interval"
+	AttributeKeySwept          = "swept"
+	AttributeKeyPrice          = "price"
+	AttributeKeyRewardWeight   = "reward_weight"
+	AttributeKeyDestination    = "destination"
+	AttributeKeyTotalAmount    = "total_amount"
+	AttributeKeyFilterMode     = "filter_mode"
+	AttributeKeyOptOutPolicy   = "opt_out_policy"
+	AttributeKeyCommissionRate = "commission_rate"
+	AttributeKeyFraction       = "fraction"
+	AttributeKeyError          = "error"
 )
@@ -260,6 +260,16 @@ type GenesisState struct {
 	Delegations                []Delegation                      `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations"`
 	Redelegations              []RedelegationState               `protobuf:"bytes,6,rep,name=redelegations,proto3" json:"redelegations"`
 	Undelegations              []UndelegationState               `protobuf:"bytes,7,rep,name=undelegations,proto3" json:"undelegations"`
+	LiquidReceipts             []LiquidReceipt                   `protobuf:"bytes,8,rep,name=liquid_receipts,json=liquidReceipts,proto3" json:"liquid_receipts"`
+	LiquidReceiptHolders       []LiquidReceiptHolder             `protobuf:"bytes,9,rep,name=liquid_receipt_holders,json=liquidReceiptHolders,proto3" json:"liquid_receipt_holders"`
+	AutoCompoundDelegations    []AutoCompoundDelegation          `protobuf:"bytes,10,rep,name=auto_compound_delegations,json=autoCompoundDelegations,proto3" json:"auto_compound_delegations"`
+	WithdrawAddresses          []DelegatorWithdrawAddress        `protobuf:"bytes,11,rep,name=withdraw_addresses,json=withdrawAddresses,proto3" json:"withdraw_addresses"`
+	TakeRateAccruals           []TakeRateAccrual                 `protobuf:"bytes,12,rep,name=take_rate_accruals,json=takeRateAccruals,proto3" json:"take_rate_accruals"`
+	TakeRateHistory            []TakeRateRecord                  `protobuf:"bytes,13,rep,name=take_rate_history,json=takeRateHistory,proto3" json:"take_rate_history"`
+	SlashRecords               []SlashRecord                     `protobuf:"bytes,14,rep,name=slash_records,json=slashRecords,proto3" json:"slash_records"`
+	InsuranceClaims            []InsuranceClaim                  `protobuf:"bytes,15,rep,name=insurance_claims,json=insuranceClaims,proto3" json:"insurance_claims"`
+	FallbackValidators         []DelegatorFallbackValidator      `protobuf:"bytes,16,rep,name=fallback_validators,json=fallbackValidators,proto3" json:"fallback_validators"`
+	ArchivedValidatorInfos     []ValidatorInfoState              `protobuf:"bytes,17,rep,name=archived_validator_infos,json=archivedValidatorInfos,proto3" json:"archived_validator_infos"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -344,6 +354,76 @@ func (m *GenesisState) GetUndelegations() []UndelegationState {
 	return nil
 }
 
+func (m *GenesisState) GetLiquidReceipts() []LiquidReceipt {
+	if m != nil {
+		return m.LiquidReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidReceiptHolders() []LiquidReceiptHolder {
+	if m != nil {
+		return m.LiquidReceiptHolders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoCompoundDelegations() []AutoCompoundDelegation {
+	if m != nil {
+		return m.AutoCompoundDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetWithdrawAddresses() []DelegatorWithdrawAddress {
+	if m != nil {
+		return m.WithdrawAddresses
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTakeRateAccruals() []TakeRateAccrual {
+	if m != nil {
+		return m.TakeRateAccruals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTakeRateHistory() []TakeRateRecord {
+	if m != nil {
+		return m.TakeRateHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlashRecords() []SlashRecord {
+	if m != nil {
+		return m.SlashRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceClaims() []InsuranceClaim {
+	if m != nil {
+		return m.InsuranceClaims
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFallbackValidators() []DelegatorFallbackValidator {
+	if m != nil {
+		return m.FallbackValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetArchivedValidatorInfos() []ValidatorInfoState {
+	if m != nil {
+		return m.ArchivedValidatorInfos
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ValidatorInfoState)(nil), "petrichor.petrichor.ValidatorInfoState")
 	proto.RegisterType((*RedelegationState)(nil), "petrichor.petrichor.RedelegationState")
@@ -355,47 +435,65 @@ func init() {
 func init() { proto.RegisterFile("petrichor/genesis.proto", fileDescriptor_2375ef509b6cf31e) }
 
 var fileDescriptor_2375ef509b6cf31e = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0xd6, 0xae, 0xac, 0x6e, 0xd9, 0xa8, 0x99, 0x46, 0xe8, 0x20, 0x2d, 0x45, 0x82, 0x4a,
-	0x93, 0x12, 0xa9, 0xe3, 0xc2, 0xb1, 0x05, 0x69, 0x42, 0x68, 0x82, 0x66, 0x30, 0x24, 0x2e, 0x95,
-	0xdb, 0xb8, 0x49, 0x44, 0x13, 0x47, 0xb1, 0xbb, 0xc2, 0x81, 0xff, 0x30, 0xf1, 0x47, 0x38, 0x71,
-	0xe7, 0xb8, 0xe3, 0x0e, 0x1c, 0x38, 0x01, 0x6a, 0xff, 0x08, 0x8a, 0x9d, 0x34, 0xee, 0x9a, 0x0d,
-	0x71, 0xd8, 0xcd, 0x7e, 0xdf, 0x7b, 0xdf, 0xfb, 0xde, 0xf3, 0x7b, 0x06, 0x77, 0x02, 0xcc, 0x42,
-	0x77, 0xe8, 0x90, 0xd0, 0xb0, 0xb1, 0x8f, 0xa9, 0x4b, 0xf5, 0x20, 0x24, 0x8c, 0xc0, 0xdb, 0x0b,
-	0x40, 0x5f, 0x9c, 0x6a, 0xdb, 0x36, 0xb1, 0x09, 0xc7, 0x8d, 0xe8, 0x24, 0x5c, 0x6b, 0x77, 0x53,
-	0x0e, 0x29, 0x88, 0x43, 0x3b, 0x12, 0x84, 0x42, 0xe4, 0xc5, 0xec, 0xb5, 0xdd, 0xd4, 0x6e, 0xe1,
-	0x31, 0xb6, 0x11, 0x73, 0x89, 0x9f, 0x80, 0x75, 0x9b, 0x10, 0x7b, 0x8c, 0x0d, 0x7e, 0x1b, 0x4c,
-	0x46, 0x06, 0x73, 0x3d, 0x4c, 0x19, 0xf2, 0x02, 0xe1, 0xd0, 0xfc, 0xa2, 0x00, 0x78, 0x8c, 0xc6,
-	0xae, 0x85, 0x18, 0x09, 0x5f, 0xf8, 0x23, 0x72, 0xc4, 0x10, 0xc3, 0x70, 0x0f, 0x54, 0x4f, 0x12,
-	0x6b, 0x1f, 0x59, 0x56, 0x88, 0x29, 0x55, 0x95, 0x86, 0xd2, 0x2a, 0x99, 0xb7, 0x16, 0x40, 0x47,
-	0xd8, 0xe1, 0x2b, 0x50, 0x5a, 0xd8, 0xd4, 0xb5, 0x86, 0xd2, 0x2a, 0xb7, 0xf7, 0xf4, 0x8c, 0x9a,
-	0xf5, 0xd7, 0xc9, 0x69, 0x29, 0x63, 0xb7, 0x70, 0xf6, 0xab, 0x9e, 0x33, 0x53, 0x8e, 0xe6, 0x57,
-	0x05, 0x54, 0x4d, 0x9c, 0x56, 0x23, 0x34, 0x1d, 0x82, 0xad, 0x21, 0xf1, 0x82, 0x31, 0x8e, 0x4c,
-	0xfd, 0xa8, 0x10, 0xae, 0xa8, 0xdc, 0xae, 0xe9, 0xa2, 0x4a, 0x3d, 0xa9, 0x52, 0x7f, 0x93, 0x54,
-	0xd9, 0xdd, 0x88, 0xb8, 0x4f, 0x7f, 0xd7, 0x15, 0x73, 0x33, 0x0d, 0x8e, 0x60, 0xf8, 0x12, 0x54,
-	0x42, 0x29, 0x47, 0x2c, 0xfc, 0x41, 0xa6, 0x70, 0x59, 0x4c, 0x2c, 0x77, 0x29, 0xb8, 0xf9, 0x4d,
-	0x01, 0xd5, 0xb7, 0xfe, 0x35, 0x2b, 0xee, 0x81, 0xca, 0xc4, 0x5f, 0x51, 0xfc, 0x38, 0x53, 0x71,
-	0x6f, 0x82, 0x27, 0xd8, 0x92, 0x25, 0x25, 0xba, 0x65, 0x8a, 0xe6, 0x77, 0x05, 0xd4, 0x4d, 0x3c,
-	0x45, 0xa1, 0xf5, 0x0e, 0xbb, 0xb6, 0xc3, 0x9e, 0x39, 0xc8, 0xb7, 0xf1, 0x91, 0x8f, 0x02, 0xea,
-	0x10, 0x26, 0xaa, 0xd8, 0x01, 0x45, 0x87, 0x83, 0x5c, 0x7c, 0xc1, 0x8c, 0x6f, 0xf0, 0xde, 0xc5,
-	0x67, 0x2f, 0x49, 0x6f, 0x08, 0xb7, 0xc1, 0xba, 0x85, 0x7d, 0xe2, 0xa9, 0x79, 0x8e, 0x88, 0x0b,
-	0xec, 0x81, 0x0d, 0x1a, 0x93, 0xab, 0x05, 0x2e, 0xdf, 0xb8, 0xa4, 0xe1, 0x97, 0x69, 0x8a, 0xcb,
-	0x58, 0xd0, 0x34, 0x7f, 0x14, 0x40, 0xe5, 0x40, 0xec, 0x9b, 0xd0, 0xfb, 0x14, 0x14, 0xc5, 0x82,
-	0xc4, 0xcd, 0xde, 0xcd, 0x9e, 0x45, 0xee, 0x12, 0xb3, 0xc5, 0x01, 0xb0, 0x03, 0x8a, 0x88, 0x52,
-	0xcc, 0xa8, 0xba, 0xd6, 0xc8, 0xb7, 0xca, 0xed, 0x87, 0x57, 0x8f, 0x71, 0x27, 0xf2, 0x4d, 0x28,
-	0x44, 0x20, 0x3c, 0x06, 0x5b, 0xe9, 0xe6, 0xb8, 0xfe, 0x88, 0x50, 0x35, 0xcf, 0xb9, 0xb2, 0xdf,
-	0x69, 0x75, 0xf7, 0x62, 0xbe, 0xcd, 0x13, 0x19, 0xa1, 0xf0, 0x33, 0xb8, 0x1f, 0xf2, 0xa6, 0xf4,
-	0xa7, 0xbc, 0x2b, 0xfd, 0x21, 0x6f, 0x4b, 0x3f, 0xea, 0x83, 0x43, 0x18, 0x55, 0x0b, 0x3c, 0xcb,
-	0x93, 0xff, 0x6c, 0xa7, 0x9c, 0xb2, 0x16, 0x66, 0xba, 0x45, 0xec, 0xf0, 0x00, 0x94, 0xa5, 0xdf,
-	0x45, 0x5d, 0xe7, 0xc9, 0xea, 0x99, 0xc9, 0x9e, 0x5f, 0x1c, 0x39, 0x39, 0x12, 0x9a, 0xe0, 0xa6,
-	0xbc, 0x39, 0x54, 0x2d, 0x72, 0xaa, 0x47, 0xff, 0xdc, 0x3b, 0x59, 0xe9, 0x32, 0x45, 0xc4, 0x29,
-	0x4f, 0x35, 0x55, 0x6f, 0x5c, 0xc1, 0xb9, 0xb2, 0xa6, 0x09, 0xe7, 0x12, 0x45, 0xf7, 0xf0, 0x6c,
-	0xa6, 0x29, 0xe7, 0x33, 0x4d, 0xf9, 0x33, 0xd3, 0x94, 0xd3, 0xb9, 0x96, 0x3b, 0x9f, 0x6b, 0xb9,
-	0x9f, 0x73, 0x2d, 0xf7, 0x7e, 0xdf, 0x76, 0x99, 0x33, 0x19, 0xe8, 0x43, 0xe2, 0x89, 0x4f, 0xda,
-	0xc7, 0x6c, 0x4a, 0xc2, 0x0f, 0xe9, 0x8f, 0x6d, 0x7c, 0x94, 0xce, 0xec, 0x53, 0x80, 0xe9, 0xa0,
-	0xc8, 0x37, 0x7d, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x31, 0x62, 0x83, 0x55, 0x25, 0x06,
-	0x00, 0x00,
+	// 927 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x73, 0xdb, 0x44,
+	0x14, 0xc7, 0xa3, 0x3a, 0x35, 0xc9, 0xda, 0x89, 0xe3, 0x6d, 0x26, 0xdd, 0xba, 0x60, 0x1b, 0xc3,
+	0x40, 0x66, 0x3a, 0xd8, 0x33, 0x29, 0x17, 0x8e, 0x4e, 0x18, 0xda, 0x4e, 0xe9, 0x40, 0x94, 0xa4,
+	0x65, 0xb8, 0x68, 0xd6, 0xd2, 0x5a, 0xd2, 0x44, 0xd6, 0x9a, 0x7d, 0xab, 0x98, 0x1e, 0x38, 0x72,
+	0xef, 0xf0, 0x8f, 0x70, 0xe2, 0xce, 0xb1, 0xc7, 0x1e, 0x39, 0x01, 0x93, 0xfc, 0x23, 0x8c, 0x56,
+	0x2b, 0x4b, 0xb2, 0x65, 0x67, 0x60, 0x86, 0x9b, 0xf4, 0x7e, 0x7c, 0xde, 0x77, 0xdf, 0xee, 0x3e,
+	0x09, 0xdd, 0x9f, 0x32, 0x29, 0x7c, 0xdb, 0xe3, 0x62, 0xe0, 0xb2, 0x90, 0x81, 0x0f, 0xfd, 0xa9,
+	0xe0, 0x92, 0xe3, 0x7b, 0x73, 0x47, 0x7f, 0xfe, 0xd4, 0xda, 0x77, 0xb9, 0xcb, 0x95, 0x7f, 0x10,
+	0x3f, 0x25, 0xa1, 0xad, 0x07, 0x19, 0x23, 0x97, 0xa4, 0x5c, 0x07, 0x39, 0x17, 0x15, 0x74, 0xa2,
+	0xe9, 0xad, 0x87, 0x99, 0xdd, 0x61, 0x01, 0x73, 0xa9, 0xf4, 0x79, 0x98, 0x3a, 0x3b, 0x2e, 0xe7,
+	0x6e, 0xc0, 0x06, 0xea, 0x6d, 0x14, 0x8d, 0x07, 0xd2, 0x9f, 0x30, 0x90, 0x74, 0x32, 0x4d, 0x02,
+	0x7a, 0xbf, 0x18, 0x08, 0xbf, 0xa4, 0x81, 0xef, 0x50, 0xc9, 0xc5, 0xb3, 0x70, 0xcc, 0xcf, 0x24,
+	0x95, 0x0c, 0x3f, 0x42, 0xcd, 0xab, 0xd4, 0x6a, 0x51, 0xc7, 0x11, 0x0c, 0x80, 0x18, 0x5d, 0xe3,
+	0x70, 0xdb, 0xdc, 0x9b, 0x3b, 0x86, 0x89, 0x1d, 0x7f, 0x83, 0xb6, 0xe7, 0x36, 0x72, 0xa7, 0x6b,
+	0x1c, 0xd6, 0x8e, 0x1e, 0xf5, 0x4b, 0xd6, 0xdc, 0xff, 0x36, 0x7d, 0x2a, 0x54, 0x3c, 0xde, 0x7c,
+	0xfb, 0x67, 0x67, 0xc3, 0xcc, 0x18, 0xbd, 0x5f, 0x0d, 0xd4, 0x34, 0x59, 0xb6, 0x9a, 0x44, 0xd3,
+	0x0b, 0xd4, 0xb0, 0xf9, 0x64, 0x1a, 0xb0, 0xd8, 0x64, 0xc5, 0x0b, 0x51, 0x8a, 0x6a, 0x47, 0xad,
+	0x7e, 0xb2, 0xca, 0x7e, 0xba, 0xca, 0xfe, 0x79, 0xba, 0xca, 0xe3, 0xad, 0x98, 0xfd, 0xe6, 0xaf,
+	0x8e, 0x61, 0xee, 0x66, 0xc9, 0xb1, 0x1b, 0x3f, 0x47, 0x75, 0x91, 0xab, 0xa1, 0x85, 0x7f, 0x58,
+	0x2a, 0x3c, 0x2f, 0x46, 0xcb, 0x2d, 0x24, 0xf7, 0x7e, 0x33, 0x50, 0xf3, 0x22, 0xfc, 0x9f, 0x15,
+	0x9f, 0xa2, 0x7a, 0x14, 0x2e, 0x29, 0xfe, 0xb4, 0x54, 0xf1, 0x69, 0xc4, 0x22, 0xe6, 0x5c, 0x84,
+	0xcb, 0xba, 0xf3, 0x88, 0xde, 0xef, 0x06, 0xea, 0x98, 0x6c, 0x46, 0x85, 0xf3, 0x8a, 0xf9, 0xae,
+	0x27, 0x4f, 0x3c, 0x1a, 0xba, 0xec, 0x2c, 0xa4, 0x53, 0xf0, 0xb8, 0x4c, 0x56, 0x71, 0x80, 0xaa,
+	0x9e, 0x72, 0x2a, 0xf1, 0x9b, 0xa6, 0x7e, 0xc3, 0xef, 0x2f, 0x6e, 0xfb, 0x76, 0x6e, 0x0f, 0xf1,
+	0x3e, 0xba, 0xeb, 0xb0, 0x90, 0x4f, 0x48, 0x45, 0x79, 0x92, 0x17, 0x7c, 0x8a, 0xb6, 0x40, 0xc3,
+	0xc9, 0xa6, 0x92, 0x3f, 0x58, 0xd1, 0xf0, 0x55, 0x9a, 0xf4, 0x32, 0xe6, 0x98, 0xde, 0xcf, 0x75,
+	0x54, 0x7f, 0x92, 0xdc, 0xb7, 0x44, 0xef, 0x17, 0xa8, 0x9a, 0x5c, 0x10, 0xdd, 0xec, 0x87, 0xe5,
+	0x67, 0x51, 0x85, 0x68, 0x9a, 0x4e, 0xc0, 0x43, 0x54, 0xa5, 0x00, 0x4c, 0x02, 0xb9, 0xd3, 0xad,
+	0x1c, 0xd6, 0x8e, 0x3e, 0x5a, 0x7f, 0x8c, 0x87, 0x71, 0x6c, 0x8a, 0x48, 0x12, 0xf1, 0x4b, 0xd4,
+	0xc8, 0x6e, 0x8e, 0x1f, 0x8e, 0x39, 0x90, 0x4a, 0xb7, 0xb2, 0x72, 0x9f, 0x96, 0xef, 0x9e, 0xe6,
+	0xed, 0x5e, 0xe5, 0x3d, 0x80, 0x7f, 0x42, 0x1f, 0x08, 0xd5, 0x14, 0x6b, 0xa6, 0xba, 0x62, 0xd9,
+	0xaa, 0x2d, 0x56, 0xdc, 0x07, 0x8f, 0x4b, 0x20, 0x9b, 0xaa, 0xca, 0xe7, 0xff, 0xb2, 0x9d, 0xf9,
+	0x92, 0x2d, 0x51, 0x1a, 0x16, 0xd3, 0xf1, 0x13, 0x54, 0xcb, 0x4d, 0x17, 0x72, 0x57, 0x15, 0xeb,
+	0x94, 0x16, 0xfb, 0x72, 0xf1, 0xc8, 0xe5, 0x33, 0xb1, 0x89, 0x76, 0xf2, 0x37, 0x07, 0x48, 0x55,
+	0xa1, 0x3e, 0xb9, 0xf5, 0xde, 0xe5, 0x95, 0x16, 0x11, 0x31, 0x33, 0x7f, 0xaa, 0x81, 0xbc, 0xb7,
+	0x86, 0x79, 0x11, 0xae, 0x60, 0x16, 0x10, 0xf8, 0x14, 0x35, 0x02, 0xff, 0x87, 0xc8, 0x77, 0x2c,
+	0xc1, 0x6c, 0xe6, 0x4f, 0x25, 0x90, 0x2d, 0x45, 0xed, 0x95, 0x52, 0xbf, 0x56, 0xb1, 0x66, 0x12,
+	0x9a, 0x6e, 0x61, 0x90, 0x37, 0x02, 0x76, 0xd0, 0x41, 0x11, 0x69, 0x79, 0x3c, 0x70, 0x98, 0x00,
+	0xb2, 0xad, 0xc8, 0x87, 0xb7, 0x93, 0x9f, 0xaa, 0x04, 0xcd, 0xdf, 0x0f, 0x96, 0x5d, 0x80, 0x27,
+	0xe8, 0x01, 0x8d, 0x24, 0xb7, 0xe2, 0xe1, 0xc1, 0xa3, 0xd0, 0xb1, 0xf2, 0x8d, 0x41, 0xdd, 0xca,
+	0xca, 0xe9, 0x3c, 0x8c, 0x24, 0x3f, 0xd1, 0x49, 0x4b, 0x7b, 0x78, 0x9f, 0x96, 0x7a, 0x01, 0x8f,
+	0x10, 0x9e, 0xf9, 0xd2, 0x73, 0x04, 0x9d, 0xa5, 0x1f, 0x0a, 0x06, 0xa4, 0xa6, 0xea, 0x7c, 0xb6,
+	0xee, 0x7c, 0x70, 0xf1, 0x4a, 0xe7, 0xe9, 0xef, 0x88, 0xae, 0xd4, 0x9c, 0x15, 0xcd, 0x0c, 0xf0,
+	0x77, 0x08, 0x4b, 0x7a, 0xc9, 0x2c, 0x41, 0x25, 0xb3, 0xa8, 0x6d, 0x8b, 0x88, 0x06, 0x40, 0xea,
+	0xaa, 0xc6, 0xc7, 0xa5, 0x35, 0xce, 0xe9, 0x25, 0x33, 0xa9, 0x64, 0xc3, 0x24, 0x58, 0xa3, 0xf7,
+	0x64, 0xd1, 0x0c, 0xf8, 0x02, 0x35, 0x33, 0xb2, 0xe7, 0x83, 0xe4, 0xe2, 0x35, 0xd9, 0x59, 0x73,
+	0xf7, 0x53, 0xb0, 0xc9, 0x6c, 0x2e, 0x1c, 0xcd, 0x6d, 0xa4, 0xdc, 0xa7, 0x09, 0x01, 0x3f, 0x47,
+	0x3b, 0x10, 0x50, 0xf0, 0xe2, 0x8d, 0xe6, 0xc2, 0x01, 0xb2, 0xab, 0x90, 0xdd, 0x52, 0xe4, 0x59,
+	0x1c, 0x59, 0xe0, 0xd5, 0x21, 0x33, 0x01, 0x3e, 0x47, 0x7b, 0x7e, 0x08, 0x91, 0xa0, 0xa1, 0xcd,
+	0x2c, 0x3b, 0xa0, 0xfe, 0x04, 0x48, 0x63, 0x8d, 0xc4, 0x67, 0x69, 0xf0, 0x49, 0x1c, 0x9b, 0x4a,
+	0xf4, 0x0b, 0x56, 0xc0, 0x63, 0x74, 0x6f, 0x4c, 0x83, 0x60, 0x44, 0xed, 0x4b, 0x6b, 0x3e, 0x6a,
+	0x80, 0xec, 0x75, 0x2b, 0x2b, 0x87, 0xf2, 0x7c, 0xe3, 0xbe, 0xd2, 0x89, 0xf3, 0xe1, 0xa5, 0x8b,
+	0xe0, 0xf1, 0xa2, 0x03, 0xb0, 0x8b, 0x08, 0x15, 0xb6, 0xe7, 0x5f, 0x31, 0xc7, 0x5a, 0x1c, 0x8c,
+	0xcd, 0xff, 0x32, 0x18, 0x0f, 0x52, 0x5c, 0x21, 0x02, 0x8e, 0x5f, 0xbc, 0xbd, 0x6e, 0x1b, 0xef,
+	0xae, 0xdb, 0xc6, 0xdf, 0xd7, 0x6d, 0xe3, 0xcd, 0x4d, 0x7b, 0xe3, 0xdd, 0x4d, 0x7b, 0xe3, 0x8f,
+	0x9b, 0xf6, 0xc6, 0xf7, 0x8f, 0x5d, 0x5f, 0x7a, 0xd1, 0xa8, 0x6f, 0xf3, 0x49, 0xf2, 0x57, 0x15,
+	0x32, 0x39, 0xe3, 0xe2, 0x32, 0xfb, 0xc5, 0x1a, 0xfc, 0x98, 0x7b, 0x96, 0xaf, 0xa7, 0x0c, 0x46,
+	0x55, 0xf5, 0x69, 0x7e, 0xfc, 0xcf, 0x00, 0x4a, 0xaf, 0x40, 0x51, 0xd6, 0x09, 0x00, 0x00,
 }
 
 func (m *ValidatorInfoState) Marshal() (dAtA []byte, err error) {
@@ -592,6 +690,150 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ArchivedValidatorInfos) > 0 {
+		for iNdEx := len(m.ArchivedValidatorInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArchivedValidatorInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.FallbackValidators) > 0 {
+		for iNdEx := len(m.FallbackValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FallbackValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.InsuranceClaims) > 0 {
+		for iNdEx := len(m.InsuranceClaims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceClaims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for iNdEx := len(m.SlashRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TakeRateHistory) > 0 {
+		for iNdEx := len(m.TakeRateHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TakeRateAccruals) > 0 {
+		for iNdEx := len(m.TakeRateAccruals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateAccruals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for iNdEx := len(m.WithdrawAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for iNdEx := len(m.AutoCompoundDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoCompoundDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LiquidReceiptHolders) > 0 {
+		for iNdEx := len(m.LiquidReceiptHolders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidReceiptHolders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.LiquidReceipts) > 0 {
+		for iNdEx := len(m.LiquidReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Undelegations) > 0 {
 		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -807,6 +1049,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LiquidReceipts) > 0 {
+		for _, e := range m.LiquidReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidReceiptHolders) > 0 {
+		for _, e := range m.LiquidReceiptHolders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoCompoundDelegations) > 0 {
+		for _, e := range m.AutoCompoundDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.WithdrawAddresses) > 0 {
+		for _, e := range m.WithdrawAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakeRateAccruals) > 0 {
+		for _, e := range m.TakeRateAccruals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TakeRateHistory) > 0 {
+		for _, e := range m.TakeRateHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlashRecords) > 0 {
+		for _, e := range m.SlashRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceClaims) > 0 {
+		for _, e := range m.InsuranceClaims {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FallbackValidators) > 0 {
+		for _, e := range m.FallbackValidators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ArchivedValidatorInfos) > 0 {
+		for _, e := range m.ArchivedValidatorInfos {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1595,6 +1897,346 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidReceipts = append(m.LiquidReceipts, LiquidReceipt{})
+			if err := m.LiquidReceipts[len(m.LiquidReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidReceiptHolders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidReceiptHolders = append(m.LiquidReceiptHolders, LiquidReceiptHolder{})
+			if err := m.LiquidReceiptHolders[len(m.LiquidReceiptHolders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoCompoundDelegations = append(m.AutoCompoundDelegations, AutoCompoundDelegation{})
+			if err := m.AutoCompoundDelegations[len(m.AutoCompoundDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddresses = append(m.WithdrawAddresses, DelegatorWithdrawAddress{})
+			if err := m.WithdrawAddresses[len(m.WithdrawAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateAccruals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateAccruals = append(m.TakeRateAccruals, TakeRateAccrual{})
+			if err := m.TakeRateAccruals[len(m.TakeRateAccruals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateHistory = append(m.TakeRateHistory, TakeRateRecord{})
+			if err := m.TakeRateHistory[len(m.TakeRateHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashRecords = append(m.SlashRecords, SlashRecord{})
+			if err := m.SlashRecords[len(m.SlashRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceClaims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceClaims = append(m.InsuranceClaims, InsuranceClaim{})
+			if err := m.InsuranceClaims[len(m.InsuranceClaims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackValidators = append(m.FallbackValidators, DelegatorFallbackValidator{})
+			if err := m.FallbackValidators[len(m.FallbackValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchivedValidatorInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArchivedValidatorInfos = append(m.ArchivedValidatorInfos, ValidatorInfoState{})
+			if err := m.ArchivedValidatorInfos[len(m.ArchivedValidatorInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"google.golang.org/grpc/codes"
@@ -12,18 +13,21 @@ const (
 	ProposalTypeCreatePetrichor = "msg_create_petrichor_proposal"
 	ProposalTypeUpdatePetrichor = "msg_update_petrichor_proposal"
 	ProposalTypeDeletePetrichor = "msg_delete_petrichor_proposal"
+	ProposalTypeDelistPetrichor = "msg_delist_petrichor_proposal"
 )
 
 var (
 	_ govtypes.Content = &MsgCreatePetrichorProposal{}
 	_ govtypes.Content = &MsgUpdatePetrichorProposal{}
 	_ govtypes.Content = &MsgDeletePetrichorProposal{}
+	_ govtypes.Content = &MsgDelistPetrichorProposal{}
 )
 
 func init() {
 	govtypes.RegisterProposalType(ProposalTypeCreatePetrichor)
 	govtypes.RegisterProposalType(ProposalTypeUpdatePetrichor)
 	govtypes.RegisterProposalType(ProposalTypeDeletePetrichor)
+	govtypes.RegisterProposalType(ProposalTypeDelistPetrichor)
 }
 func NewMsgCreatePetrichorProposal(title, description, denom string, rewardWeight, takeRate sdk.Dec, rewardChangeRate sdk.Dec, rewardChangeInterval time.Duration) govtypes.Content {
 	return &MsgCreatePetrichorProposal{
@@ -42,24 +46,12 @@ func (m *MsgCreatePetrichorProposal) ProposalRoute() string  { return RouterKey
 func (m *MsgCreatePetrichorProposal) ProposalType() string   { return ProposalTypeCreatePetrichor }
 
 func (m *MsgCreatePetrichorProposal) ValidateBasic() error {
+	return ValidatePetrichorAssetFields(m.AssetConfig())
+}
 
-	if m.Denom == "" {
-		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
-	}
-
-	if m.RewardWeight.IsNil() || m.RewardWeight.LTE(sdk.ZeroDec()) {
-		return status.Errorf(codes.InvalidArgument, "Petrichor rewardWeight must be a positive number")
-	}
-
-	if m.TakeRate.IsNil() || m.TakeRate.IsNegative() || m.TakeRate.GTE(sdk.OneDec()) {
-		return status.Errorf(codes.InvalidArgument, "Petrichor takeRate must be more or equals to 0 but strictly less than 1")
-	}
-
-	if m.RewardChangeRate.IsZero() || m.RewardChangeRate.IsNegative() {
-		return status.Errorf(codes.InvalidArgument, "Petrichor rewardChangeRate must be strictly a positive number")
-	}
-
-	return nil
+// AssetConfig returns the asset fields set by the proposal
+func (m *MsgCreatePetrichorProposal) AssetConfig() PetrichorAssetConfig {
+	return newAssetConfig(m)
 }
 
 func NewMsgUpdatePetrichorProposal(title, description, denom string, rewardWeight, takeRate sdk.Dec, rewardChangeRate sdk.Dec, rewardChangeInterval time.Duration) govtypes.Content {
@@ -79,40 +71,135 @@ func (m *MsgUpdatePetrichorProposal) ProposalRoute() string  { return RouterKey
 func (m *MsgUpdatePetrichorProposal) ProposalType() string   { return ProposalTypeUpdatePetrichor }
 
 func (m *MsgUpdatePetrichorProposal) ValidateBasic() error {
+	return ValidatePetrichorAssetFields(m.AssetConfig())
+}
+
+// AssetConfig returns the asset fields set by the proposal
+func (m *MsgUpdatePetrichorProposal) AssetConfig() PetrichorAssetConfig {
+	return newAssetConfig(m)
+}
+
+func NewMsgDeletePetrichorProposal(title, description, denom string) govtypes.Content {
+	return &MsgDeletePetrichorProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+func (m *MsgDeletePetrichorProposal) GetTitle() string       { return m.Title }
+func (m *MsgDeletePetrichorProposal) GetDescription() string { return m.Description }
+func (m *MsgDeletePetrichorProposal) ProposalRoute() string  { return RouterKey }
+func (m *MsgDeletePetrichorProposal) ProposalType() string   { return ProposalTypeDeletePetrichor }
+
+func (m *MsgDeletePetrichorProposal) ValidateBasic() error {
+	if m.Denom == "" {
+		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
+	}
+	return nil
+}
+
+func NewMsgDelistPetrichorProposal(title, description, denom string) govtypes.Content {
+	return &MsgDelistPetrichorProposal{
+		Title:       title,
+		Description: description,
+		Denom:       denom,
+	}
+}
+func (m *MsgDelistPetrichorProposal) GetTitle() string       { return m.Title }
+func (m *MsgDelistPetrichorProposal) GetDescription() string { return m.Description }
+func (m *MsgDelistPetrichorProposal) ProposalRoute() string  { return RouterKey }
+func (m *MsgDelistPetrichorProposal) ProposalType() string   { return ProposalTypeDelistPetrichor }
+
+func (m *MsgDelistPetrichorProposal) ValidateBasic() error {
 	if m.Denom == "" {
 		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
 	}
+	return nil
+}
+
+func validatePetrichorAsset(denom string, rewardWeight, takeRate, rewardChangeRate sdk.Dec) error {
+	if denom == "" {
+		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
+	}
 
-	if m.RewardWeight.IsNil() || m.RewardWeight.LTE(sdk.ZeroDec()) {
+	if rewardWeight.IsNil() || rewardWeight.LTE(sdk.ZeroDec()) {
 		return status.Errorf(codes.InvalidArgument, "Petrichor rewardWeight must be a positive number")
 	}
 
-	if m.TakeRate.IsNil() || m.TakeRate.IsNegative() || m.TakeRate.GTE(sdk.OneDec()) {
+	if takeRate.IsNil() || takeRate.IsNegative() || takeRate.GTE(sdk.OneDec()) {
 		return status.Errorf(codes.InvalidArgument, "Petrichor takeRate must be more or equals to 0 but strictly less than 1")
 	}
 
-	if m.RewardChangeRate.IsZero() || m.RewardChangeRate.IsNegative() {
+	if rewardChangeRate.IsNil() || rewardChangeRate.IsZero() || rewardChangeRate.IsNegative() {
 		return status.Errorf(codes.InvalidArgument, "Petrichor rewardChangeRate must be strictly a positive number")
 	}
 
 	return nil
 }
 
-func NewMsgDeletePetrichorProposal(title, description, denom string) govtypes.Content {
-	return &MsgDeletePetrichorProposal{
-		Title:       title,
-		Description: description,
-		Denom:       denom,
+// validateAssetCaps checks the optional delegation caps and minimum. A nil value means no limit
+func validateAssetCaps(maxTotalTokens *math.Int, maxValidatorShare *sdk.Dec, minDelegationAmount *math.Int) error {
+	if maxTotalTokens != nil && !maxTotalTokens.IsNil() && !maxTotalTokens.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "Petrichor maxTotalTokens must be a positive number")
+	}
+	if maxValidatorShare != nil && !maxValidatorShare.IsNil() &&
+		(!maxValidatorShare.IsPositive() || maxValidatorShare.GT(sdk.OneDec())) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor maxValidatorShare must be more than 0 and less or equals to 1")
 	}
+	if minDelegationAmount != nil && !minDelegationAmount.IsNil() && !minDelegationAmount.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "Petrichor minDelegationAmount must be a positive number")
+	}
+	return nil
 }
-func (m *MsgDeletePetrichorProposal) GetTitle() string       { return m.Title }
-func (m *MsgDeletePetrichorProposal) GetDescription() string { return m.Description }
-func (m *MsgDeletePetrichorProposal) ProposalRoute() string  { return RouterKey }
-func (m *MsgDeletePetrichorProposal) ProposalType() string   { return ProposalTypeDeletePetrichor }
 
-func (m *MsgDeletePetrichorProposal) ValidateBasic() error {
-	if m.Denom == "" {
-		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
+// validateInsuranceCoverageCeiling checks the optional ceiling on the insurance coverage of slashes of a petrichor
+func validateInsuranceCoverageCeiling(ceiling *math.Int) error {
+	if ceiling != nil && !ceiling.IsNil() && !ceiling.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "Petrichor insuranceCoverageCeiling must be a positive number")
+	}
+	return nil
+}
+
+// validateRewardWeightMode checks the bounds of oracle priced reward weights. Both bounds are required in oracle mode
+// so that a faulty price cannot push the weight of an asset to zero or let it take over the rewards
+func validateRewardWeightMode(mode RewardWeightMode, minRewardWeight, maxRewardWeight *sdk.Dec) error {
+	if _, ok := RewardWeightMode_name[int32(mode)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "Petrichor rewardWeightMode %d is unknown", mode)
+	}
+	hasMin := minRewardWeight != nil && !minRewardWeight.IsNil()
+	hasMax := maxRewardWeight != nil && !maxRewardWeight.IsNil()
+	if mode == RewardWeightModeOracle && (!hasMin || !hasMax) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor minRewardWeight and maxRewardWeight must be set in oracle mode")
+	}
+	if hasMin && !minRewardWeight.IsPositive() {
+		return status.Errorf(codes.InvalidArgument, "Petrichor minRewardWeight must be a positive number")
+	}
+	if hasMin && hasMax && maxRewardWeight.LT(*minRewardWeight) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor maxRewardWeight must be more or equals to minRewardWeight")
+	}
+	return nil
+}
+
+// ValidatePetrichorAssetFields checks the asset fields shared by the messages and proposals that create or update a
+// petrichor asset
+func ValidatePetrichorAssetFields(c PetrichorAssetConfig) error {
+	if err := validatePetrichorAsset(c.Denom, c.RewardWeight, c.TakeRate, c.RewardChangeRate); err != nil {
+		return err
+	}
+	if err := validateAssetCaps(c.MaxTotalTokens, c.MaxValidatorShare, c.MinDelegationAmount); err != nil {
+		return err
+	}
+	if err := validateRewardWeightMode(c.RewardWeightMode, c.MinRewardWeight, c.MaxRewardWeight); err != nil {
+		return err
+	}
+	if err := ValidateTakeRateSplits(c.TakeRateSplits); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor takeRateSplits are invalid: %s", err)
+	}
+	if err := ValidateRewardWeightSchedule(c.RewardWeightMode, c.RewardWeightSchedule, c.RewardWeightInterpolation); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor rewardWeightSchedule is invalid: %s", err)
+	}
+	if err := validateInsuranceCoverageCeiling(c.InsuranceCoverageCeiling); err != nil {
+		return err
 	}
 	return nil
 }
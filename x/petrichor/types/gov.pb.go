@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -44,6 +45,29 @@ type MsgCreatePetrichorProposal struct {
 	TakeRate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate"`
 	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
 	RewardChangeInterval time.Duration                          `protobuf:"bytes,7,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
+	// Optional cap on the total amount of tokens that can be delegated for the asset
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Optional cap on the ratio of the asset that can be delegated to a single validator
+	MaxValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share,omitempty"`
+	// Optional minimum amount of tokens a delegation must hold. Partial undelegations and redelegations cannot leave less
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// How the reward weight is set. In oracle mode it follows the price of the asset and in schedule mode the
+	// reward weight schedule
+	RewardWeightMode RewardWeightMode `protobuf:"varint,11,opt,name=reward_weight_mode,json=rewardWeightMode,proto3,enum=petrichor.petrichor.RewardWeightMode" json:"reward_weight_mode,omitempty"`
+	// Lower bound of the reward weight in oracle and schedule mode
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Upper bound of the reward weight in oracle and schedule mode
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Optional split of the take rate proceeds of the asset. The module wide split is used when empty
+	TakeRateSplits []TakeRateSplit `protobuf:"bytes,14,rep,name=take_rate_splits,json=takeRateSplits,proto3" json:"take_rate_splits"`
+	// Reward weight targets applied in schedule mode, ordered by time
+	RewardWeightSchedule []RewardWeightSchedulePoint `protobuf:"bytes,15,rep,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule"`
+	// How the reward weight moves between the points of the schedule
+	RewardWeightInterpolation RewardWeightInterpolation `protobuf:"varint,16,opt,name=reward_weight_interpolation,json=rewardWeightInterpolation,proto3,enum=petrichor.petrichor.RewardWeightInterpolation" json:"reward_weight_interpolation,omitempty"`
+	// Optional ceiling on the slashed tokens of the asset covered by the insurance fund in each slash. Only
+	// undelegations that are still unbonding are covered, slashed delegations and redelegations are not.
+	// Slashes of the asset are not covered when unset
+	InsuranceCoverageCeiling *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=insurance_coverage_ceiling,json=insuranceCoverageCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"insurance_coverage_ceiling,omitempty"`
 }
 
 func (m *MsgCreatePetrichorProposal) Reset()         { *m = MsgCreatePetrichorProposal{} }
@@ -93,6 +117,29 @@ type MsgUpdatePetrichorProposal struct {
 	TakeRate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate"`
 	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
 	RewardChangeInterval time.Duration                          `protobuf:"bytes,7,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
+	// Optional cap on the total amount of tokens that can be delegated for the asset
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Optional cap on the ratio of the asset that can be delegated to a single validator
+	MaxValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share,omitempty"`
+	// Optional minimum amount of tokens a delegation must hold. Partial undelegations and redelegations cannot leave less
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// How the reward weight is set. In oracle mode it follows the price of the asset and in schedule mode the
+	// reward weight schedule
+	RewardWeightMode RewardWeightMode `protobuf:"varint,11,opt,name=reward_weight_mode,json=rewardWeightMode,proto3,enum=petrichor.petrichor.RewardWeightMode" json:"reward_weight_mode,omitempty"`
+	// Lower bound of the reward weight in oracle and schedule mode
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Upper bound of the reward weight in oracle and schedule mode
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Optional split of the take rate proceeds of the asset. The module wide split is used when empty
+	TakeRateSplits []TakeRateSplit `protobuf:"bytes,14,rep,name=take_rate_splits,json=takeRateSplits,proto3" json:"take_rate_splits"`
+	// Reward weight targets applied in schedule mode, ordered by time
+	RewardWeightSchedule []RewardWeightSchedulePoint `protobuf:"bytes,15,rep,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule"`
+	// How the reward weight moves between the points of the schedule
+	RewardWeightInterpolation RewardWeightInterpolation `protobuf:"varint,16,opt,name=reward_weight_interpolation,json=rewardWeightInterpolation,proto3,enum=petrichor.petrichor.RewardWeightInterpolation" json:"reward_weight_interpolation,omitempty"`
+	// Optional ceiling on the slashed tokens of the asset covered by the insurance fund in each slash. Only
+	// undelegations that are still unbonding are covered, slashed delegations and redelegations are not.
+	// Slashes of the asset are not covered when unset
+	InsuranceCoverageCeiling *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=insurance_coverage_ceiling,json=insuranceCoverageCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"insurance_coverage_ceiling,omitempty"`
 }
 
 func (m *MsgUpdatePetrichorProposal) Reset()         { *m = MsgUpdatePetrichorProposal{} }
@@ -169,43 +216,107 @@ func (m *MsgDeletePetrichorProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeletePetrichorProposal proto.InternalMessageInfo
 
+type MsgDelistPetrichorProposal struct {
+	// the title of the delist proposal
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// the description of the proposal
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denom       string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+}
+
+func (m *MsgDelistPetrichorProposal) Reset()         { *m = MsgDelistPetrichorProposal{} }
+func (m *MsgDelistPetrichorProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistPetrichorProposal) ProtoMessage()    {}
+func (*MsgDelistPetrichorProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_311febec2b6b7944, []int{3}
+}
+func (m *MsgDelistPetrichorProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistPetrichorProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistPetrichorProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistPetrichorProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistPetrichorProposal.Merge(m, src)
+}
+func (m *MsgDelistPetrichorProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistPetrichorProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistPetrichorProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistPetrichorProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreatePetrichorProposal)(nil), "petrichor.petrichor.MsgCreatePetrichorProposal")
 	proto.RegisterType((*MsgUpdatePetrichorProposal)(nil), "petrichor.petrichor.MsgUpdatePetrichorProposal")
 	proto.RegisterType((*MsgDeletePetrichorProposal)(nil), "petrichor.petrichor.MsgDeletePetrichorProposal")
+	proto.RegisterType((*MsgDelistPetrichorProposal)(nil), "petrichor.petrichor.MsgDelistPetrichorProposal")
 }
 
 func init() { proto.RegisterFile("petrichor/gov.proto", fileDescriptor_311febec2b6b7944) }
 
 var fileDescriptor_311febec2b6b7944 = []byte{
-	// 432 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x94, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x33, 0xae, 0x5d, 0xbb, 0xb3, 0x2b, 0x2c, 0x63, 0x91, 0xd8, 0x43, 0x52, 0x7a, 0x58,
-	0xf6, 0xe2, 0x04, 0xdc, 0xdb, 0x1e, 0xbb, 0xbd, 0x88, 0x2c, 0x2c, 0x11, 0x11, 0x45, 0x58, 0xa6,
-	0xc9, 0x73, 0x12, 0x9a, 0xe4, 0x85, 0xc9, 0x74, 0xeb, 0x7e, 0x01, 0xf1, 0xe8, 0xd1, 0x63, 0x3f,
-	0x4e, 0x8f, 0x3d, 0x8a, 0x87, 0xaa, 0xed, 0xc5, 0xb3, 0x9f, 0x40, 0x32, 0x49, 0x6d, 0xbc, 0xf6,
-	0x22, 0x88, 0xa7, 0xbc, 0x79, 0xff, 0xe1, 0x97, 0xe1, 0xfd, 0xe0, 0xd1, 0x07, 0x39, 0x68, 0x15,
-	0x07, 0x11, 0x2a, 0x4f, 0xe2, 0x0d, 0xcf, 0x15, 0x6a, 0x64, 0xdb, 0x26, 0xff, 0x5d, 0x75, 0x3b,
-	0x12, 0x25, 0x9a, 0xdc, 0x2b, 0xab, 0xea, 0x6a, 0xd7, 0x91, 0x88, 0x32, 0x01, 0xcf, 0x9c, 0x46,
-	0x93, 0xb7, 0x5e, 0x38, 0x51, 0x42, 0xc7, 0x98, 0x55, 0x79, 0xff, 0xfb, 0x1e, 0xed, 0x5e, 0x16,
-	0xf2, 0x42, 0x81, 0xd0, 0x70, 0xb5, 0x81, 0x5d, 0x29, 0xcc, 0xb1, 0x10, 0x09, 0xeb, 0xd0, 0x96,
-	0x8e, 0x75, 0x02, 0x36, 0xe9, 0x91, 0xd3, 0x03, 0xbf, 0x3a, 0xb0, 0x1e, 0x3d, 0x0c, 0xa1, 0x08,
-	0x54, 0x9c, 0x97, 0x24, 0xfb, 0x8e, 0xc9, 0x9a, 0x2d, 0x76, 0x42, 0x5b, 0x21, 0x64, 0x98, 0xda,
-	0x7b, 0x65, 0x36, 0x38, 0xfe, 0xb9, 0x74, 0x8f, 0x6e, 0x45, 0x9a, 0x9c, 0xf7, 0x4d, 0xbb, 0xef,
-	0x57, 0x31, 0x7b, 0x4e, 0xef, 0x2b, 0x98, 0x0a, 0x15, 0x5e, 0x4f, 0x21, 0x96, 0x91, 0xb6, 0xef,
-	0x9a, 0xfb, 0x7c, 0xbe, 0x74, 0xad, 0x2f, 0x4b, 0xf7, 0x44, 0xc6, 0x3a, 0x9a, 0x8c, 0x78, 0x80,
-	0xa9, 0x17, 0x60, 0x91, 0x62, 0x51, 0x7f, 0x1e, 0x17, 0xe1, 0xd8, 0xd3, 0xb7, 0x39, 0x14, 0x7c,
-	0x08, 0x81, 0x7f, 0x54, 0x41, 0x5e, 0x1a, 0x06, 0x7b, 0x46, 0x0f, 0xb4, 0x18, 0xc3, 0xb5, 0x12,
-	0x1a, 0xec, 0xd6, 0x4e, 0xc0, 0x76, 0x09, 0xf0, 0x85, 0x06, 0xf6, 0x86, 0xb2, 0xfa, 0x85, 0x41,
-	0x24, 0x32, 0x59, 0x53, 0xf7, 0x77, 0xa2, 0x1e, 0x57, 0xa4, 0x0b, 0x03, 0x32, 0xf4, 0x57, 0xf4,
-	0xe1, 0x9f, 0xf4, 0x38, 0xd3, 0xa0, 0x6e, 0x44, 0x62, 0xdf, 0xeb, 0x91, 0xd3, 0xc3, 0x27, 0x8f,
-	0x78, 0xe5, 0x8f, 0x6f, 0xfc, 0xf1, 0x61, 0xed, 0x6f, 0xd0, 0x2e, 0x7f, 0xfe, 0xe9, 0xab, 0x4b,
-	0xfc, 0x4e, 0x13, 0xfb, 0xb4, 0x06, 0x9c, 0xb7, 0x3f, 0xcc, 0x5c, 0xeb, 0xc7, 0xcc, 0xb5, 0x36,
-	0x8e, 0x5f, 0xe4, 0xe1, 0x7f, 0xc7, 0xff, 0xaa, 0xe3, 0xf7, 0xc4, 0x38, 0x1e, 0x42, 0x02, 0x7f,
-	0xc1, 0xf1, 0xf6, 0x21, 0x83, 0xcb, 0xf9, 0xca, 0x21, 0x8b, 0x95, 0x43, 0xbe, 0xad, 0x1c, 0xf2,
-	0x71, 0xed, 0x58, 0x8b, 0xb5, 0x63, 0x7d, 0x5e, 0x3b, 0xd6, 0xeb, 0xb3, 0xc6, 0x04, 0xcd, 0xda,
-	0xca, 0x40, 0x4f, 0x51, 0x8d, 0xbd, 0xed, 0x8a, 0x7b, 0xd7, 0xa8, 0xcd, 0x48, 0x47, 0xfb, 0x66,
-	0x28, 0x67, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xb3, 0x15, 0x5f, 0x9a, 0x08, 0x05, 0x00, 0x00,
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x97, 0xcf, 0x6b, 0xdb, 0x48,
+	0x14, 0xc7, 0xad, 0xcd, 0x8f, 0x75, 0xc6, 0x89, 0xe3, 0x4c, 0xbc, 0x41, 0xf1, 0x82, 0x6d, 0x0c,
+	0x1b, 0x7c, 0x89, 0x0c, 0xc9, 0x2d, 0xec, 0x65, 0xed, 0x5c, 0xc2, 0x12, 0x08, 0x72, 0x76, 0x4b,
+	0x43, 0xa9, 0x98, 0x48, 0x13, 0x79, 0x6a, 0x69, 0x46, 0xcc, 0x8c, 0x1d, 0xa7, 0x7f, 0x40, 0xe9,
+	0xb1, 0xc7, 0x1e, 0xf3, 0x47, 0xf4, 0x8f, 0xc8, 0x31, 0x94, 0x1e, 0x4a, 0x0f, 0x6e, 0x49, 0x2e,
+	0x3d, 0xf7, 0x2f, 0x28, 0x1a, 0xc9, 0xb6, 0x5c, 0x5c, 0xd2, 0x9a, 0x40, 0xa0, 0xf8, 0x64, 0xcd,
+	0x7b, 0x33, 0x9f, 0xf7, 0xe6, 0xbd, 0x37, 0x5f, 0x30, 0x58, 0x0f, 0xb0, 0xe4, 0xc4, 0x6e, 0x31,
+	0x5e, 0x73, 0x59, 0xd7, 0x08, 0x38, 0x93, 0x0c, 0x8e, 0x8c, 0xc6, 0xf0, 0xab, 0x90, 0x77, 0x99,
+	0xcb, 0x94, 0xbf, 0x16, 0x7e, 0x45, 0x5b, 0x0b, 0x45, 0x97, 0x31, 0xd7, 0xc3, 0x35, 0xb5, 0x3a,
+	0xed, 0x9c, 0xd5, 0x9c, 0x0e, 0x47, 0x92, 0x30, 0x1a, 0xfb, 0x37, 0x6d, 0x26, 0x7c, 0x26, 0xac,
+	0xe8, 0x60, 0xb4, 0x18, 0xb8, 0x46, 0xa1, 0x13, 0xf1, 0x94, 0x6b, 0x23, 0xe1, 0x42, 0x1c, 0xf9,
+	0xf1, 0x91, 0xca, 0xbb, 0x0c, 0x28, 0x1c, 0x0a, 0xb7, 0xc1, 0x31, 0x92, 0xf8, 0x68, 0xb0, 0xe7,
+	0x88, 0xb3, 0x80, 0x09, 0xe4, 0xc1, 0x3c, 0x58, 0x90, 0x44, 0x7a, 0x58, 0xd7, 0xca, 0x5a, 0x75,
+	0xc9, 0x8c, 0x16, 0xb0, 0x0c, 0x32, 0x0e, 0x16, 0x36, 0x27, 0x41, 0x98, 0x97, 0xfe, 0x9b, 0xf2,
+	0x25, 0x4d, 0x70, 0x0b, 0x2c, 0x38, 0x98, 0x32, 0x5f, 0x9f, 0x0b, 0x7d, 0xf5, 0xdc, 0x97, 0x7e,
+	0x69, 0xf9, 0x02, 0xf9, 0xde, 0x5e, 0x45, 0x99, 0x2b, 0x66, 0xe4, 0x86, 0x4d, 0xb0, 0xc2, 0xf1,
+	0x39, 0xe2, 0x8e, 0x75, 0x8e, 0x89, 0xdb, 0x92, 0xfa, 0xbc, 0xda, 0x6f, 0x5c, 0xf5, 0x4b, 0xa9,
+	0x0f, 0xfd, 0xd2, 0x96, 0x4b, 0x64, 0xab, 0x73, 0x6a, 0xd8, 0xcc, 0x8f, 0x6f, 0x1a, 0xff, 0x6c,
+	0x0b, 0xa7, 0x5d, 0x93, 0x17, 0x01, 0x16, 0xc6, 0x3e, 0xb6, 0xcd, 0xe5, 0x08, 0xf2, 0x48, 0x31,
+	0xe0, 0xbf, 0x60, 0x49, 0xa2, 0x36, 0xb6, 0x38, 0x92, 0x58, 0x5f, 0x98, 0x0a, 0x98, 0x0e, 0x01,
+	0x26, 0x92, 0x18, 0x3e, 0x01, 0x30, 0xce, 0xd0, 0x6e, 0x21, 0xea, 0xc6, 0xd4, 0xc5, 0xa9, 0xa8,
+	0xb9, 0x88, 0xd4, 0x50, 0x20, 0x45, 0x7f, 0x0c, 0x36, 0xc6, 0xe9, 0x84, 0x4a, 0xcc, 0xbb, 0xc8,
+	0xd3, 0x7f, 0x2f, 0x6b, 0xd5, 0xcc, 0xce, 0xa6, 0x11, 0x4d, 0x83, 0x31, 0x98, 0x06, 0x63, 0x3f,
+	0x9e, 0x86, 0x7a, 0x3a, 0x0c, 0xfe, 0xfa, 0x63, 0x49, 0x33, 0xf3, 0x49, 0xec, 0x41, 0x0c, 0x80,
+	0x67, 0x20, 0xe7, 0xa3, 0x9e, 0x25, 0x99, 0x44, 0x9e, 0x25, 0x59, 0x1b, 0x53, 0xa1, 0xa7, 0x55,
+	0xda, 0x7f, 0x5f, 0xf5, 0x4b, 0xda, 0x0f, 0xa6, 0x7d, 0x40, 0xe5, 0xdb, 0x37, 0xdb, 0x20, 0xb2,
+	0x87, 0x2b, 0x33, 0xeb, 0xa3, 0xde, 0x71, 0x08, 0x3d, 0x56, 0x4c, 0xf8, 0x14, 0xac, 0x87, 0x71,
+	0xba, 0xc8, 0x23, 0x0e, 0x92, 0x8c, 0x5b, 0xa2, 0x85, 0x38, 0xd6, 0x97, 0x86, 0x15, 0xd2, 0x7e,
+	0xa2, 0x42, 0x6b, 0x3e, 0xea, 0xfd, 0x3f, 0x20, 0x35, 0x43, 0x10, 0x0c, 0xc0, 0x1f, 0x3e, 0xa1,
+	0x96, 0x83, 0x3d, 0xec, 0xaa, 0x9b, 0x5b, 0xc8, 0x67, 0x1d, 0x2a, 0x75, 0x70, 0x0f, 0x97, 0x59,
+	0xf7, 0x09, 0xdd, 0x1f, 0x92, 0xff, 0x51, 0x60, 0xd8, 0x04, 0x70, 0x6c, 0x28, 0x2d, 0x9f, 0x39,
+	0x58, 0xcf, 0x94, 0xb5, 0x6a, 0x76, 0xe7, 0x2f, 0x63, 0xc2, 0x4b, 0x36, 0xcc, 0xc4, 0xf8, 0x1d,
+	0x32, 0x07, 0x0f, 0x3a, 0x3d, 0xb2, 0xc0, 0x13, 0xb0, 0x16, 0x5e, 0x63, 0x7c, 0xda, 0x97, 0xa7,
+	0x2a, 0xd2, 0xaa, 0x4f, 0x68, 0x32, 0xa2, 0x62, 0xa3, 0xde, 0x37, 0xec, 0x95, 0x29, 0xd9, 0xa8,
+	0x37, 0xc6, 0x36, 0x41, 0x6e, 0xf8, 0x98, 0x2c, 0x11, 0x78, 0x44, 0x0a, 0x3d, 0x5b, 0x9e, 0xab,
+	0x66, 0x76, 0x2a, 0x13, 0x4b, 0x71, 0x1c, 0x3f, 0x9c, 0x66, 0xb8, 0xb5, 0x3e, 0x1f, 0x0e, 0xa9,
+	0x99, 0x95, 0x49, 0xa3, 0x80, 0xcf, 0xc0, 0xc6, 0x58, 0xae, 0x96, 0xb0, 0x5b, 0xd8, 0xe9, 0x78,
+	0x58, 0x5f, 0x55, 0x64, 0xe3, 0xce, 0x22, 0x37, 0xe3, 0x03, 0x47, 0x8c, 0xd0, 0x41, 0x94, 0x3c,
+	0x9f, 0xb0, 0x01, 0x52, 0xf0, 0xe7, 0x78, 0x2c, 0xf5, 0xc2, 0x02, 0xe6, 0xa9, 0x8e, 0xeb, 0x39,
+	0xd5, 0xd5, 0xbb, 0x03, 0x1e, 0x24, 0x4f, 0x99, 0x9b, 0xfc, 0x7b, 0x2e, 0xf8, 0x1c, 0x14, 0x08,
+	0x15, 0x1d, 0x8e, 0xa8, 0x8d, 0x2d, 0x9b, 0x75, 0x31, 0x47, 0x2e, 0xb6, 0x6c, 0x4c, 0x3c, 0x42,
+	0x5d, 0x7d, 0xed, 0x1e, 0x66, 0x56, 0x1f, 0xf2, 0x1b, 0x31, 0xbe, 0x11, 0xd1, 0xf7, 0xd2, 0x2f,
+	0x2f, 0x4b, 0xa9, 0xcf, 0x97, 0xa5, 0xd4, 0x40, 0xd6, 0xff, 0x0b, 0x9c, 0x99, 0xac, 0xcf, 0x64,
+	0x7d, 0x26, 0xeb, 0x33, 0x59, 0x9f, 0xc9, 0xfa, 0x2f, 0x20, 0xeb, 0x2f, 0x34, 0x25, 0xeb, 0xe1,
+	0x68, 0x3f, 0x80, 0xac, 0x4f, 0x4c, 0x84, 0x08, 0xf9, 0x80, 0x89, 0xd4, 0x0f, 0xaf, 0x6e, 0x8a,
+	0xda, 0xf5, 0x4d, 0x51, 0xfb, 0x74, 0x53, 0xd4, 0x5e, 0xdd, 0x16, 0x53, 0xd7, 0xb7, 0xc5, 0xd4,
+	0xfb, 0xdb, 0x62, 0xea, 0x64, 0x37, 0xd1, 0x05, 0xd5, 0x7c, 0x8a, 0xe5, 0x39, 0xe3, 0xed, 0xd1,
+	0x5f, 0xa3, 0x5a, 0x2f, 0xf1, 0xad, 0xda, 0x72, 0xba, 0xa8, 0x04, 0x79, 0xf7, 0xeb, 0x00, 0x94,
+	0xea, 0x79, 0x0d, 0xc5, 0x0d, 0x00, 0x00,
 }
 
 func (m *MsgCreatePetrichorProposal) Marshal() (dAtA []byte, err error) {
@@ -228,6 +339,120 @@ func (m *MsgCreatePetrichorProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.InsuranceCoverageCeiling != nil {
+		{
+			size := m.InsuranceCoverageCeiling.Size()
+			i -= size
+			if _, err := m.InsuranceCoverageCeiling.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RewardWeightInterpolation != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.RewardWeightInterpolation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for iNdEx := len(m.RewardWeightSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeightSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for iNdEx := len(m.TakeRateSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RewardWeightMode != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.RewardWeightMode))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxValidatorShare != nil {
+		{
+			size := m.MaxValidatorShare.Size()
+			i -= size
+			if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
 	if err1 != nil {
 		return 0, err1
@@ -310,6 +535,120 @@ func (m *MsgUpdatePetrichorProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.InsuranceCoverageCeiling != nil {
+		{
+			size := m.InsuranceCoverageCeiling.Size()
+			i -= size
+			if _, err := m.InsuranceCoverageCeiling.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RewardWeightInterpolation != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.RewardWeightInterpolation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for iNdEx := len(m.RewardWeightSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeightSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for iNdEx := len(m.TakeRateSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGov(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.RewardWeightMode != 0 {
+		i = encodeVarintGov(dAtA, i, uint64(m.RewardWeightMode))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxValidatorShare != nil {
+		{
+			size := m.MaxValidatorShare.Size()
+			i -= size
+			if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGov(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
 	if err2 != nil {
 		return 0, err2
@@ -416,6 +755,50 @@ func (m *MsgDeletePetrichorProposal) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgDelistPetrichorProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistPetrichorProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistPetrichorProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -453,6 +836,48 @@ func (m *MsgCreatePetrichorProposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
 	n += 1 + l + sovGov(uint64(l))
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxValidatorShare != nil {
+		l = m.MaxValidatorShare.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.RewardWeightMode != 0 {
+		n += 1 + sovGov(uint64(m.RewardWeightMode))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for _, e := range m.TakeRateSplits {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for _, e := range m.RewardWeightSchedule {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.RewardWeightInterpolation != 0 {
+		n += 2 + sovGov(uint64(m.RewardWeightInterpolation))
+	}
+	if m.InsuranceCoverageCeiling != nil {
+		l = m.InsuranceCoverageCeiling.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -482,6 +907,48 @@ func (m *MsgUpdatePetrichorProposal) Size() (n int) {
 	n += 1 + l + sovGov(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
 	n += 1 + l + sovGov(uint64(l))
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxValidatorShare != nil {
+		l = m.MaxValidatorShare.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.RewardWeightMode != 0 {
+		n += 1 + sovGov(uint64(m.RewardWeightMode))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovGov(uint64(l))
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for _, e := range m.TakeRateSplits {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for _, e := range m.RewardWeightSchedule {
+			l = e.Size()
+			n += 1 + l + sovGov(uint64(l))
+		}
+	}
+	if m.RewardWeightInterpolation != 0 {
+		n += 2 + sovGov(uint64(m.RewardWeightInterpolation))
+	}
+	if m.InsuranceCoverageCeiling != nil {
+		l = m.InsuranceCoverageCeiling.Size()
+		n += 2 + l + sovGov(uint64(l))
+	}
 	return n
 }
 
@@ -506,6 +973,27 @@ func (m *MsgDeletePetrichorProposal) Size() (n int) {
 	return n
 }
 
+func (m *MsgDelistPetrichorProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -772,59 +1260,9 @@ func (m *MsgCreatePetrichorProposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGov(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGov
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGov
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePetrichorProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePetrichorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -852,11 +1290,15 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -884,11 +1326,15 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxValidatorShare = &v
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -916,13 +1362,17 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightMode", wireType)
 			}
-			var stringLen uint64
+			m.RewardWeightMode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGov
@@ -932,13 +1382,580 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RewardWeightMode |= RewardWeightMode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateSplits = append(m.TakeRateSplits, TakeRateSplit{})
+			if err := m.TakeRateSplits[len(m.TakeRateSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeightSchedule = append(m.RewardWeightSchedule, RewardWeightSchedulePoint{})
+			if err := m.RewardWeightSchedule[len(m.RewardWeightSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightInterpolation", wireType)
+			}
+			m.RewardWeightInterpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightInterpolation |= RewardWeightInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceCoverageCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.InsuranceCoverageCeiling = &v
+			if err := m.InsuranceCoverageCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePetrichorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePetrichorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxValidatorShare = &v
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGov
 			}
 			postIndex := iNdEx + intStringLen
@@ -948,13 +1965,34 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightMode", wireType)
+			}
+			m.RewardWeightMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightMode |= RewardWeightMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -982,13 +2020,15 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1016,13 +2056,15 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateSplits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1049,7 +2091,97 @@ func (m *MsgUpdatePetrichorProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+			m.TakeRateSplits = append(m.TakeRateSplits, TakeRateSplit{})
+			if err := m.TakeRateSplits[len(m.TakeRateSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeightSchedule = append(m.RewardWeightSchedule, RewardWeightSchedulePoint{})
+			if err := m.RewardWeightSchedule[len(m.RewardWeightSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightInterpolation", wireType)
+			}
+			m.RewardWeightInterpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightInterpolation |= RewardWeightInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceCoverageCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.InsuranceCoverageCeiling = &v
+			if err := m.InsuranceCoverageCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1220,6 +2352,152 @@ func (m *MsgDeletePetrichorProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgDelistPetrichorProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelistPetrichorProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelistPetrichorProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,198 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PetrichorHooks lets other modules react to petrichor validators, delegations, rewards, assets and slashes.
+// A Before hook runs once the action is known to be valid and an error returned by any hook aborts the action
+type PetrichorHooks interface {
+	// Validator lifecycle
+	AfterPetrichorValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error
+	BeforePetrichorValidatorRemoved(ctx sdk.Context, valAddr sdk.ValAddress) error
+	AfterPetrichorValidatorArchived(ctx sdk.Context, valAddr sdk.ValAddress) error
+
+	// Delegations
+	BeforeDelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error
+	AfterDelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error
+	BeforeRedelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcValAddr sdk.ValAddress, dstValAddr sdk.ValAddress, coin sdk.Coin) error
+	AfterRedelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcValAddr sdk.ValAddress, dstValAddr sdk.ValAddress, coin sdk.Coin) error
+	BeforeUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error
+	AfterUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error
+
+	// Rewards
+	BeforeClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error
+	AfterClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, rewards sdk.Coins) error
+
+	// Assets
+	BeforeCreatePetrichorAsset(ctx sdk.Context, denom string) error
+	AfterCreatePetrichorAsset(ctx sdk.Context, asset PetrichorAsset) error
+	BeforeUpdatePetrichorAsset(ctx sdk.Context, asset PetrichorAsset) error
+	AfterUpdatePetrichorAsset(ctx sdk.Context, asset PetrichorAsset) error
+
+	// Slashing
+	BeforeSlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error
+	AfterSlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error
+}
+
+var _ PetrichorHooks = MultiPetrichorHooks{}
+
+// MultiPetrichorHooks combines multiple petrichor hooks, all hooks are run in order
+type MultiPetrichorHooks []PetrichorHooks
+
+func NewMultiPetrichorHooks(hooks ...PetrichorHooks) MultiPetrichorHooks {
+	return hooks
+}
+
+func (h MultiPetrichorHooks) AfterPetrichorValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	for i := range h {
+		if err := h[i].AfterPetrichorValidatorCreated(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforePetrichorValidatorRemoved(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	for i := range h {
+		if err := h[i].BeforePetrichorValidatorRemoved(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterPetrichorValidatorArchived(ctx sdk.Context, valAddr sdk.ValAddress) error {
+	for i := range h {
+		if err := h[i].AfterPetrichorValidatorArchived(ctx, valAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforeDelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].BeforeDelegate(ctx, delAddr, valAddr, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterDelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterDelegate(ctx, delAddr, valAddr, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforeRedelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcValAddr sdk.ValAddress, dstValAddr sdk.ValAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].BeforeRedelegate(ctx, delAddr, srcValAddr, dstValAddr, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterRedelegate(ctx sdk.Context, delAddr sdk.AccAddress, srcValAddr sdk.ValAddress, dstValAddr sdk.ValAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterRedelegate(ctx, delAddr, srcValAddr, dstValAddr, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforeUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].BeforeUndelegate(ctx, delAddr, valAddr, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterUndelegate(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterUndelegate(ctx, delAddr, valAddr, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforeClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) error {
+	for i := range h {
+		if err := h[i].BeforeClaimDelegationRewards(ctx, delAddr, valAddr, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterClaimDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string, rewards sdk.Coins) error {
+	for i := range h {
+		if err := h[i].AfterClaimDelegationRewards(ctx, delAddr, valAddr, denom, rewards); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforeCreatePetrichorAsset(ctx sdk.Context, denom string) error {
+	for i := range h {
+		if err := h[i].BeforeCreatePetrichorAsset(ctx, denom); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterCreatePetrichorAsset(ctx sdk.Context, asset PetrichorAsset) error {
+	for i := range h {
+		if err := h[i].AfterCreatePetrichorAsset(ctx, asset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforeUpdatePetrichorAsset(ctx sdk.Context, asset PetrichorAsset) error {
+	for i := range h {
+		if err := h[i].BeforeUpdatePetrichorAsset(ctx, asset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterUpdatePetrichorAsset(ctx sdk.Context, asset PetrichorAsset) error {
+	for i := range h {
+		if err := h[i].AfterUpdatePetrichorAsset(ctx, asset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) BeforeSlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	for i := range h {
+		if err := h[i].BeforeSlashValidator(ctx, valAddr, fraction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiPetrichorHooks) AfterSlashValidator(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	for i := range h {
+		if err := h[i].AfterSlashValidator(ctx, valAddr, fraction); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,9 +1,12 @@
 package types
 
 import (
+	"context"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/staking/types"
 	"time"
 )
@@ -37,6 +40,11 @@ type StakingKeeper interface {
 	GetAllValidators(ctx sdk.Context) (validators []types.Validator)
 }
 
+// SlashingKeeper defines the expected slashing keeper used to find tombstoned validators
+type SlashingKeeper interface {
+	IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+}
+
 type BankKeeper interface {
 	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
@@ -46,6 +54,9 @@ type BankKeeper interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SpendableCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -57,4 +68,12 @@ type AccountKeeper interface {
 
 type DistributionKeeper interface {
 	WithdrawDelegationRewards(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) (sdk.Coins, error)
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DelegationRewards(c context.Context, req *distrtypes.QueryDelegationRewardsRequest) (*distrtypes.QueryDelegationRewardsResponse, error)
+}
+
+// PriceSource provides the prices used to derive the reward weight of petrichor assets in oracle mode.
+// It can be backed by an oracle keeper or a TWAP module. Prices must be quoted in the same denom for every asset
+type PriceSource interface {
+	GetPrice(ctx sdk.Context, denom string) (price sdk.Dec, found bool)
 }
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"time"
@@ -13,6 +16,18 @@ const (
 	// RewardsPoolName is the name of the module account for rewards
 	RewardsPoolName = "petrichor_rewards"
 
+	// LiquidStakingPoolName is the name of the module account that holds delegations backing liquid staking receipts
+	LiquidStakingPoolName = "petrichor_liquid"
+
+	// BuybackPoolName is the name of the module account that receives the take rate proceeds set aside for buybacks
+	BuybackPoolName = "petrichor_buyback"
+
+	// InsuranceFundName is the name of the module account that covers slashed petrichor undelegations
+	InsuranceFundName = "petrichor_insurance"
+
+	// LiquidReceiptDenomPrefix is the prefix of the denoms minted as liquid staking receipts
+	LiquidReceiptDenomPrefix = "petrichor/receipt/"
+
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
@@ -34,21 +49,75 @@ var (
 	AssetRebalanceQueueKey        = []byte{0x13}
 	RewardWeightChangeSnapshotKey = []byte{0x14}
 	RewardWeightDecayQueueKey     = []byte{0x15}
+	TakeRateAccrualKey            = []byte{0x16}
+	TakeRateHistoryKey            = []byte{0x17}
+	ValidatorOptOutQueueKey       = []byte{0x18}
+	SlashRecordKey                = []byte{0x19}
+	InsuranceClaimKey             = []byte{0x1A}
+	TombstonedValidatorQueueKey   = []byte{0x1B}
+	ArchivedValidatorInfoKey      = []byte{0x1C}
+	AutoCompoundCursorKey         = []byte{0x1D}
+	DelistingAssetQueueKey        = []byte{0x1E}
 
 	DelegationKey        = []byte{0x21}
 	RedelegationKey      = []byte{0x22}
 	RedelegationQueueKey = []byte{0x23}
 	UndelegationQueueKey = []byte{0x24}
 
+	LiquidReceiptKey       = []byte{0x25}
+	LiquidReceiptHolderKey = []byte{0x26}
+	AutoCompoundKey        = []byte{0x27}
+	WithdrawAddressKey     = []byte{0x28}
+	FallbackValidatorKey   = []byte{0x29}
+
 	// Indexes for querying
 	RedelegationByValidatorIndexKey = []byte{0x31}
 	UndelegationByValidatorIndexKey = []byte{0x32}
+	UndelegationByDelegatorIndexKey = []byte{0x33}
+	UndelegationByDenomIndexKey     = []byte{0x34}
+	RedelegationByDenomIndexKey     = []byte{0x35}
+	DelegationByValidatorIndexKey   = []byte{0x36}
+	SlashRecordByDelegationIndexKey = []byte{0x37}
+	DelegationByDenomIndexKey       = []byte{0x38}
 )
 
 func GetAssetKey(denom string) []byte {
 	return append(AssetKey, address.MustLengthPrefix([]byte(denom))...)
 }
 
+func GetTakeRateAccrualKey(denom string) []byte {
+	return append(TakeRateAccrualKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetTakeRateHistoryKey key is in the format of denom|height so that the history of an asset is sorted by height
+func GetTakeRateHistoryKey(denom string, height uint64) []byte {
+	return append(GetTakeRateHistoryKeyForDenom(denom), sdk.Uint64ToBigEndian(height)...)
+}
+
+func GetTakeRateHistoryKeyForDenom(denom string) []byte {
+	return append(TakeRateHistoryKey, address.MustLengthPrefix([]byte(denom))...)
+}
+
+// GetSlashRecordKey key is in the format of validator|height
+func GetSlashRecordKey(valAddr sdk.ValAddress, height uint64) []byte {
+	return append(GetSlashRecordsKeyForValidator(valAddr), sdk.Uint64ToBigEndian(height)...)
+}
+
+func GetSlashRecordsKeyForValidator(valAddr sdk.ValAddress) []byte {
+	return append(SlashRecordKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetInsuranceClaimKey key is in the format of delegator|height|validator|denom
+func GetInsuranceClaimKey(delAddr sdk.AccAddress, height uint64, valAddr sdk.ValAddress, denom string) []byte {
+	key := append(GetInsuranceClaimsKeyForDelegator(delAddr), sdk.Uint64ToBigEndian(height)...)
+	key = append(key, address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix([]byte(denom))...)
+}
+
+func GetInsuranceClaimsKeyForDelegator(delAddr sdk.AccAddress) []byte {
+	return append(InsuranceClaimKey, address.MustLengthPrefix(delAddr)...)
+}
+
 // GetDelegationKey key is in the format of delegator|validator|denom
 func GetDelegationKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
 	return append(GetDelegationsKeyForAllDenoms(delAddr, valAddr), address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
@@ -64,6 +133,52 @@ func GetDelegationsKey(delAddr sdk.AccAddress) []byte {
 	return append(DelegationKey, address.MustLengthPrefix(delAddr)...)
 }
 
+// GetAutoCompoundKey key is in the same format as the delegation key delegator|validator|denom
+func GetAutoCompoundKey(delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) []byte {
+	key := append(AutoCompoundKey, address.MustLengthPrefix(delAddr)...)
+	key = append(key, address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+func ParseAutoCompoundKey(key []byte) (delAddr sdk.AccAddress, valAddr sdk.ValAddress, denom string) {
+	offset := len(AutoCompoundKey)
+	delAddrLen := int(key[offset])
+	offset += 1
+	delAddr = sdk.AccAddress(key[offset : offset+delAddrLen])
+	offset += delAddrLen
+	valAddrLen := int(key[offset])
+	offset += 1
+	valAddr = sdk.ValAddress(key[offset : offset+valAddrLen])
+	offset += valAddrLen
+	denomLen := int(key[offset])
+	offset += 1
+	// Denoms are stored null-terminated
+	denom = string(key[offset : offset+denomLen-1])
+	return delAddr, valAddr, denom
+}
+
+func GetWithdrawAddressKey(delAddr sdk.AccAddress) []byte {
+	return append(WithdrawAddressKey, address.MustLengthPrefix(delAddr)...)
+}
+
+func ParseWithdrawAddressKey(key []byte) sdk.AccAddress {
+	offset := len(WithdrawAddressKey)
+	delAddrLen := int(key[offset])
+	offset += 1
+	return sdk.AccAddress(key[offset : offset+delAddrLen])
+}
+
+func GetFallbackValidatorKey(delAddr sdk.AccAddress) []byte {
+	return append(FallbackValidatorKey, address.MustLengthPrefix(delAddr)...)
+}
+
+func ParseFallbackValidatorKey(key []byte) sdk.AccAddress {
+	offset := len(FallbackValidatorKey)
+	delAddrLen := int(key[offset])
+	offset += 1
+	return sdk.AccAddress(key[offset : offset+delAddrLen])
+}
+
 func GetRedelegationsKeyByDelegator(delAddr sdk.AccAddress) []byte {
 	return append(RedelegationKey, address.MustLengthPrefix(delAddr)...)
 }
@@ -173,6 +288,61 @@ func ParseUnbondingIndexKeyToUndelegationKey(key []byte) ([]byte, time.Time, err
 	return newKey, completionTime, err
 }
 
+// ParseUnbondingIndexKeyDenom returns the denom of an undelegation index key in the format of
+// UndelegationByValidatorIndexKey|validator|timestamp|denom|delegator
+func ParseUnbondingIndexKeyDenom(key []byte) string {
+	offset := 0
+	offset += len(UndelegationByValidatorIndexKey)
+	offset += int(key[offset]) + 1
+	offset += int(key[offset]) + 1
+	denomLen := int(key[offset])
+	offset += 1
+	// Drop the null byte terminator added by CreateDenomAddressPrefix
+	return string(key[offset : offset+denomLen-1])
+}
+
+// GetUndelegationsByDelegatorIndexKey key is in the format of UndelegationByDelegatorIndexKey|delegator
+func GetUndelegationsByDelegatorIndexKey(delAddr sdk.AccAddress) []byte {
+	return append(UndelegationByDelegatorIndexKey, address.MustLengthPrefix(delAddr)...)
+}
+
+// GetUndelegationByDelegatorIndexKey key is in the format of UndelegationByDelegatorIndexKey|delegator|timestamp
+// and points to the queued undelegations of the delegator at the completion time
+func GetUndelegationByDelegatorIndexKey(delAddr sdk.AccAddress, completion time.Time) []byte {
+	key := GetUndelegationsByDelegatorIndexKey(delAddr)
+	return append(key, address.MustLengthPrefix(sdk.FormatTimeBytes(completion))...)
+}
+
+// GetUndelegationsByDenomIndexKey key is in the format of UndelegationByDenomIndexKey|denom
+func GetUndelegationsByDenomIndexKey(denom string) []byte {
+	return append(UndelegationByDenomIndexKey, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+// GetUndelegationByDenomIndexKey key is in the format of UndelegationByDenomIndexKey|denom|timestamp|delegator
+// and points to the queued undelegations of the delegator at the completion time
+func GetUndelegationByDenomIndexKey(denom string, completion time.Time, delAddr sdk.AccAddress) []byte {
+	key := GetUndelegationsByDenomIndexKey(denom)
+	key = append(key, address.MustLengthPrefix(sdk.FormatTimeBytes(completion))...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
+
+// ParseUndelegationIndexTimeAndDelegator parses the timestamp|delegator suffix of an undelegation index key into the
+// undelegation queue key. The delegator is missing from the delegator index, in that case delAddr is used
+func ParseUndelegationIndexTimeAndDelegator(suffix []byte, delAddr sdk.AccAddress) ([]byte, time.Time, error) {
+	timeLen := int(suffix[0])
+	timeBytes := suffix[1 : 1+timeLen]
+	completionTime, err := sdk.ParseTimeBytes(timeBytes)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	offset := 1 + timeLen
+	if offset < len(suffix) {
+		delAddrLen := int(suffix[offset])
+		delAddr = suffix[offset+1 : offset+1+delAddrLen]
+	}
+	return GetUndelegationQueueKey(completionTime, delAddr), completionTime, nil
+}
+
 func ParseRedelegationQueueKey(key []byte) time.Time {
 	offset := 0
 	offset += len(RedelegationQueueKey)
@@ -234,6 +404,48 @@ func GetPetrichorValidatorInfoKey(valAddr sdk.ValAddress) []byte {
 	return append(ValidatorInfoKey, address.MustLengthPrefix(valAddr)...)
 }
 
+func GetArchivedValidatorInfoKey(valAddr sdk.ValAddress) []byte {
+	return append(ArchivedValidatorInfoKey, address.MustLengthPrefix(valAddr)...)
+}
+
+func GetValidatorOptOutQueueKey(valAddr sdk.ValAddress) []byte {
+	return append(ValidatorOptOutQueueKey, address.MustLengthPrefix(valAddr)...)
+}
+
+func GetTombstonedValidatorQueueKey(valAddr sdk.ValAddress) []byte {
+	return append(TombstonedValidatorQueueKey, address.MustLengthPrefix(valAddr)...)
+}
+
+func GetDelistingAssetQueueKey(denom string) []byte {
+	return append(DelistingAssetQueueKey, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+func ParseDelistingAssetQueueKey(key []byte) string {
+	denomLen := int(key[1])
+	// Drop the null byte terminator added by CreateDenomAddressPrefix
+	return string(key[2 : 2+denomLen-1])
+}
+
+// GetWindDownProgress encodes the value of a wind down queue entry. passes counts the finished passes over the
+// delegations of the entry that had failures, failed is set once a delegation fails in the current pass and cursor
+// is the index key the current pass continues from
+func GetWindDownProgress(passes uint8, failed bool, cursor []byte) []byte {
+	var failedByte byte
+	if failed {
+		failedByte = 1
+	}
+	return append([]byte{passes, failedByte}, cursor...)
+}
+
+// ParseWindDownProgress decodes the value of a wind down queue entry. An empty value is an entry that was not
+// processed yet
+func ParseWindDownProgress(b []byte) (passes uint8, failed bool, cursor []byte) {
+	if len(b) < 2 {
+		return 0, false, nil
+	}
+	return b[0], b[1] == 1, b[2:]
+}
+
 func ParsePetrichorValidatorKey(key []byte) sdk.ValAddress {
 	b := key[2:]
 	return b
@@ -285,3 +497,134 @@ func ParseRewardWeightDecayQueueKeyForDenom(key []byte) (triggerTime time.Time,
 	offset += 1
 	return triggerTime, string(key[offset : offset+denomLen-1])
 }
+
+func GetLiquidReceiptKey(receiptDenom string) []byte {
+	return append(LiquidReceiptKey, address.MustLengthPrefix([]byte(receiptDenom))...)
+}
+
+// GetLiquidReceiptHolderKey key is in the format of receipt_denom|holder
+func GetLiquidReceiptHolderKey(receiptDenom string, holder sdk.AccAddress) []byte {
+	key := append(LiquidReceiptHolderKey, address.MustLengthPrefix([]byte(receiptDenom))...)
+	return append(key, address.MustLengthPrefix(holder)...)
+}
+
+// GetLiquidReceiptDenom deterministically derives the receipt denom for an asset and validator pair.
+// The pair is hashed since validator addresses and ibc denoms together can exceed the max denom length
+func GetLiquidReceiptDenom(valAddr sdk.ValAddress, denom string) string {
+	hash := sha256.Sum256(append(address.MustLengthPrefix(valAddr), CreateDenomAddressPrefix(denom)...))
+	return LiquidReceiptDenomPrefix + hex.EncodeToString(hash[:])
+}
+
+// GetRedelegationsByDenomIndexKey key is in the format of RedelegationByDenomIndexKey|denom
+func GetRedelegationsByDenomIndexKey(denom string) []byte {
+	return append(RedelegationByDenomIndexKey, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+// GetRedelegationByDenomIndexKey key is in the format of RedelegationByDenomIndexKey|denom|delegator|destination|timestamp
+// and points to the redelegation of the delegator to the destination validator at the completion time
+func GetRedelegationByDenomIndexKey(denom string, delAddr sdk.AccAddress, dstVal sdk.ValAddress, completion time.Time) []byte {
+	key := GetRedelegationsByDenomIndexKey(denom)
+	key = append(key, address.MustLengthPrefix(delAddr)...)
+	key = append(key, address.MustLengthPrefix(dstVal)...)
+	return append(key, sdk.FormatTimeBytes(completion)...)
+}
+
+// ParseRedelegationByDenomIndexSuffix parses the delegator|destination|timestamp suffix of a redelegation denom index
+// key into the redelegation key
+func ParseRedelegationByDenomIndexSuffix(denom string, suffix []byte) ([]byte, time.Time, error) {
+	offset := 0
+	delAddrLen := int(suffix[offset])
+	offset += 1
+	delAddr := sdk.AccAddress(suffix[offset : offset+delAddrLen])
+	offset += delAddrLen
+
+	dstValAddrLen := int(suffix[offset])
+	offset += 1
+	dstValAddr := sdk.ValAddress(suffix[offset : offset+dstValAddrLen])
+	offset += dstValAddrLen
+
+	timeBytes := suffix[offset:]
+	completionTime, err := sdk.ParseTimeBytes(timeBytes)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return append(GetRedelegationsKey(delAddr, denom, dstValAddr), timeBytes...), completionTime, nil
+}
+
+// GetDelegationsByValidatorIndexKey key is in the format of DelegationByValidatorIndexKey|validator
+func GetDelegationsByValidatorIndexKey(valAddr sdk.ValAddress) []byte {
+	return append(DelegationByValidatorIndexKey, address.MustLengthPrefix(valAddr)...)
+}
+
+// GetDelegationsByValidatorAndDenomIndexKey key is in the format of DelegationByValidatorIndexKey|validator|denom
+func GetDelegationsByValidatorAndDenomIndexKey(valAddr sdk.ValAddress, denom string) []byte {
+	return append(GetDelegationsByValidatorIndexKey(valAddr), address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+// GetDelegationByValidatorIndexKey key is in the format of DelegationByValidatorIndexKey|validator|denom|delegator
+// and points to the delegation of the delegator to the validator in the denom
+func GetDelegationByValidatorIndexKey(valAddr sdk.ValAddress, denom string, delAddr sdk.AccAddress) []byte {
+	return append(GetDelegationsByValidatorAndDenomIndexKey(valAddr, denom), address.MustLengthPrefix(delAddr)...)
+}
+
+// GetDelegationsByDenomIndexKey key is in the format of DelegationByDenomIndexKey|denom
+func GetDelegationsByDenomIndexKey(denom string) []byte {
+	return append(DelegationByDenomIndexKey, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+// GetDelegationByDenomIndexKey key is in the format of DelegationByDenomIndexKey|denom|validator|delegator and points
+// to the delegation of the delegator to the validator in the denom
+func GetDelegationByDenomIndexKey(denom string, valAddr sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	key := append(GetDelegationsByDenomIndexKey(denom), address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
+
+// ParseDelegationByValidatorIndexSuffix parses the denom|delegator suffix of a delegation validator index key
+func ParseDelegationByValidatorIndexSuffix(suffix []byte) (denom string, delAddr sdk.AccAddress) {
+	offset := 0
+	denomLen := int(suffix[offset])
+	offset += 1
+	// Drop the null byte terminator added by CreateDenomAddressPrefix
+	denom = string(suffix[offset : offset+denomLen-1])
+	offset += denomLen
+
+	delAddrLen := int(suffix[offset])
+	offset += 1
+	delAddr = sdk.AccAddress(suffix[offset : offset+delAddrLen])
+	return denom, delAddr
+}
+
+// GetSlashRecordsByDelegationIndexKey key is in the format of SlashRecordByDelegationIndexKey|validator|denom
+func GetSlashRecordsByDelegationIndexKey(valAddr sdk.ValAddress, denom string) []byte {
+	key := append(SlashRecordByDelegationIndexKey, address.MustLengthPrefix(valAddr)...)
+	return append(key, address.MustLengthPrefix(CreateDenomAddressPrefix(denom))...)
+}
+
+// GetSlashRecordByDelegationIndexKey key is in the format of
+// SlashRecordByDelegationIndexKey|validator|denom|height|slashed validator|delegator and points to the slash record of
+// the slashed validator at the height. The delegator is empty when the slash affected every delegation to the
+// validator in the denom, and set when it only hit an immature redelegation of the delegator into the validator
+func GetSlashRecordByDelegationIndexKey(valAddr sdk.ValAddress, denom string, height uint64, slashedVal sdk.ValAddress, delAddr sdk.AccAddress) []byte {
+	key := append(GetSlashRecordsByDelegationIndexKey(valAddr, denom), sdk.Uint64ToBigEndian(height)...)
+	key = append(key, address.MustLengthPrefix(slashedVal)...)
+	return append(key, address.MustLengthPrefix(delAddr)...)
+}
+
+// ParseSlashRecordByDelegationIndexSuffix parses the height|slashed validator|delegator suffix of a slash record
+// delegation index key
+func ParseSlashRecordByDelegationIndexSuffix(suffix []byte) (height uint64, slashedVal sdk.ValAddress, delAddr sdk.AccAddress) {
+	offset := 0
+	height = sdk.BigEndianToUint64(suffix[offset : offset+8])
+	offset += 8
+
+	slashedValLen := int(suffix[offset])
+	offset += 1
+	slashedVal = sdk.ValAddress(suffix[offset : offset+slashedValLen])
+	offset += slashedValLen
+
+	delAddr = sdk.AccAddress(suffix[offset:])
+	if len(delAddr) > 0 {
+		delAddr = delAddr[1:]
+	}
+	return height, slashedVal, delAddr
+}
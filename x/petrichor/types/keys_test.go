@@ -57,6 +57,7 @@ func TestUndelegationIndex(t *testing.T) {
 	require.Equal(t, parsedTime, completion)
 	delKey := types.GetUndelegationQueueKey(completion, delAddr)
 	require.Equal(t, delKey, parsedUndelKey)
+	require.Equal(t, denom, types.ParseUnbondingIndexKeyDenom(indexKey))
 }
 
 func TestRewardWeightDecayQueueKey(t *testing.T) {
@@ -87,4 +88,39 @@ func TestValidatorKey(t *testing.T) {
 
 	parseValAddr := types.ParsePetrichorValidatorKey(key)
 	require.Equal(t, parseValAddr, valAddr)
-}
\ No newline at end of file
+}
+func TestAutoCompoundKey(t *testing.T) {
+	delAddr, err := sdk.AccAddressFromHexUnsafe("aa")
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromHex("bb")
+	require.NoError(t, err)
+	key := types.GetAutoCompoundKey(delAddr, valAddr, "denom")
+
+	parsedDelAddr, parsedValAddr, parsedDenom := types.ParseAutoCompoundKey(key)
+	require.Equal(t, delAddr, parsedDelAddr)
+	require.Equal(t, valAddr, parsedValAddr)
+	require.Equal(t, "denom", parsedDenom)
+}
+
+func TestSlashRecordByDelegationIndex(t *testing.T) {
+	delAddr, err := sdk.AccAddressFromHexUnsafe("aa")
+	require.NoError(t, err)
+	valAddr, err := sdk.ValAddressFromHex("bb")
+	require.NoError(t, err)
+	slashedValAddr, err := sdk.ValAddressFromHex("cc")
+	require.NoError(t, err)
+	prefixLen := len(types.GetSlashRecordsByDelegationIndexKey(valAddr, "denom"))
+
+	key := types.GetSlashRecordByDelegationIndexKey(valAddr, "denom", 10, slashedValAddr, delAddr)
+	height, parsedSlashedVal, parsedDelAddr := types.ParseSlashRecordByDelegationIndexSuffix(key[prefixLen:])
+	require.Equal(t, uint64(10), height)
+	require.Equal(t, slashedValAddr, parsedSlashedVal)
+	require.Equal(t, delAddr, parsedDelAddr)
+
+	// Slashes of the validator itself are not specific to a delegator
+	key = types.GetSlashRecordByDelegationIndexKey(valAddr, "denom", 10, valAddr, nil)
+	height, parsedSlashedVal, parsedDelAddr = types.ParseSlashRecordByDelegationIndexSuffix(key[prefixLen:])
+	require.Equal(t, uint64(10), height)
+	require.Equal(t, valAddr, parsedSlashedVal)
+	require.Empty(t, parsedDelAddr)
+}
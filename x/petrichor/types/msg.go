@@ -1,7 +1,10 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -11,6 +14,24 @@ var (
 	_ sdk.Msg = &MsgRedelegate{}
 	_ sdk.Msg = &MsgUndelegate{}
 	_ sdk.Msg = &MsgClaimDelegationRewards{}
+	_ sdk.Msg = &MsgLiquidDelegate{}
+	_ sdk.Msg = &MsgRedeemLiquidReceipt{}
+	_ sdk.Msg = &MsgClaimLiquidReceiptRewards{}
+	_ sdk.Msg = &MsgSetAutoCompound{}
+	_ sdk.Msg = &MsgSetRewardWithdrawAddress{}
+	_ sdk.Msg = &MsgClaimAllDelegationRewards{}
+	_ sdk.Msg = &MsgCancelUndelegation{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgCreatePetrichor{}
+	_ sdk.Msg = &MsgUpdatePetrichor{}
+	_ sdk.Msg = &MsgDeletePetrichor{}
+	_ sdk.Msg = &MsgBatchUpdatePetrichors{}
+	_ sdk.Msg = &MsgDelistPetrichor{}
+	_ sdk.Msg = &MsgSweepDustDelegations{}
+	_ sdk.Msg = &MsgSetValidatorDenomFilter{}
+	_ sdk.Msg = &MsgSetPetrichorCommission{}
+	_ sdk.Msg = &MsgWithdrawPetrichorCommission{}
+	_ sdk.Msg = &MsgSetFallbackValidator{}
 )
 
 var (
@@ -18,6 +39,24 @@ var (
 	MsgUndelegateType             = "msg_undelegate"
 	MsgRedelegateType             = "msg_redelegate"
 	MsgClaimDelegationRewardsType = "claim_delegation_rewards"
+	MsgLiquidDelegateType         = "msg_liquid_delegate"
+	MsgRedeemLiquidReceiptType    = "msg_redeem_liquid_receipt"
+	MsgClaimReceiptRewardsType    = "claim_liquid_receipt_rewards"
+	MsgSetAutoCompoundType        = "msg_set_auto_compound"
+	MsgSetWithdrawAddressType     = "msg_set_reward_withdraw_address"
+	MsgClaimAllRewardsType        = "claim_all_delegation_rewards"
+	MsgCancelUndelegationType     = "msg_cancel_undelegation"
+	MsgUpdateParamsType           = "msg_update_params"
+	MsgCreatePetrichorType        = "msg_create_petrichor"
+	MsgUpdatePetrichorType        = "msg_update_petrichor"
+	MsgDeletePetrichorType        = "msg_delete_petrichor"
+	MsgBatchUpdatePetrichorsType  = "msg_batch_update_petrichors"
+	MsgDelistPetrichorType        = "msg_delist_petrichor"
+	MsgSweepDustDelegationsType   = "msg_sweep_dust_delegations"
+	MsgSetDenomFilterType         = "msg_set_validator_denom_filter"
+	MsgSetCommissionType          = "msg_set_petrichor_commission"
+	MsgWithdrawCommissionType     = "msg_withdraw_petrichor_commission"
+	MsgSetFallbackValidatorType   = "msg_set_fallback_validator"
 )
 
 func (m MsgDelegate) ValidateBasic() error {
@@ -87,3 +126,385 @@ func (m *MsgClaimDelegationRewards) GetSigners() []sdk.AccAddress {
 }
 
 func (msg MsgClaimDelegationRewards) Type() string { return MsgClaimDelegationRewardsType }
+
+func (m *MsgLiquidDelegate) ValidateBasic() error {
+	if !m.Amount.Amount.GT(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor liquid delegation amount must be more than zero")
+	}
+	return nil
+}
+
+func (m *MsgLiquidDelegate) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgLiquidDelegate is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgLiquidDelegate) Type() string { return MsgLiquidDelegateType }
+
+func (m *MsgRedeemLiquidReceipt) ValidateBasic() error {
+	if !m.Amount.Amount.GT(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor receipt amount must be more than zero")
+	}
+	if !strings.HasPrefix(m.Amount.Denom, LiquidReceiptDenomPrefix) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor receipt denom must start with %s", LiquidReceiptDenomPrefix)
+	}
+	return nil
+}
+
+func (m *MsgRedeemLiquidReceipt) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.HolderAddress)
+	if err != nil {
+		panic("HolderAddress signer from MsgRedeemLiquidReceipt is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgRedeemLiquidReceipt) Type() string { return MsgRedeemLiquidReceiptType }
+
+func (m *MsgClaimLiquidReceiptRewards) ValidateBasic() error {
+	if !strings.HasPrefix(m.ReceiptDenom, LiquidReceiptDenomPrefix) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor receipt denom must start with %s", LiquidReceiptDenomPrefix)
+	}
+	return nil
+}
+
+func (m *MsgClaimLiquidReceiptRewards) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.HolderAddress)
+	if err != nil {
+		panic("HolderAddress signer from MsgClaimLiquidReceiptRewards is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgClaimLiquidReceiptRewards) Type() string { return MsgClaimReceiptRewardsType }
+
+func (m *MsgSetAutoCompound) ValidateBasic() error {
+	if m.Denom == "" {
+		return status.Errorf(codes.InvalidArgument, "Petrichor denom must not be empty")
+	}
+	return nil
+}
+
+func (m *MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgSetAutoCompound is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSetAutoCompound) Type() string { return MsgSetAutoCompoundType }
+
+func (m *MsgSetRewardWithdrawAddress) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor withdraw address is not valid: %s", err)
+	}
+	return nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgSetRewardWithdrawAddress is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSetRewardWithdrawAddress) Type() string { return MsgSetWithdrawAddressType }
+
+func (m *MsgSetFallbackValidator) ValidateBasic() error {
+	if m.ValidatorAddress == "" {
+		return nil
+	}
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor validator address is not valid: %s", err)
+	}
+	return nil
+}
+
+func (m *MsgSetFallbackValidator) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgSetFallbackValidator is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSetFallbackValidator) Type() string { return MsgSetFallbackValidatorType }
+
+func (m *MsgClaimAllDelegationRewards) ValidateBasic() error {
+	if m.ValidatorAddress != "" {
+		if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Petrichor validator address is not valid: %s", err)
+		}
+	}
+	if m.Limit > MaxClaimAllDelegations {
+		return status.Errorf(codes.InvalidArgument, "Petrichor claim limit must not be more than %d", MaxClaimAllDelegations)
+	}
+	return nil
+}
+
+func (m *MsgClaimAllDelegationRewards) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgClaimAllDelegationRewards is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgClaimAllDelegationRewards) Type() string { return MsgClaimAllRewardsType }
+
+func (m *MsgCancelUndelegation) ValidateBasic() error {
+	if !m.Amount.Amount.GT(sdk.ZeroInt()) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor cancel undelegation amount must be more than zero")
+	}
+	return nil
+}
+
+func (m *MsgCancelUndelegation) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic("DelegatorAddress signer from MsgCancelUndelegation is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgCancelUndelegation) Type() string { return MsgCancelUndelegationType }
+
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid authority address: %s", err)
+	}
+	if err := m.Params.Validate(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid petrichor params: %s", err)
+	}
+	return nil
+}
+
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic("Authority signer from MsgUpdateParams is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgUpdateParams) Type() string { return MsgUpdateParamsType }
+
+func (m *MsgCreatePetrichor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid authority address: %s", err)
+	}
+	return ValidatePetrichorAssetFields(m.AssetConfig())
+}
+
+// AssetConfig returns the asset fields set by the message
+func (m *MsgCreatePetrichor) AssetConfig() PetrichorAssetConfig {
+	return newAssetConfig(m)
+}
+
+func (m *MsgCreatePetrichor) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic("Authority signer from MsgCreatePetrichor is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgCreatePetrichor) Type() string { return MsgCreatePetrichorType }
+
+func (m *MsgUpdatePetrichor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid authority address: %s", err)
+	}
+	return ValidatePetrichorAssetFields(m.AssetConfig())
+}
+
+// AssetConfig returns the asset fields set by the message
+func (m *MsgUpdatePetrichor) AssetConfig() PetrichorAssetConfig {
+	return newAssetConfig(m)
+}
+
+func (m *MsgUpdatePetrichor) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic("Authority signer from MsgUpdatePetrichor is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgUpdatePetrichor) Type() string { return MsgUpdatePetrichorType }
+
+func (m *MsgDeletePetrichor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid authority address: %s", err)
+	}
+	if m.Denom == "" {
+		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
+	}
+	return nil
+}
+
+func (m *MsgDeletePetrichor) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic("Authority signer from MsgDeletePetrichor is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgDeletePetrichor) Type() string { return MsgDeletePetrichorType }
+
+func (m *MsgBatchUpdatePetrichors) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid authority address: %s", err)
+	}
+	if len(m.CreateAssets)+len(m.UpdateAssets)+len(m.DeleteDenoms) == 0 {
+		return status.Errorf(codes.InvalidArgument, "Petrichor batch update must contain at least one asset")
+	}
+
+	// Each denom can only appear once across the whole batch
+	denoms := make(map[string]bool)
+	for _, asset := range append(append([]PetrichorAssetConfig{}, m.CreateAssets...), m.UpdateAssets...) {
+		if err := ValidatePetrichorAssetFields(asset); err != nil {
+			return err
+		}
+		if denoms[asset.Denom] {
+			return status.Errorf(codes.InvalidArgument, "Petrichor denom %s is duplicated in the batch", asset.Denom)
+		}
+		denoms[asset.Denom] = true
+	}
+	for _, denom := range m.DeleteDenoms {
+		if denom == "" {
+			return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
+		}
+		if denoms[denom] {
+			return status.Errorf(codes.InvalidArgument, "Petrichor denom %s is duplicated in the batch", denom)
+		}
+		denoms[denom] = true
+	}
+	return nil
+}
+
+func (m *MsgBatchUpdatePetrichors) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic("Authority signer from MsgBatchUpdatePetrichors is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgBatchUpdatePetrichors) Type() string { return MsgBatchUpdatePetrichorsType }
+
+func (m *MsgDelistPetrichor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid authority address: %s", err)
+	}
+	if m.Denom == "" {
+		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
+	}
+	return nil
+}
+
+func (m *MsgDelistPetrichor) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic("Authority signer from MsgDelistPetrichor is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgDelistPetrichor) Type() string { return MsgDelistPetrichorType }
+
+func (m *MsgSweepDustDelegations) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid authority address: %s", err)
+	}
+	if m.Denom == "" {
+		return status.Errorf(codes.InvalidArgument, "Petrichor denom must have a value")
+	}
+	return nil
+}
+
+func (m *MsgSweepDustDelegations) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic("Authority signer from MsgSweepDustDelegations is not valid")
+	}
+	return []sdk.AccAddress{signer}
+}
+
+func (msg MsgSweepDustDelegations) Type() string { return MsgSweepDustDelegationsType }
+
+func (m *MsgSetValidatorDenomFilter) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor validator address is not valid: %s", err)
+	}
+	if err := ValidateDenomFilter(m.Mode, m.Denoms, m.OptOutPolicy); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor denom filter is invalid: %s", err)
+	}
+	return nil
+}
+
+// GetSigners returns the account of the validator operator
+func (m *MsgSetValidatorDenomFilter) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		panic("ValidatorAddress signer from MsgSetValidatorDenomFilter is not valid")
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg MsgSetValidatorDenomFilter) Type() string { return MsgSetDenomFilterType }
+
+func (m *MsgSetPetrichorCommission) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor validator address is not valid: %s", err)
+	}
+	if m.Rate.IsNil() || m.Rate.IsNegative() || m.Rate.GT(sdk.OneDec()) {
+		return status.Errorf(codes.InvalidArgument, "Petrichor commission rate must be between 0 and 1")
+	}
+	hasMaxRate := m.MaxRate != nil && !m.MaxRate.IsNil()
+	hasMaxChangeRate := m.MaxChangeRate != nil && !m.MaxChangeRate.IsNil()
+	if hasMaxRate != hasMaxChangeRate {
+		return status.Errorf(codes.InvalidArgument, "Petrichor commission max rate and max change rate must be set together")
+	}
+	if hasMaxRate {
+		if err := stakingtypes.NewCommissionRates(m.Rate, *m.MaxRate, *m.MaxChangeRate).Validate(); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Petrichor commission is invalid: %s", err)
+		}
+	}
+	return nil
+}
+
+// GetSigners returns the account of the validator operator
+func (m *MsgSetPetrichorCommission) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		panic("ValidatorAddress signer from MsgSetPetrichorCommission is not valid")
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg MsgSetPetrichorCommission) Type() string { return MsgSetCommissionType }
+
+func (m *MsgWithdrawPetrichorCommission) ValidateBasic() error {
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Petrichor validator address is not valid: %s", err)
+	}
+	return nil
+}
+
+// GetSigners returns the account of the validator operator
+func (m *MsgWithdrawPetrichorCommission) GetSigners() []sdk.AccAddress {
+	valAddr, err := sdk.ValAddressFromBech32(m.ValidatorAddress)
+	if err != nil {
+		panic("ValidatorAddress signer from MsgWithdrawPetrichorCommission is not valid")
+	}
+	return []sdk.AccAddress{sdk.AccAddress(valAddr)}
+}
+
+func (msg MsgWithdrawPetrichorCommission) Type() string { return MsgWithdrawCommissionType }
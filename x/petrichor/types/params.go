@@ -6,6 +6,7 @@ import (
 
 	"golang.org/x/exp/slices"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,6 +14,13 @@ var (
 	RewardDelayTime       = []byte("RewardDelayTime")
 	TakeRateClaimInterval = []byte("TakeRateClaimInterval")
 	LastTakeRateClaimTime = []byte("LastTakeRateClaimTime")
+	AutoCompoundInterval  = []byte("AutoCompoundInterval")
+	LastAutoCompoundTime  = []byte("LastAutoCompoundTime")
+	TakeRateSplits        = []byte("TakeRateSplits")
+
+	AutoRedelegateTombstoned = []byte("AutoRedelegateTombstoned")
+	MaxTotalRewardWeight     = []byte("MaxTotalRewardWeight")
+	TakeRateHistoryRetention = []byte("TakeRateHistoryRetention")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -20,11 +28,37 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(RewardDelayTime, &p.RewardDelayTime, validatePositiveDuration),
-		paramtypes.NewParamSetPair(TakeRateClaimInterval, &p.TakeRateClaimInterval, validatePositiveDuration),
+		paramtypes.NewParamSetPair(TakeRateClaimInterval, &p.TakeRateClaimInterval, validateInterval),
 		paramtypes.NewParamSetPair(LastTakeRateClaimTime, &p.LastTakeRateClaimTime, validateTime),
+		paramtypes.NewParamSetPair(AutoCompoundInterval, &p.AutoCompoundInterval, validateInterval),
+		paramtypes.NewParamSetPair(LastAutoCompoundTime, &p.LastAutoCompoundTime, validateTime),
+		paramtypes.NewParamSetPair(TakeRateSplits, &p.TakeRateSplits, validateTakeRateSplits),
+		paramtypes.NewParamSetPair(AutoRedelegateTombstoned, &p.AutoRedelegateTombstoned, validateBool),
+		paramtypes.NewParamSetPair(MaxTotalRewardWeight, &p.MaxTotalRewardWeight, validateMaxTotalRewardWeight),
+		paramtypes.NewParamSetPair(TakeRateHistoryRetention, &p.TakeRateHistoryRetention, validateInterval),
 	}
 }
 
+// Validate checks the params for stateless errors
+func (p Params) Validate() error {
+	if err := validatePositiveDuration(p.RewardDelayTime); err != nil {
+		return err
+	}
+	if err := validateInterval(p.AutoCompoundInterval); err != nil {
+		return fmt.Errorf("auto_compound_interval: %w", err)
+	}
+	if err := validateInterval(p.TakeRateClaimInterval); err != nil {
+		return fmt.Errorf("take_rate_claim_interval: %w", err)
+	}
+	if err := validateInterval(p.TakeRateHistoryRetention); err != nil {
+		return fmt.Errorf("take_rate_history_retention: %w", err)
+	}
+	if err := validateMaxTotalRewardWeight(p.MaxTotalRewardWeight); err != nil {
+		return fmt.Errorf("max_total_reward_weight: %w", err)
+	}
+	return ValidateTakeRateSplits(p.TakeRateSplits)
+}
+
 func validatePositiveDuration(i interface{}) error {
 	v, ok := i.(time.Duration)
 	if !ok {
@@ -36,6 +70,44 @@ func validatePositiveDuration(i interface{}) error {
 	return nil
 }
 
+func validateInterval(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("interval has to be more than 0: %d", v)
+	}
+	return nil
+}
+
+func validateTakeRateSplits(i interface{}) error {
+	v, ok := i.([]TakeRateSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return ValidateTakeRateSplits(v)
+}
+
+func validateMaxTotalRewardWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("max total reward weight cannot be negative: %s", v)
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func validateTime(i interface{}) error {
 	_, ok := i.(time.Time)
 	if !ok {
@@ -47,9 +119,13 @@ func validateTime(i interface{}) error {
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{
-		RewardDelayTime:       time.Hour,
-		TakeRateClaimInterval: time.Minute * 5,
-		LastTakeRateClaimTime: time.Now(),
+		RewardDelayTime:          time.Hour,
+		TakeRateClaimInterval:    time.Minute * 5,
+		LastTakeRateClaimTime:    time.Now(),
+		AutoCompoundInterval:     time.Hour * 6,
+		LastAutoCompoundTime:     time.Now(),
+		MaxTotalRewardWeight:     sdk.NewDec(100),
+		TakeRateHistoryRetention: time.Hour * 24 * 30,
 	}
 }
 
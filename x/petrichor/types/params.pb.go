@@ -30,12 +30,67 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TakeRateDestination defines where take rate proceeds can be sent
+type TakeRateDestination int32
+
+const (
+	// Distributed to stakers through the fee collector
+	TakeRateDestinationFeeCollector TakeRateDestination = 0
+	// Sent to the community pool
+	TakeRateDestinationCommunityPool TakeRateDestination = 1
+	// Sent to the buyback module account
+	TakeRateDestinationBuyback TakeRateDestination = 2
+	// Burned
+	TakeRateDestinationBurn TakeRateDestination = 3
+	// Sent to the insurance fund that covers slashed petrichor undelegations
+	TakeRateDestinationInsuranceFund TakeRateDestination = 4
+)
+
+var TakeRateDestination_name = map[int32]string{
+	0: "TAKE_RATE_DESTINATION_FEE_COLLECTOR",
+	1: "TAKE_RATE_DESTINATION_COMMUNITY_POOL",
+	2: "TAKE_RATE_DESTINATION_BUYBACK",
+	3: "TAKE_RATE_DESTINATION_BURN",
+	4: "TAKE_RATE_DESTINATION_INSURANCE_FUND",
+}
+
+var TakeRateDestination_value = map[string]int32{
+	"TAKE_RATE_DESTINATION_FEE_COLLECTOR":  0,
+	"TAKE_RATE_DESTINATION_COMMUNITY_POOL": 1,
+	"TAKE_RATE_DESTINATION_BUYBACK":        2,
+	"TAKE_RATE_DESTINATION_BURN":           3,
+	"TAKE_RATE_DESTINATION_INSURANCE_FUND": 4,
+}
+
+func (x TakeRateDestination) String() string {
+	return proto.EnumName(TakeRateDestination_name, int32(x))
+}
+
+func (TakeRateDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0fa5f2cbb7020d65, []int{0}
+}
+
 type Params struct {
 	RewardDelayTime time.Duration `protobuf:"bytes,1,opt,name=reward_delay_time,json=rewardDelayTime,proto3,stdduration" json:"reward_delay_time"`
 	// Time interval between consecutive applications of `take_rate`
 	TakeRateClaimInterval time.Duration `protobuf:"bytes,2,opt,name=take_rate_claim_interval,json=takeRateClaimInterval,proto3,stdduration" json:"take_rate_claim_interval"`
 	// Last application of `take_rate` on assets
 	LastTakeRateClaimTime time.Time `protobuf:"bytes,3,opt,name=last_take_rate_claim_time,json=lastTakeRateClaimTime,proto3,stdtime" json:"last_take_rate_claim_time"`
+	// Time interval between consecutive auto-compounding of delegation rewards
+	AutoCompoundInterval time.Duration `protobuf:"bytes,4,opt,name=auto_compound_interval,json=autoCompoundInterval,proto3,stdduration" json:"auto_compound_interval"`
+	// Last time delegation rewards were auto-compounded
+	LastAutoCompoundTime time.Time `protobuf:"bytes,5,opt,name=last_auto_compound_time,json=lastAutoCompoundTime,proto3,stdtime" json:"last_auto_compound_time"`
+	// Split of the take rate proceeds of assets that do not define their own. Everything goes to the fee collector
+	// when empty
+	TakeRateSplits []TakeRateSplit `protobuf:"bytes,6,rep,name=take_rate_splits,json=takeRateSplits,proto3" json:"take_rate_splits"`
+	// Automatically redelegate petrichor delegations away from tombstoned validators, to the fallback validator of the
+	// delegator or spread across the active set
+	AutoRedelegateTombstoned bool `protobuf:"varint,7,opt,name=auto_redelegate_tombstoned,json=autoRedelegateTombstoned,proto3" json:"auto_redelegate_tombstoned,omitempty"`
+	// Cap on the sum of the reward weights of all petrichor assets, relative to the native stake which always has a
+	// weight of 1. There is no cap when zero
+	MaxTotalRewardWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_total_reward_weight,json=maxTotalRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_total_reward_weight"`
+	// How long the per-period take rate history is kept. Older records are pruned at the end of every block
+	TakeRateHistoryRetention time.Duration `protobuf:"bytes,9,opt,name=take_rate_history_retention,json=takeRateHistoryRetention,proto3,stdduration" json:"take_rate_history_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -92,6 +147,87 @@ func (m *Params) GetLastTakeRateClaimTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Params) GetAutoCompoundInterval() time.Duration {
+	if m != nil {
+		return m.AutoCompoundInterval
+	}
+	return 0
+}
+
+func (m *Params) GetLastAutoCompoundTime() time.Time {
+	if m != nil {
+		return m.LastAutoCompoundTime
+	}
+	return time.Time{}
+}
+
+func (m *Params) GetTakeRateSplits() []TakeRateSplit {
+	if m != nil {
+		return m.TakeRateSplits
+	}
+	return nil
+}
+
+func (m *Params) GetAutoRedelegateTombstoned() bool {
+	if m != nil {
+		return m.AutoRedelegateTombstoned
+	}
+	return false
+}
+
+func (m *Params) GetTakeRateHistoryRetention() time.Duration {
+	if m != nil {
+		return m.TakeRateHistoryRetention
+	}
+	return 0
+}
+
+// TakeRateSplit is the ratio of the take rate proceeds sent to a destination. Ratios of a split must sum to 1
+type TakeRateSplit struct {
+	Destination TakeRateDestination                    `protobuf:"varint,1,opt,name=destination,proto3,enum=petrichor.petrichor.TakeRateDestination" json:"destination,omitempty"`
+	Ratio       github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ratio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ratio"`
+}
+
+func (m *TakeRateSplit) Reset()         { *m = TakeRateSplit{} }
+func (m *TakeRateSplit) String() string { return proto.CompactTextString(m) }
+func (*TakeRateSplit) ProtoMessage()    {}
+func (*TakeRateSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fa5f2cbb7020d65, []int{1}
+}
+func (m *TakeRateSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakeRateSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakeRateSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakeRateSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakeRateSplit.Merge(m, src)
+}
+func (m *TakeRateSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakeRateSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakeRateSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakeRateSplit proto.InternalMessageInfo
+
+func (m *TakeRateSplit) GetDestination() TakeRateDestination {
+	if m != nil {
+		return m.Destination
+	}
+	return TakeRateDestinationFeeCollector
+}
+
 type RewardHistory struct {
 	Denom string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Index github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=index,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index"`
@@ -101,7 +237,7 @@ func (m *RewardHistory) Reset()         { *m = RewardHistory{} }
 func (m *RewardHistory) String() string { return proto.CompactTextString(m) }
 func (*RewardHistory) ProtoMessage()    {}
 func (*RewardHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0fa5f2cbb7020d65, []int{1}
+	return fileDescriptor_0fa5f2cbb7020d65, []int{2}
 }
 func (m *RewardHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -138,40 +274,68 @@ func (m *RewardHistory) GetDenom() string {
 }
 
 func init() {
+	proto.RegisterEnum("petrichor.petrichor.TakeRateDestination", TakeRateDestination_name, TakeRateDestination_value)
 	proto.RegisterType((*Params)(nil), "petrichor.petrichor.Params")
+	proto.RegisterType((*TakeRateSplit)(nil), "petrichor.petrichor.TakeRateSplit")
 	proto.RegisterType((*RewardHistory)(nil), "petrichor.petrichor.RewardHistory")
 }
 
 func init() { proto.RegisterFile("petrichor/params.proto", fileDescriptor_0fa5f2cbb7020d65) }
 
 var fileDescriptor_0fa5f2cbb7020d65 = []byte{
-	// 415 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0xaf, 0xd3, 0x30,
-	0x10, 0xc7, 0x93, 0x42, 0x2b, 0x6a, 0x84, 0x10, 0xa1, 0xa0, 0xb6, 0x43, 0x82, 0x3a, 0x20, 0x96,
-	0x26, 0x12, 0xdd, 0x10, 0x53, 0xc9, 0x00, 0x03, 0x02, 0x45, 0x9d, 0x10, 0x22, 0x72, 0x93, 0x23,
-	0xb5, 0x1a, 0xc7, 0x91, 0xed, 0xd2, 0x76, 0xe2, 0x2b, 0x74, 0x64, 0x41, 0xe2, 0x43, 0xf0, 0x21,
-	0x3a, 0x56, 0x4c, 0x88, 0xa1, 0x3c, 0xb5, 0xcb, 0xfb, 0x18, 0x4f, 0xb6, 0xd3, 0xbe, 0xaa, 0x6f,
-	0x79, 0x53, 0xee, 0xf2, 0x3f, 0xff, 0xfe, 0x77, 0xf6, 0xa1, 0xa7, 0x25, 0x48, 0x4e, 0x92, 0x09,
-	0xe3, 0x41, 0x89, 0x39, 0xa6, 0xc2, 0x2f, 0x39, 0x93, 0xcc, 0x79, 0x7c, 0xfc, 0xef, 0x1f, 0xa3,
-	0x6e, 0x2b, 0x63, 0x19, 0xd3, 0x7a, 0xa0, 0x22, 0x53, 0xda, 0xed, 0x24, 0x4c, 0x50, 0x26, 0x62,
-	0x23, 0x98, 0xa4, 0x92, 0xdc, 0x8c, 0xb1, 0x2c, 0x87, 0x40, 0x67, 0xe3, 0xd9, 0xd7, 0x20, 0x9d,
-	0x71, 0x2c, 0x09, 0x2b, 0x2a, 0xdd, 0x3b, 0xd7, 0x25, 0xa1, 0x20, 0x24, 0xa6, 0xa5, 0x29, 0xe8,
-	0xfd, 0xac, 0xa1, 0xc6, 0x47, 0xdd, 0x97, 0xf3, 0x01, 0x3d, 0xe2, 0x30, 0xc7, 0x3c, 0x8d, 0x53,
-	0xc8, 0xf1, 0x32, 0x56, 0xa5, 0x6d, 0xfb, 0x99, 0xfd, 0xe2, 0xfe, 0xcb, 0x8e, 0x6f, 0x38, 0xfe,
-	0x81, 0xe3, 0x87, 0x95, 0xcf, 0xf0, 0xde, 0x7a, 0xeb, 0x59, 0x3f, 0xfe, 0x7b, 0x76, 0xf4, 0xd0,
-	0x9c, 0x0e, 0xd5, 0xe1, 0x11, 0xa1, 0xe0, 0x7c, 0x46, 0x6d, 0x89, 0xa7, 0x10, 0x73, 0x2c, 0x21,
-	0x4e, 0x72, 0x4c, 0x68, 0x4c, 0x0a, 0x09, 0xfc, 0x1b, 0xce, 0xdb, 0xb5, 0xdb, 0x73, 0x9f, 0x28,
-	0x48, 0x84, 0x25, 0xbc, 0x51, 0x88, 0x77, 0x15, 0xc1, 0xf9, 0x82, 0x3a, 0x39, 0x16, 0x32, 0x3e,
-	0xb7, 0xd0, 0x6d, 0xdf, 0xd1, 0xf8, 0xee, 0x0d, 0xfc, 0xe8, 0x30, 0xbe, 0xe1, 0xaf, 0x34, 0x5f,
-	0x61, 0x46, 0xa7, 0x1e, 0xaa, 0xea, 0xd5, 0xdd, 0xcb, 0x5f, 0x9e, 0xdd, 0xfb, 0x8e, 0x1e, 0x44,
-	0x7a, 0xac, 0xb7, 0x44, 0x48, 0xc6, 0x97, 0x4e, 0x0b, 0xd5, 0x53, 0x28, 0x18, 0xd5, 0x37, 0xd3,
-	0x8c, 0x4c, 0xe2, 0x44, 0xa8, 0x4e, 0x8a, 0x14, 0x16, 0x7a, 0xae, 0xe6, 0xf0, 0xb5, 0x82, 0xff,
-	0xdb, 0x7a, 0xcf, 0x33, 0x22, 0x27, 0xb3, 0xb1, 0x9f, 0x30, 0x5a, 0xbd, 0x5b, 0xf5, 0xe9, 0x8b,
-	0x74, 0x1a, 0xc8, 0x65, 0x09, 0xc2, 0x0f, 0x21, 0xf9, 0xf3, 0xbb, 0x8f, 0xaa, 0x67, 0x0d, 0x21,
-	0x89, 0x0c, 0xca, 0x34, 0x30, 0x7c, 0xbf, 0xde, 0xb9, 0xf6, 0x66, 0xe7, 0xda, 0x17, 0x3b, 0xd7,
-	0x5e, 0xed, 0x5d, 0x6b, 0xb3, 0x77, 0xad, 0xbf, 0x7b, 0xd7, 0xfa, 0x34, 0x38, 0x81, 0xeb, 0x15,
-	0x2a, 0x40, 0xce, 0x19, 0x9f, 0x06, 0xd7, 0x1b, 0xb7, 0x38, 0x89, 0xb5, 0xdb, 0xb8, 0xa1, 0xaf,
-	0x62, 0x70, 0x15, 0x00, 0x00, 0xff, 0xff, 0xca, 0x7a, 0x74, 0x17, 0x97, 0x02, 0x00, 0x00,
+	// 820 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xc0, 0xe3, 0x36, 0x2d, 0xed, 0x54, 0xbb, 0x04, 0x6f, 0xd8, 0xba, 0x5e, 0xe1, 0x58, 0xdd,
+	0x15, 0x8a, 0x90, 0xd6, 0x91, 0xba, 0x37, 0xd8, 0x4b, 0xe2, 0xa4, 0x22, 0x6c, 0xeb, 0x54, 0x53,
+	0x57, 0xa8, 0x80, 0x18, 0x4d, 0xec, 0x21, 0xb5, 0x6a, 0x7b, 0xa2, 0xf1, 0x98, 0x26, 0x27, 0xae,
+	0x90, 0xd3, 0x1e, 0xb9, 0x44, 0x42, 0xe2, 0x2b, 0xc0, 0x9d, 0xe3, 0x1e, 0x57, 0x9c, 0x10, 0x87,
+	0x05, 0xb5, 0x17, 0x3e, 0x06, 0x9a, 0xb1, 0xf3, 0x67, 0x17, 0x17, 0x75, 0xa5, 0x9e, 0x32, 0x33,
+	0xef, 0xcd, 0x6f, 0x7e, 0xef, 0xcd, 0x58, 0x01, 0xf7, 0x87, 0x84, 0xb3, 0xc0, 0x3b, 0xa3, 0xac,
+	0x31, 0xc4, 0x0c, 0x47, 0x89, 0x35, 0x64, 0x94, 0x53, 0xf5, 0xde, 0x7c, 0xdd, 0x9a, 0x8f, 0xf4,
+	0xea, 0x80, 0x0e, 0xa8, 0x8c, 0x37, 0xc4, 0x28, 0x4b, 0xd5, 0x77, 0x3c, 0x9a, 0x44, 0x34, 0x41,
+	0x59, 0x20, 0x9b, 0xe4, 0x21, 0x63, 0x40, 0xe9, 0x20, 0x24, 0x0d, 0x39, 0xeb, 0xa7, 0xdf, 0x34,
+	0xfc, 0x94, 0x61, 0x1e, 0xd0, 0x38, 0x8f, 0xd7, 0xde, 0x8c, 0xf3, 0x20, 0x22, 0x09, 0xc7, 0xd1,
+	0x30, 0x4b, 0xd8, 0xfd, 0x6d, 0x1d, 0xac, 0x1f, 0x49, 0x2f, 0xb5, 0x07, 0xde, 0x63, 0xe4, 0x02,
+	0x33, 0x1f, 0xf9, 0x24, 0xc4, 0x63, 0x24, 0x52, 0x35, 0xc5, 0x54, 0xea, 0x5b, 0x7b, 0x3b, 0x56,
+	0xc6, 0xb1, 0x66, 0x1c, 0xab, 0x9d, 0x9f, 0xd3, 0xda, 0x78, 0xf1, 0xaa, 0x56, 0xfa, 0xf1, 0xaf,
+	0x9a, 0x02, 0xdf, 0xcd, 0x76, 0xb7, 0xc5, 0x66, 0x37, 0x88, 0x88, 0xfa, 0x15, 0xd0, 0x38, 0x3e,
+	0x27, 0x88, 0x61, 0x4e, 0x90, 0x17, 0xe2, 0x20, 0x42, 0x41, 0xcc, 0x09, 0xfb, 0x16, 0x87, 0xda,
+	0xca, 0xcd, 0xb9, 0xef, 0x0b, 0x08, 0xc4, 0x9c, 0xd8, 0x02, 0xd1, 0xcd, 0x09, 0xea, 0xd7, 0x60,
+	0x27, 0xc4, 0x09, 0x47, 0x6f, 0x1e, 0x21, 0xb5, 0x57, 0x25, 0x5e, 0xff, 0x0f, 0xde, 0x9d, 0x95,
+	0x9f, 0xf1, 0x9f, 0x4b, 0xbe, 0xc0, 0xb8, 0xcb, 0x67, 0x48, 0xfb, 0x53, 0x70, 0x1f, 0xa7, 0x9c,
+	0x22, 0x8f, 0x46, 0x43, 0x9a, 0xc6, 0xfe, 0xc2, 0xbd, 0x7c, 0x73, 0xf7, 0xaa, 0x40, 0xd8, 0x39,
+	0x61, 0xae, 0xfe, 0x25, 0xd8, 0x96, 0xea, 0xaf, 0xf3, 0xa5, 0xf8, 0xda, 0x5b, 0x88, 0x57, 0x05,
+	0xa4, 0xb9, 0x74, 0x80, 0xf4, 0x86, 0xa0, 0xb2, 0x68, 0x49, 0x32, 0x0c, 0x03, 0x9e, 0x68, 0xeb,
+	0xe6, 0x6a, 0x7d, 0x6b, 0x6f, 0xd7, 0x2a, 0x78, 0x73, 0xd6, 0xac, 0xf2, 0x63, 0x91, 0xda, 0x2a,
+	0x0b, 0x3a, 0xbc, 0xcb, 0x97, 0x17, 0x13, 0xf5, 0x29, 0xd0, 0xa5, 0x2b, 0x23, 0x3e, 0x09, 0xc9,
+	0x40, 0x90, 0x39, 0x8d, 0xfa, 0x09, 0xa7, 0x31, 0xf1, 0xb5, 0x77, 0x4c, 0xa5, 0xbe, 0x01, 0x35,
+	0x91, 0x01, 0xe7, 0x09, 0xee, 0x3c, 0xae, 0x26, 0x60, 0x3b, 0xc2, 0x23, 0xc4, 0x29, 0xc7, 0x21,
+	0xca, 0x9f, 0xd8, 0x05, 0x09, 0x06, 0x67, 0x5c, 0xdb, 0x30, 0x95, 0xfa, 0x66, 0xeb, 0xa9, 0x38,
+	0xf4, 0xcf, 0x57, 0xb5, 0x0f, 0x07, 0x01, 0x3f, 0x4b, 0xfb, 0x96, 0x47, 0xa3, 0xfc, 0x99, 0xe7,
+	0x3f, 0x8f, 0x13, 0xff, 0xbc, 0xc1, 0xc7, 0x43, 0x92, 0x58, 0x6d, 0xe2, 0xfd, 0xfe, 0xcb, 0x63,
+	0x90, 0xad, 0x8b, 0x19, 0xac, 0x46, 0x78, 0xe4, 0x0a, 0x36, 0x94, 0xe8, 0xcf, 0x25, 0x59, 0xed,
+	0x83, 0x07, 0x8b, 0x36, 0x9c, 0x05, 0x09, 0xa7, 0x6c, 0x8c, 0x18, 0xe1, 0x24, 0x16, 0x57, 0xa4,
+	0x6d, 0xde, 0xfc, 0x0e, 0xb5, 0x59, 0x33, 0x3e, 0xcd, 0x28, 0x70, 0x06, 0xf9, 0xb8, 0xfc, 0xcf,
+	0x4f, 0x35, 0x65, 0xf7, 0x57, 0x05, 0xdc, 0x79, 0xad, 0x89, 0xea, 0x67, 0x60, 0xcb, 0x27, 0x09,
+	0x0f, 0x62, 0x89, 0x92, 0xdf, 0xd0, 0xdd, 0xbd, 0xfa, 0xff, 0x76, 0xbf, 0xbd, 0xc8, 0x87, 0xcb,
+	0x9b, 0x55, 0x08, 0xd6, 0xa4, 0x91, 0xb6, 0x72, 0x0b, 0xad, 0xca, 0x50, 0xb9, 0xf7, 0x77, 0xe0,
+	0x4e, 0xd6, 0xb1, 0xbc, 0x2e, 0xb5, 0x0a, 0xd6, 0x7c, 0x12, 0xd3, 0x48, 0x0a, 0x6f, 0xc2, 0x6c,
+	0x22, 0x04, 0x82, 0xd8, 0x27, 0xa3, 0xdb, 0x11, 0x90, 0xa8, 0x4c, 0xe0, 0xa3, 0x1f, 0x56, 0xc1,
+	0xbd, 0x82, 0xfa, 0xd5, 0x03, 0xf0, 0xd0, 0x6d, 0x3e, 0xeb, 0x20, 0xd8, 0x74, 0x3b, 0xa8, 0xdd,
+	0x39, 0x76, 0xbb, 0x4e, 0xd3, 0xed, 0xf6, 0x1c, 0xb4, 0xdf, 0xe9, 0x20, 0xbb, 0x77, 0x70, 0xd0,
+	0xb1, 0xdd, 0x1e, 0xac, 0x94, 0xf4, 0x87, 0x93, 0xa9, 0x59, 0x2b, 0x20, 0xec, 0x13, 0x62, 0xd3,
+	0x30, 0x24, 0x1e, 0xa7, 0x4c, 0x75, 0xc0, 0xa3, 0x62, 0x9a, 0xdd, 0x3b, 0x3c, 0x3c, 0x71, 0xba,
+	0xee, 0x29, 0x3a, 0xea, 0xf5, 0x0e, 0x2a, 0x8a, 0xfe, 0x68, 0x32, 0x35, 0xcd, 0x02, 0x9c, 0x4d,
+	0xa3, 0x28, 0x8d, 0x03, 0x3e, 0x3e, 0xa2, 0x34, 0x54, 0x9b, 0xe0, 0x83, 0x62, 0x5e, 0xeb, 0xe4,
+	0xb4, 0xd5, 0xb4, 0x9f, 0x55, 0x56, 0x74, 0x63, 0x32, 0x35, 0xf5, 0x02, 0x50, 0x2b, 0x1d, 0xf7,
+	0xb1, 0x77, 0xae, 0x7e, 0x02, 0xf4, 0xeb, 0x10, 0xd0, 0xa9, 0xac, 0xea, 0x0f, 0x26, 0x53, 0x73,
+	0xbb, 0x70, 0x3f, 0x8b, 0xaf, 0xaf, 0xa7, 0xeb, 0x1c, 0x9f, 0xc0, 0xa6, 0x63, 0x77, 0xd0, 0xfe,
+	0x89, 0xd3, 0xae, 0x94, 0xaf, 0xad, 0xa7, 0x1b, 0x27, 0x29, 0xc3, 0xb1, 0x47, 0xf6, 0xd3, 0xd8,
+	0xd7, 0xcb, 0xdf, 0xff, 0x6c, 0x94, 0x5a, 0x87, 0x2f, 0x2e, 0x0d, 0xe5, 0xe5, 0xa5, 0xa1, 0xfc,
+	0x7d, 0x69, 0x28, 0xcf, 0xaf, 0x8c, 0xd2, 0xcb, 0x2b, 0xa3, 0xf4, 0xc7, 0x95, 0x51, 0xfa, 0xe2,
+	0xc9, 0xd2, 0x45, 0xcb, 0x77, 0x1b, 0x13, 0x7e, 0x41, 0xd9, 0x79, 0x63, 0xf1, 0xc7, 0x36, 0x5a,
+	0x1a, 0xcb, 0x9b, 0xef, 0xaf, 0xcb, 0x0f, 0xea, 0xc9, 0xbf, 0x03, 0x00, 0xd4, 0x37, 0x72, 0x6d,
+	0xfe, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -202,6 +366,56 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.LastTakeRateClaimTime.Equal(that1.LastTakeRateClaimTime) {
 		return false
 	}
+	if this.AutoCompoundInterval != that1.AutoCompoundInterval {
+		return false
+	}
+	if !this.LastAutoCompoundTime.Equal(that1.LastAutoCompoundTime) {
+		return false
+	}
+	if len(this.TakeRateSplits) != len(that1.TakeRateSplits) {
+		return false
+	}
+	for i := range this.TakeRateSplits {
+		if !this.TakeRateSplits[i].Equal(&that1.TakeRateSplits[i]) {
+			return false
+		}
+	}
+	if this.AutoRedelegateTombstoned != that1.AutoRedelegateTombstoned {
+		return false
+	}
+	if !this.MaxTotalRewardWeight.Equal(that1.MaxTotalRewardWeight) {
+		return false
+	}
+	if this.TakeRateHistoryRetention != that1.TakeRateHistoryRetention {
+		return false
+	}
+	return true
+}
+func (this *TakeRateSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TakeRateSplit)
+	if !ok {
+		that2, ok := that.(TakeRateSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if !this.Ratio.Equal(that1.Ratio) {
+		return false
+	}
 	return true
 }
 func (this *RewardHistory) Equal(that interface{}) bool {
@@ -251,33 +465,129 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTakeRateClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTakeRateClaimTime):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TakeRateHistoryRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TakeRateHistoryRetention):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x1a
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TakeRateClaimInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TakeRateClaimInterval):])
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxTotalRewardWeight.Size()
+		i -= size
+		if _, err := m.MaxTotalRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.AutoRedelegateTombstoned {
+		i--
+		if m.AutoRedelegateTombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for iNdEx := len(m.TakeRateSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastAutoCompoundTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAutoCompoundTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x12
-	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardDelayTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardDelayTime):])
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.AutoCompoundInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoCompoundInterval):])
 	if err3 != nil {
 		return 0, err3
 	}
 	i -= n3
 	i = encodeVarintParams(dAtA, i, uint64(n3))
 	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastTakeRateClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTakeRateClaimTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TakeRateClaimInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TakeRateClaimInterval):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardDelayTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardDelayTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintParams(dAtA, i, uint64(n6))
+	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
+func (m *TakeRateSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakeRateSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakeRateSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Destination != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Destination))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RewardHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -341,6 +651,37 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastTakeRateClaimTime)
 	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.AutoCompoundInterval)
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAutoCompoundTime)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.TakeRateSplits) > 0 {
+		for _, e := range m.TakeRateSplits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AutoRedelegateTombstoned {
+		n += 2
+	}
+	l = m.MaxTotalRewardWeight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TakeRateHistoryRetention)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *TakeRateSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Destination != 0 {
+		n += 1 + sovParams(uint64(m.Destination))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -493,6 +834,296 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCompoundInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.AutoCompoundInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAutoCompoundTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastAutoCompoundTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateSplits = append(m.TakeRateSplits, TakeRateSplit{})
+			if err := m.TakeRateSplits[len(m.TakeRateSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRedelegateTombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoRedelegateTombstoned = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxTotalRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TakeRateHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakeRateSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakeRateSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakeRateSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			m.Destination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Destination |= TakeRateDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
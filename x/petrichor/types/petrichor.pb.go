@@ -30,6 +30,115 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// RewardWeightMode defines how the reward weight of a petrichor asset is set
+type RewardWeightMode int32
+
+const (
+	// The reward weight is set by governance and changes through the reward change rate
+	RewardWeightModeStatic RewardWeightMode = 0
+	// The reward weight follows the price of the asset, clamped within the governance bounds
+	// It changes at most once per reward change interval or hour, whichever is longer, and is only accepted on
+	// chains that set a price source on the petrichor keeper
+	RewardWeightModeOracle RewardWeightMode = 1
+	// The reward weight follows the reward weight schedule of the asset, clamped within the governance bounds
+	RewardWeightModeSchedule RewardWeightMode = 2
+)
+
+var RewardWeightMode_name = map[int32]string{
+	0: "REWARD_WEIGHT_MODE_STATIC",
+	1: "REWARD_WEIGHT_MODE_ORACLE",
+	2: "REWARD_WEIGHT_MODE_SCHEDULE",
+}
+
+var RewardWeightMode_value = map[string]int32{
+	"REWARD_WEIGHT_MODE_STATIC":   0,
+	"REWARD_WEIGHT_MODE_ORACLE":   1,
+	"REWARD_WEIGHT_MODE_SCHEDULE": 2,
+}
+
+func (x RewardWeightMode) String() string {
+	return proto.EnumName(RewardWeightMode_name, int32(x))
+}
+
+func (RewardWeightMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_baabf92e941f4fa4, []int{0}
+}
+
+// RewardWeightInterpolation defines how the reward weight moves between two points of a schedule
+type RewardWeightInterpolation int32
+
+const (
+	// The reward weight moves linearly from one target to the next in steps of the reward change interval or one
+	// hour, whichever is longer
+	RewardWeightInterpolationLinear RewardWeightInterpolation = 0
+	// The reward weight jumps to a target once its time is reached
+	RewardWeightInterpolationStep RewardWeightInterpolation = 1
+)
+
+var RewardWeightInterpolation_name = map[int32]string{
+	0: "REWARD_WEIGHT_INTERPOLATION_LINEAR",
+	1: "REWARD_WEIGHT_INTERPOLATION_STEP",
+}
+
+var RewardWeightInterpolation_value = map[string]int32{
+	"REWARD_WEIGHT_INTERPOLATION_LINEAR": 0,
+	"REWARD_WEIGHT_INTERPOLATION_STEP":   1,
+}
+
+func (x RewardWeightInterpolation) String() string {
+	return proto.EnumName(RewardWeightInterpolation_name, int32(x))
+}
+
+func (RewardWeightInterpolation) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_baabf92e941f4fa4, []int{1}
+}
+
+// RewardWeightSchedulePoint is the reward weight an asset reaches at a given time
+type RewardWeightSchedulePoint struct {
+	Time         time.Time                              `protobuf:"bytes,1,opt,name=time,proto3,stdtime" json:"time"`
+	TargetWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=target_weight,json=targetWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_weight"`
+}
+
+func (m *RewardWeightSchedulePoint) Reset()         { *m = RewardWeightSchedulePoint{} }
+func (m *RewardWeightSchedulePoint) String() string { return proto.CompactTextString(m) }
+func (*RewardWeightSchedulePoint) ProtoMessage()    {}
+func (*RewardWeightSchedulePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baabf92e941f4fa4, []int{0}
+}
+func (m *RewardWeightSchedulePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardWeightSchedulePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardWeightSchedulePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardWeightSchedulePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardWeightSchedulePoint.Merge(m, src)
+}
+func (m *RewardWeightSchedulePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardWeightSchedulePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardWeightSchedulePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardWeightSchedulePoint proto.InternalMessageInfo
+
+func (m *RewardWeightSchedulePoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
 // key: denom value: PetrichorAsset
 type PetrichorAsset struct {
 	// Denom of the asset. It could either be a native token or an IBC token
@@ -47,13 +156,40 @@ type PetrichorAsset struct {
 	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
 	RewardChangeInterval time.Duration                          `protobuf:"bytes,8,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
 	LastRewardChangeTime time.Time                              `protobuf:"bytes,9,opt,name=last_reward_change_time,json=lastRewardChangeTime,proto3,stdtime" json:"last_reward_change_time"`
+	// Set when governance delists the asset. New delegations are rejected and the asset is removed once
+	// total_tokens reaches zero
+	IsDelisting bool `protobuf:"varint,10,opt,name=is_delisting,json=isDelisting,proto3" json:"is_delisting,omitempty"`
+	// Optional cap on the total amount of tokens that can be delegated for the asset
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Optional cap on the ratio of the asset that can be delegated to a single validator. The ratio applies to
+	// max_total_tokens when it is set and to the asset's total tokens otherwise
+	MaxValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share,omitempty"`
+	// Optional minimum amount of tokens a delegation must hold. Partial undelegations and redelegations cannot leave less
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,13,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// How the reward weight is set. In oracle mode it follows the price of the asset and in schedule mode the
+	// reward weight schedule
+	RewardWeightMode RewardWeightMode `protobuf:"varint,14,opt,name=reward_weight_mode,json=rewardWeightMode,proto3,enum=petrichor.petrichor.RewardWeightMode" json:"reward_weight_mode,omitempty"`
+	// Lower bound of the reward weight in oracle and schedule mode
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Upper bound of the reward weight in oracle and schedule mode
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Optional split of the take rate proceeds of the asset. The module wide split is used when empty
+	TakeRateSplits []TakeRateSplit `protobuf:"bytes,17,rep,name=take_rate_splits,json=takeRateSplits,proto3" json:"take_rate_splits"`
+	// Reward weight targets applied in schedule mode, ordered by time
+	RewardWeightSchedule []RewardWeightSchedulePoint `protobuf:"bytes,18,rep,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule"`
+	// How the reward weight moves between the points of the schedule
+	RewardWeightInterpolation RewardWeightInterpolation `protobuf:"varint,19,opt,name=reward_weight_interpolation,json=rewardWeightInterpolation,proto3,enum=petrichor.petrichor.RewardWeightInterpolation" json:"reward_weight_interpolation,omitempty"`
+	// Optional ceiling on the slashed tokens of the asset covered by the insurance fund in each slash. Only
+	// undelegations that are still unbonding are covered, slashed delegations and redelegations are not.
+	// Slashes of the asset are not covered when unset
+	InsuranceCoverageCeiling *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,20,opt,name=insurance_coverage_ceiling,json=insuranceCoverageCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"insurance_coverage_ceiling,omitempty"`
 }
 
 func (m *PetrichorAsset) Reset()         { *m = PetrichorAsset{} }
 func (m *PetrichorAsset) String() string { return proto.CompactTextString(m) }
 func (*PetrichorAsset) ProtoMessage()    {}
 func (*PetrichorAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_baabf92e941f4fa4, []int{0}
+	return fileDescriptor_baabf92e941f4fa4, []int{1}
 }
 func (m *PetrichorAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -91,7 +227,7 @@ func (m *RewardWeightChangeSnapshot) Reset()         { *m = RewardWeightChangeSn
 func (m *RewardWeightChangeSnapshot) String() string { return proto.CompactTextString(m) }
 func (*RewardWeightChangeSnapshot) ProtoMessage()    {}
 func (*RewardWeightChangeSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_baabf92e941f4fa4, []int{1}
+	return fileDescriptor_baabf92e941f4fa4, []int{2}
 }
 func (m *RewardWeightChangeSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -120,56 +256,214 @@ func (m *RewardWeightChangeSnapshot) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RewardWeightChangeSnapshot proto.InternalMessageInfo
 
+// TakeRateRecord is the take rate deducted from an asset at a take rate claim
+// key: denom|height value: TakeRateRecord
+type TakeRateRecord struct {
+	Denom  string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height uint64                                 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *TakeRateRecord) Reset()         { *m = TakeRateRecord{} }
+func (m *TakeRateRecord) String() string { return proto.CompactTextString(m) }
+func (*TakeRateRecord) ProtoMessage()    {}
+func (*TakeRateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baabf92e941f4fa4, []int{3}
+}
+func (m *TakeRateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakeRateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakeRateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakeRateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakeRateRecord.Merge(m, src)
+}
+func (m *TakeRateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakeRateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakeRateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakeRateRecord proto.InternalMessageInfo
+
+func (m *TakeRateRecord) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TakeRateRecord) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *TakeRateRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// TakeRateAccrual is the cumulative take rate deducted from an asset
+// key: denom value: TakeRateAccrual
+type TakeRateAccrual struct {
+	Denom         string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	TotalAmount   github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
+	LastClaimTime time.Time                              `protobuf:"bytes,3,opt,name=last_claim_time,json=lastClaimTime,proto3,stdtime" json:"last_claim_time"`
+}
+
+func (m *TakeRateAccrual) Reset()         { *m = TakeRateAccrual{} }
+func (m *TakeRateAccrual) String() string { return proto.CompactTextString(m) }
+func (*TakeRateAccrual) ProtoMessage()    {}
+func (*TakeRateAccrual) Descriptor() ([]byte, []int) {
+	return fileDescriptor_baabf92e941f4fa4, []int{4}
+}
+func (m *TakeRateAccrual) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TakeRateAccrual) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TakeRateAccrual.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TakeRateAccrual) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TakeRateAccrual.Merge(m, src)
+}
+func (m *TakeRateAccrual) XXX_Size() int {
+	return m.Size()
+}
+func (m *TakeRateAccrual) XXX_DiscardUnknown() {
+	xxx_messageInfo_TakeRateAccrual.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TakeRateAccrual proto.InternalMessageInfo
+
+func (m *TakeRateAccrual) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *TakeRateAccrual) GetLastClaimTime() time.Time {
+	if m != nil {
+		return m.LastClaimTime
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("petrichor.petrichor.RewardWeightMode", RewardWeightMode_name, RewardWeightMode_value)
+	proto.RegisterEnum("petrichor.petrichor.RewardWeightInterpolation", RewardWeightInterpolation_name, RewardWeightInterpolation_value)
+	proto.RegisterType((*RewardWeightSchedulePoint)(nil), "petrichor.petrichor.RewardWeightSchedulePoint")
 	proto.RegisterType((*PetrichorAsset)(nil), "petrichor.petrichor.PetrichorAsset")
 	proto.RegisterType((*RewardWeightChangeSnapshot)(nil), "petrichor.petrichor.RewardWeightChangeSnapshot")
+	proto.RegisterType((*TakeRateRecord)(nil), "petrichor.petrichor.TakeRateRecord")
+	proto.RegisterType((*TakeRateAccrual)(nil), "petrichor.petrichor.TakeRateAccrual")
 }
 
 func init() { proto.RegisterFile("petrichor/petrichor.proto", fileDescriptor_baabf92e941f4fa4) }
 
 var fileDescriptor_baabf92e941f4fa4 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x86, 0xed, 0xfe, 0x7d, 0xe9, 0xb4, 0x1f, 0x14, 0x13, 0x15, 0x37, 0x0b, 0xbb, 0xca, 0xa2,
-	0xea, 0xa6, 0xb6, 0xd4, 0xee, 0x2a, 0x36, 0x84, 0x2e, 0x1a, 0x21, 0xa4, 0xca, 0xa9, 0x40, 0xfc,
-	0x48, 0xd6, 0x24, 0x1e, 0x6c, 0x2b, 0xb6, 0xc7, 0x9a, 0x39, 0x49, 0xc8, 0x1d, 0xb0, 0xac, 0xc4,
-	0x86, 0x65, 0x2f, 0x82, 0x8b, 0xe8, 0xb2, 0x62, 0x05, 0x2c, 0x02, 0x4a, 0x36, 0xac, 0xb9, 0x02,
-	0x34, 0x33, 0x36, 0x75, 0x80, 0x4d, 0xb3, 0xf2, 0xf1, 0x9c, 0x33, 0xcf, 0x79, 0xe7, 0x3d, 0xa3,
-	0x41, 0x3b, 0x39, 0x01, 0x16, 0xf7, 0x22, 0xca, 0xdc, 0xdf, 0x91, 0x93, 0x33, 0x0a, 0xd4, 0xb8,
-	0x5f, 0x59, 0x28, 0xa3, 0x46, 0x3d, 0xa4, 0x21, 0x95, 0x79, 0x57, 0x44, 0xaa, 0xb4, 0xb1, 0xd3,
-	0xa3, 0x3c, 0xa5, 0xdc, 0x57, 0x09, 0xf5, 0x53, 0xa4, 0xb6, 0x2b, 0x0d, 0x30, 0xc3, 0x69, 0xb9,
-	0x6e, 0x85, 0x94, 0x86, 0x09, 0x71, 0xe5, 0x5f, 0x77, 0xf0, 0xc6, 0x0d, 0x06, 0x0c, 0x43, 0x4c,
-	0xb3, 0x22, 0x6f, 0xff, 0x99, 0x87, 0x38, 0x25, 0x1c, 0x70, 0x9a, 0xab, 0x82, 0xe6, 0xfb, 0x35,
-	0x74, 0xe7, 0xac, 0x64, 0x3f, 0xe2, 0x9c, 0x80, 0xb1, 0x87, 0x56, 0x03, 0x92, 0xd1, 0xd4, 0xd4,
-	0x77, 0xf5, 0xfd, 0xf5, 0xd6, 0xd6, 0xcf, 0x89, 0xbd, 0x39, 0xc6, 0x69, 0x72, 0xdc, 0x94, 0xcb,
-	0x4d, 0x4f, 0xa5, 0x8d, 0x0e, 0xfa, 0x9f, 0x91, 0x11, 0x66, 0x81, 0x3f, 0x22, 0x71, 0x18, 0x81,
-	0xb9, 0x24, 0xeb, 0x9d, 0xab, 0x89, 0xad, 0x7d, 0x9d, 0xd8, 0x7b, 0x61, 0x0c, 0xd1, 0xa0, 0xeb,
-	0xf4, 0x68, 0x5a, 0x9c, 0xa5, 0xf8, 0x1c, 0xf0, 0xa0, 0xef, 0xc2, 0x38, 0x27, 0xdc, 0x39, 0x21,
-	0x3d, 0x6f, 0x53, 0x41, 0x9e, 0x4b, 0x86, 0xf1, 0x04, 0xad, 0x03, 0xee, 0x13, 0x9f, 0x61, 0x20,
-	0xe6, 0xf2, 0x42, 0xc0, 0x9a, 0x00, 0x78, 0x18, 0x88, 0xe1, 0xa3, 0x4d, 0xa0, 0x80, 0x13, 0x1f,
-	0x68, 0x9f, 0x64, 0xdc, 0x5c, 0x91, 0xbc, 0x87, 0xb7, 0xe0, 0xb5, 0x33, 0xf8, 0xf4, 0xf1, 0x00,
-	0x15, 0xb3, 0x68, 0x67, 0xe0, 0x6d, 0x48, 0xe2, 0xb9, 0x04, 0x1a, 0x01, 0xda, 0x56, 0x0d, 0x86,
-	0x38, 0x89, 0x03, 0x0c, 0x94, 0xf9, 0x3c, 0xc2, 0x8c, 0x70, 0x73, 0x75, 0x21, 0xe9, 0x75, 0x49,
-	0x7b, 0x56, 0xc2, 0x3a, 0x92, 0x65, 0x9c, 0xa1, 0x7b, 0x85, 0xd1, 0x1c, 0x30, 0x03, 0x5f, 0xcc,
-	0xd0, 0x5c, 0xdb, 0xd5, 0xf7, 0x37, 0x0e, 0x1b, 0x8e, 0x1a, 0xb0, 0x53, 0x0e, 0xd8, 0x39, 0x2f,
-	0x07, 0xdc, 0xaa, 0x89, 0xe6, 0x17, 0xdf, 0x6c, 0xdd, 0xbb, 0xab, 0xb6, 0x77, 0xc4, 0x6e, 0x91,
-	0x37, 0x5e, 0x23, 0xa3, 0x20, 0xf6, 0x22, 0x9c, 0x85, 0x85, 0xdd, 0xff, 0x2d, 0xa4, 0x79, 0x4b,
-	0x91, 0x1e, 0x4b, 0x90, 0xb4, 0xfd, 0x05, 0xda, 0x9e, 0xa7, 0xc7, 0x19, 0x10, 0x36, 0xc4, 0x89,
-	0x59, 0x93, 0xa2, 0x77, 0xfe, 0x12, 0x7d, 0x52, 0xdc, 0x5a, 0xa5, 0xf9, 0x83, 0xd0, 0x5c, 0xaf,
-	0x62, 0xdb, 0x05, 0xc0, 0x78, 0x85, 0x1e, 0x24, 0x98, 0x83, 0x3f, 0xcf, 0x97, 0x86, 0xac, 0xdf,
-	0xc2, 0x90, 0xba, 0x80, 0x78, 0x95, 0x06, 0xa2, 0xe8, 0xb8, 0xf6, 0xee, 0xd2, 0xd6, 0x7e, 0x5c,
-	0xda, 0x5a, 0xf3, 0x8b, 0x8e, 0x1a, 0x5e, 0xe5, 0x5a, 0xaa, 0xa2, 0x4e, 0x86, 0x73, 0x1e, 0x51,
-	0x10, 0xf6, 0xe5, 0x8c, 0x0c, 0xfd, 0xf9, 0xeb, 0xaf, 0x2f, 0x66, 0x9f, 0x20, 0x55, 0x7b, 0x19,
-	0x1d, 0x54, 0x58, 0xea, 0x47, 0x31, 0x07, 0xca, 0x62, 0xc2, 0xcd, 0xa5, 0xdd, 0xe5, 0xfd, 0x8d,
-	0xc3, 0xa6, 0xf3, 0x8f, 0xc7, 0xc4, 0x51, 0x9b, 0x4f, 0x65, 0xed, 0xb8, 0xb5, 0x22, 0xfa, 0x97,
-	0x13, 0x3f, 0x2d, 0x01, 0x37, 0x67, 0x6b, 0x3d, 0xbd, 0x9a, 0x5a, 0xfa, 0xf5, 0xd4, 0xd2, 0xbf,
-	0x4f, 0x2d, 0xfd, 0x62, 0x66, 0x69, 0xd7, 0x33, 0x4b, 0xfb, 0x3c, 0xb3, 0xb4, 0x97, 0x47, 0x15,
-	0xc9, 0x12, 0x9f, 0x11, 0x18, 0x51, 0xd6, 0xbf, 0x79, 0xd3, 0xdc, 0xb7, 0x95, 0x58, 0x9e, 0xa1,
-	0xbb, 0x26, 0x8d, 0x3e, 0xfa, 0x15, 0x00, 0x00, 0xff, 0xff, 0x86, 0x2e, 0x6e, 0xbe, 0x03, 0x05,
-	0x00, 0x00,
+	// 1168 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xbd, 0x69, 0x1a, 0x92, 0x49, 0x6a, 0x3b, 0x13, 0x63, 0xd6, 0x2e, 0xd8, 0xae, 0x11,
+	0x55, 0x54, 0xa9, 0xb6, 0xd4, 0x5e, 0xa0, 0x82, 0x83, 0x63, 0x5b, 0x8d, 0x55, 0xe7, 0x87, 0x76,
+	0x0d, 0x11, 0x05, 0x31, 0x9a, 0xac, 0xa7, 0xf6, 0x90, 0xdd, 0x1d, 0x6b, 0x66, 0x9c, 0x38, 0xfc,
+	0x05, 0x55, 0x4e, 0x3d, 0x72, 0x89, 0x54, 0x89, 0x13, 0x9c, 0x91, 0xf8, 0x17, 0x7a, 0x01, 0x55,
+	0x1c, 0x10, 0x70, 0x08, 0x28, 0xb9, 0x70, 0xe6, 0x2f, 0x40, 0x3b, 0xbb, 0x76, 0x76, 0xdd, 0xa4,
+	0x95, 0x4d, 0x4e, 0xde, 0x9d, 0x37, 0xef, 0xf3, 0xde, 0xbc, 0xfd, 0xce, 0x9b, 0x31, 0xc8, 0xf4,
+	0x88, 0xe4, 0xd4, 0xea, 0x32, 0x5e, 0x1e, 0x3d, 0x95, 0x7a, 0x9c, 0x49, 0x06, 0x57, 0x42, 0x03,
+	0xc3, 0xa7, 0x6c, 0xaa, 0xc3, 0x3a, 0x4c, 0xd9, 0xcb, 0xde, 0x93, 0x3f, 0x35, 0x9b, 0xb1, 0x98,
+	0x70, 0x98, 0x40, 0xbe, 0xc1, 0x7f, 0x09, 0x4c, 0xe9, 0x50, 0x00, 0xcc, 0xb1, 0x33, 0x1c, 0xcf,
+	0x75, 0x18, 0xeb, 0xd8, 0xa4, 0xac, 0xde, 0x76, 0xfb, 0x4f, 0xca, 0xed, 0x3e, 0xc7, 0x92, 0x32,
+	0x37, 0xb0, 0xe7, 0xc7, 0xed, 0x92, 0x3a, 0x44, 0x48, 0xec, 0xf4, 0xfc, 0x09, 0xc5, 0x1f, 0x34,
+	0x90, 0x31, 0xc8, 0x01, 0xe6, 0xed, 0x1d, 0x42, 0x3b, 0x5d, 0x69, 0x5a, 0x5d, 0xd2, 0xee, 0xdb,
+	0x64, 0x9b, 0x51, 0x57, 0xc2, 0x0f, 0xc1, 0xac, 0xe7, 0xa0, 0x6b, 0x05, 0x6d, 0x75, 0xf1, 0x5e,
+	0xb6, 0xe4, 0xd3, 0x4a, 0x43, 0x5a, 0xa9, 0x35, 0xa4, 0xad, 0xcd, 0xbf, 0x38, 0xc9, 0xc7, 0x9e,
+	0xfd, 0x95, 0xd7, 0x0c, 0xe5, 0x01, 0x4d, 0x70, 0x43, 0x62, 0xde, 0x21, 0x12, 0x1d, 0x28, 0xae,
+	0x3e, 0x53, 0xd0, 0x56, 0x17, 0xd6, 0x4a, 0xde, 0xb4, 0x3f, 0x4f, 0xf2, 0xb7, 0x3b, 0x54, 0x76,
+	0xfb, 0xbb, 0x25, 0x8b, 0x39, 0xc1, 0x42, 0x83, 0x9f, 0xbb, 0xa2, 0xbd, 0x57, 0x96, 0x87, 0x3d,
+	0x22, 0x4a, 0x35, 0x62, 0x19, 0x4b, 0x3e, 0xc4, 0xcf, 0xad, 0xf8, 0x7d, 0x1c, 0xc4, 0xb7, 0x87,
+	0x85, 0xa8, 0x08, 0x41, 0x24, 0xbc, 0x0d, 0xae, 0xb7, 0x89, 0xcb, 0x1c, 0x95, 0xe2, 0xc2, 0x5a,
+	0xf2, 0xdf, 0x93, 0xfc, 0xd2, 0x21, 0x76, 0xec, 0x07, 0x45, 0x35, 0x5c, 0x34, 0x7c, 0xb3, 0x97,
+	0x0f, 0x57, 0xcb, 0xfc, 0x9f, 0xf9, 0xf0, 0x50, 0xad, 0xe0, 0x23, 0xb0, 0x20, 0xf1, 0x1e, 0x41,
+	0x1c, 0x4b, 0xa2, 0x5f, 0x9b, 0x0a, 0x38, 0xef, 0x01, 0x0c, 0x2c, 0x09, 0x44, 0x60, 0x49, 0x32,
+	0x89, 0x6d, 0x24, 0xd9, 0x1e, 0x71, 0x85, 0x3e, 0xab, 0x78, 0x1f, 0x4f, 0xc0, 0x6b, 0xb8, 0xf2,
+	0xd7, 0x1f, 0xef, 0x02, 0x7f, 0xdc, 0x7b, 0x33, 0x16, 0x15, 0xb1, 0xa5, 0x80, 0xb0, 0x0d, 0xd2,
+	0x7e, 0x80, 0x7d, 0x6c, 0xd3, 0x36, 0x96, 0x8c, 0x23, 0xd1, 0xc5, 0x9c, 0x08, 0xfd, 0xfa, 0x54,
+	0xa9, 0xa7, 0x14, 0xed, 0xb3, 0x21, 0xcc, 0x54, 0x2c, 0xb8, 0x0d, 0x96, 0x83, 0x42, 0x0b, 0x89,
+	0xb9, 0x44, 0x4a, 0x3f, 0x73, 0x13, 0xe8, 0x27, 0xe1, 0xbb, 0x9b, 0x9e, 0xb7, 0x67, 0x87, 0x5f,
+	0x02, 0x18, 0x10, 0xad, 0x2e, 0x76, 0x3b, 0x41, 0xb9, 0xdf, 0x9a, 0x2a, 0xe7, 0xa4, 0x4f, 0xaa,
+	0x2a, 0x90, 0x2a, 0xfb, 0xe7, 0x20, 0x1d, 0xa5, 0x53, 0x57, 0x12, 0xbe, 0x8f, 0x6d, 0x7d, 0x5e,
+	0x25, 0x9d, 0x79, 0x25, 0xe9, 0x5a, 0xb0, 0xc5, 0xfc, 0x9c, 0xbf, 0xf5, 0x72, 0x4e, 0x85, 0xb1,
+	0x8d, 0x00, 0x00, 0xbf, 0x00, 0xef, 0xd8, 0x58, 0x48, 0x14, 0xe5, 0xab, 0x82, 0x2c, 0x4c, 0x50,
+	0x90, 0x94, 0x07, 0x31, 0x42, 0x01, 0x54, 0x55, 0x6e, 0x81, 0x25, 0x2a, 0x50, 0x9b, 0xd8, 0x54,
+	0x48, 0xea, 0x76, 0x74, 0x50, 0xd0, 0x56, 0xe7, 0x8d, 0x45, 0x2a, 0x6a, 0xc3, 0x21, 0xf8, 0x04,
+	0x24, 0x1d, 0x3c, 0x40, 0x11, 0x55, 0x2d, 0x8e, 0x54, 0xa5, 0x4d, 0xad, 0xaa, 0xb8, 0x83, 0x07,
+	0xad, 0x90, 0xb0, 0xbe, 0x02, 0x2b, 0x5e, 0x9c, 0x31, 0x59, 0xe9, 0x4b, 0xa3, 0x2f, 0xa4, 0x4d,
+	0xf0, 0x85, 0x96, 0x1d, 0x3c, 0x88, 0x6a, 0x0a, 0xf6, 0xc0, 0xdb, 0x0e, 0x75, 0xbd, 0xb5, 0x92,
+	0x8e, 0xaa, 0x3c, 0xc2, 0x0e, 0xeb, 0xbb, 0x52, 0xbf, 0x71, 0x05, 0x8b, 0x59, 0x71, 0xa8, 0x5b,
+	0x1b, 0x91, 0x2b, 0x0a, 0x0c, 0x4d, 0x00, 0x23, 0xdd, 0x02, 0x39, 0xac, 0x4d, 0xf4, 0x78, 0x41,
+	0x5b, 0x8d, 0xdf, 0xfb, 0xa0, 0x74, 0x41, 0x47, 0x2f, 0x85, 0x7b, 0xe8, 0x06, 0x6b, 0x93, 0xa1,
+	0xd2, 0xce, 0x47, 0xe0, 0x63, 0xb0, 0xec, 0x2d, 0x23, 0xda, 0x86, 0x12, 0x53, 0x15, 0x29, 0xe1,
+	0x50, 0x37, 0x1c, 0x51, 0xb1, 0xf1, 0x60, 0x8c, 0x9d, 0x9c, 0x92, 0x8d, 0x07, 0x11, 0xb6, 0x01,
+	0x92, 0xa3, 0x2e, 0x87, 0x44, 0xcf, 0xa6, 0x52, 0xe8, 0xcb, 0x85, 0x6b, 0xab, 0x8b, 0xf7, 0x8a,
+	0x17, 0x96, 0xa2, 0x15, 0x74, 0x34, 0xd3, 0x9b, 0xba, 0x36, 0xeb, 0xe9, 0xd8, 0x88, 0xcb, 0xf0,
+	0xa0, 0x80, 0x5f, 0x83, 0x74, 0x24, 0x57, 0x24, 0x82, 0x73, 0x47, 0x87, 0x8a, 0x5c, 0x7a, 0x63,
+	0x91, 0x23, 0x07, 0x55, 0x10, 0x25, 0xc5, 0x2f, 0x98, 0x00, 0x5d, 0x70, 0x33, 0x1a, 0x4b, 0xed,
+	0xf0, 0x1e, 0xb3, 0xd5, 0x17, 0xd7, 0x57, 0xd4, 0x57, 0x7d, 0x73, 0xc0, 0x46, 0xd8, 0xcb, 0xc8,
+	0xf0, 0xcb, 0x4c, 0xf0, 0x1b, 0x90, 0xa5, 0xae, 0xe8, 0x73, 0xec, 0x5a, 0x04, 0x59, 0x6c, 0x9f,
+	0x70, 0xdc, 0x21, 0xc8, 0x22, 0xd4, 0xf6, 0xf6, 0x69, 0xea, 0x0a, 0x34, 0xab, 0x8f, 0xf8, 0xd5,
+	0x00, 0x5f, 0xf5, 0xe9, 0x0f, 0xe6, 0x9f, 0x3e, 0xcf, 0xc7, 0xfe, 0x79, 0x9e, 0x8f, 0x15, 0xff,
+	0xd0, 0x40, 0x36, 0x9c, 0xbe, 0xdf, 0x3a, 0x4c, 0x17, 0xf7, 0x44, 0x97, 0x49, 0xaf, 0xa9, 0xf6,
+	0x38, 0xd9, 0x1f, 0x53, 0x8c, 0x36, 0x5d, 0x53, 0xf5, 0x48, 0x11, 0xc9, 0x98, 0x20, 0x90, 0x3f,
+	0xea, 0x52, 0x21, 0x19, 0xa7, 0x44, 0xe8, 0x33, 0xaf, 0x91, 0x8c, 0xef, 0xbc, 0xae, 0xe6, 0x1e,
+	0x06, 0x1f, 0x33, 0xc1, 0x43, 0x83, 0x94, 0x88, 0xd0, 0xda, 0x7e, 0xd1, 0x40, 0x7c, 0xa8, 0x32,
+	0x83, 0x58, 0x8c, 0xb7, 0x61, 0x2a, 0x72, 0x0f, 0x18, 0x9e, 0xfa, 0x69, 0x30, 0xd7, 0x3d, 0x3f,
+	0xee, 0x67, 0x8d, 0xe0, 0x6d, 0x74, 0xaf, 0xb9, 0x36, 0xf1, 0xbd, 0xa6, 0x05, 0xe6, 0x82, 0xe6,
+	0x73, 0x15, 0xe7, 0x73, 0xc0, 0x2a, 0xfe, 0xa6, 0x81, 0xc4, 0x70, 0x41, 0x15, 0xcb, 0xe2, 0x7d,
+	0x6c, 0x5f, 0xb2, 0xa2, 0xd1, 0x2d, 0x21, 0xc8, 0x62, 0xe6, 0xca, 0x6e, 0x09, 0x41, 0xeb, 0x6b,
+	0x82, 0x84, 0x3a, 0xb4, 0x2c, 0x1b, 0x53, 0x07, 0x4d, 0x5c, 0xa5, 0x1b, 0x9e, 0x73, 0xd5, 0xf3,
+	0xf5, 0xac, 0x77, 0x7e, 0xd6, 0x40, 0x72, 0xbc, 0x35, 0xc2, 0x8f, 0x40, 0xc6, 0xa8, 0xef, 0x54,
+	0x8c, 0x1a, 0xda, 0xa9, 0x37, 0x1e, 0xae, 0xb7, 0xd0, 0xc6, 0x56, 0xad, 0x8e, 0xcc, 0x56, 0xa5,
+	0xd5, 0xa8, 0x26, 0x63, 0xd9, 0xec, 0xd1, 0x71, 0x21, 0x3d, 0xee, 0x64, 0x4a, 0x2c, 0xa9, 0x75,
+	0x89, 0xeb, 0x96, 0x51, 0xa9, 0x36, 0xeb, 0x49, 0xed, 0x62, 0xd7, 0x2d, 0x8e, 0x2d, 0x9b, 0xc0,
+	0x4f, 0xc0, 0xcd, 0x8b, 0xa2, 0x56, 0xd7, 0xeb, 0xb5, 0x4f, 0x9b, 0xf5, 0xe4, 0x4c, 0xf6, 0xdd,
+	0xa3, 0xe3, 0x82, 0xfe, 0x4a, 0xdc, 0xa0, 0x8b, 0x64, 0x67, 0x9f, 0x7e, 0x97, 0x8b, 0xdd, 0xf9,
+	0x69, 0xec, 0xba, 0x1c, 0xdd, 0xf9, 0x8f, 0x40, 0x31, 0x1a, 0xa2, 0xb1, 0xd9, 0xaa, 0x1b, 0xdb,
+	0x5b, 0xcd, 0x4a, 0xab, 0xb1, 0xb5, 0x89, 0x9a, 0x8d, 0xcd, 0x7a, 0xc5, 0x48, 0xc6, 0xb2, 0xef,
+	0x1f, 0x1d, 0x17, 0xf2, 0x97, 0x62, 0x9a, 0xd4, 0x25, 0x98, 0xc3, 0x87, 0xa0, 0xf0, 0x3a, 0x98,
+	0xd9, 0xaa, 0x6f, 0x27, 0xb5, 0xec, 0xad, 0xa3, 0xe3, 0xc2, 0x7b, 0x97, 0xa2, 0x4c, 0x49, 0x7a,
+	0x7e, 0xe6, 0x6b, 0x1b, 0x2f, 0x4e, 0x73, 0xda, 0xcb, 0xd3, 0x9c, 0xf6, 0xf7, 0x69, 0x4e, 0x7b,
+	0x76, 0x96, 0x8b, 0xbd, 0x3c, 0xcb, 0xc5, 0x7e, 0x3f, 0xcb, 0xc5, 0x1e, 0xdf, 0x0f, 0x89, 0x46,
+	0x6d, 0x49, 0x97, 0xc8, 0x03, 0xc6, 0xf7, 0xce, 0xff, 0xca, 0x94, 0x07, 0xa1, 0x67, 0xa5, 0xa2,
+	0xdd, 0x39, 0xa5, 0x82, 0xfb, 0xff, 0x0d, 0x00, 0xc5, 0x7d, 0x6d, 0xc7, 0xfa, 0x0c, 0x00, 0x00,
 }
 
-func (m *PetrichorAsset) Marshal() (dAtA []byte, err error) {
+func (m *RewardWeightSchedulePoint) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -179,31 +473,202 @@ func (m *PetrichorAsset) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PetrichorAsset) MarshalTo(dAtA []byte) (int, error) {
+func (m *RewardWeightSchedulePoint) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PetrichorAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *RewardWeightSchedulePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRewardChangeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardChangeTime):])
+	{
+		size := m.TargetWeight.Size()
+		i -= size
+		if _, err := m.TargetWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPetrichor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintPetrichor(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x4a
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PetrichorAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PetrichorAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PetrichorAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InsuranceCoverageCeiling != nil {
+		{
+			size := m.InsuranceCoverageCeiling.Size()
+			i -= size
+			if _, err := m.InsuranceCoverageCeiling.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPetrichor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.RewardWeightInterpolation != 0 {
+		i = encodeVarintPetrichor(dAtA, i, uint64(m.RewardWeightInterpolation))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for iNdEx := len(m.RewardWeightSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeightSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPetrichor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for iNdEx := len(m.TakeRateSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPetrichor(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPetrichor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPetrichor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RewardWeightMode != 0 {
+		i = encodeVarintPetrichor(dAtA, i, uint64(m.RewardWeightMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPetrichor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxValidatorShare != nil {
+		{
+			size := m.MaxValidatorShare.Size()
+			i -= size
+			if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPetrichor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintPetrichor(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.IsDelisting {
+		i--
+		if m.IsDelisting {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastRewardChangeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardChangeTime):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintPetrichor(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPetrichor(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x42
 	{
 		size := m.RewardChangeRate.Size()
@@ -215,12 +680,12 @@ func (m *PetrichorAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x3a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RewardStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RewardStartTime):])
-	if err3 != nil {
-		return 0, err3
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RewardStartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RewardStartTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n3
-	i = encodeVarintPetrichor(dAtA, i, uint64(n3))
+	i -= n4
+	i = encodeVarintPetrichor(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -320,43 +785,202 @@ func (m *RewardWeightChangeSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPetrichor(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPetrichor(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TakeRateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PetrichorAsset) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TakeRateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakeRateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovPetrichor(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPetrichor(dAtA, i, uint64(size))
 	}
-	l = m.RewardWeight.Size()
-	n += 1 + l + sovPetrichor(uint64(l))
-	l = m.TakeRate.Size()
-	n += 1 + l + sovPetrichor(uint64(l))
-	l = m.TotalTokens.Size()
-	n += 1 + l + sovPetrichor(uint64(l))
-	l = m.TotalValidatorShares.Size()
-	n += 1 + l + sovPetrichor(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RewardStartTime)
-	n += 1 + l + sovPetrichor(uint64(l))
-	l = m.RewardChangeRate.Size()
-	n += 1 + l + sovPetrichor(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
-	n += 1 + l + sovPetrichor(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardChangeTime)
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintPetrichor(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintPetrichor(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPetrichor(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TakeRateAccrual) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TakeRateAccrual) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TakeRateAccrual) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastClaimTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastClaimTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintPetrichor(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPetrichor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintPetrichor(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPetrichor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPetrichor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RewardWeightSchedulePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = m.TargetWeight.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	return n
+}
+
+func (m *PetrichorAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPetrichor(uint64(l))
+	}
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = m.TakeRate.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = m.TotalTokens.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = m.TotalValidatorShares.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.RewardStartTime)
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = m.RewardChangeRate.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastRewardChangeTime)
 	n += 1 + l + sovPetrichor(uint64(l))
+	if m.IsDelisting {
+		n += 2
+	}
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovPetrichor(uint64(l))
+	}
+	if m.MaxValidatorShare != nil {
+		l = m.MaxValidatorShare.Size()
+		n += 1 + l + sovPetrichor(uint64(l))
+	}
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovPetrichor(uint64(l))
+	}
+	if m.RewardWeightMode != 0 {
+		n += 1 + sovPetrichor(uint64(m.RewardWeightMode))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovPetrichor(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 2 + l + sovPetrichor(uint64(l))
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for _, e := range m.TakeRateSplits {
+			l = e.Size()
+			n += 2 + l + sovPetrichor(uint64(l))
+		}
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for _, e := range m.RewardWeightSchedule {
+			l = e.Size()
+			n += 2 + l + sovPetrichor(uint64(l))
+		}
+	}
+	if m.RewardWeightInterpolation != 0 {
+		n += 2 + sovPetrichor(uint64(m.RewardWeightInterpolation))
+	}
+	if m.InsuranceCoverageCeiling != nil {
+		l = m.InsuranceCoverageCeiling.Size()
+		n += 2 + l + sovPetrichor(uint64(l))
+	}
 	return n
 }
 
@@ -377,13 +1001,50 @@ func (m *RewardWeightChangeSnapshot) Size() (n int) {
 	return n
 }
 
+func (m *TakeRateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPetrichor(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPetrichor(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	return n
+}
+
+func (m *TakeRateAccrual) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovPetrichor(uint64(l))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovPetrichor(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastClaimTime)
+	n += 1 + l + sovPetrichor(uint64(l))
+	return n
+}
+
 func sovPetrichor(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozPetrichor(x uint64) (n int) {
 	return sovPetrichor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
+func (m *RewardWeightSchedulePoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -396,25 +1057,626 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeightSchedulePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeightSchedulePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPetrichor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPetrichor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PetrichorAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PetrichorAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValidatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RewardStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardChangeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRewardChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDelisting", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDelisting = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxValidatorShare = &v
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightMode", wireType)
+			}
+			m.RewardWeightMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightMode |= RewardWeightMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PetrichorAsset: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PetrichorAsset: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -442,13 +1704,17 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateSplits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPetrichor
@@ -458,31 +1724,31 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPetrichor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPetrichor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TakeRateSplits = append(m.TakeRateSplits, TakeRateSplit{})
+			if err := m.TakeRateSplits[len(m.TakeRateSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPetrichor
@@ -492,29 +1758,48 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPetrichor
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPetrichor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardWeightSchedule = append(m.RewardWeightSchedule, RewardWeightSchedulePoint{})
+			if err := m.RewardWeightSchedule[len(m.RewardWeightSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightInterpolation", wireType)
+			}
+			m.RewardWeightInterpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightInterpolation |= RewardWeightInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceCoverageCeiling", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -542,13 +1827,65 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.InsuranceCoverageCeiling = &v
+			if err := m.InsuranceCoverageCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPetrichor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPetrichor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardWeightChangeSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardWeightChangeSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevRewardWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -576,13 +1913,13 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalValidatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PrevRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistories", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -609,13 +1946,64 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.RewardStartTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardHistories = append(m.RewardHistories, RewardHistory{})
+			if err := m.RewardHistories[len(m.RewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPetrichor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TakeRateRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPetrichor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TakeRateRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TakeRateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -643,13 +2031,30 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -676,15 +2081,15 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastRewardChangeTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPetrichor
@@ -694,22 +2099,23 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPetrichor
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPetrichor
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastRewardChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -734,7 +2140,7 @@ func (m *PetrichorAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
+func (m *TakeRateAccrual) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -757,15 +2163,15 @@ func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardWeightChangeSnapshot: wiretype end group for non-group")
+			return fmt.Errorf("proto: TakeRateAccrual: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardWeightChangeSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TakeRateAccrual: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevRewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -793,13 +2199,45 @@ func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrevRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPetrichor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPetrichor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardHistories", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -826,8 +2264,7 @@ func (m *RewardWeightChangeSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardHistories = append(m.RewardHistories, RewardHistory{})
-			if err := m.RewardHistories[len(m.RewardHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastClaimTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
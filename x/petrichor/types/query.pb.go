@@ -9,22 +9,27 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
+	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -856,7 +861,8 @@ func (m *QueryIBCPetrichorDelegationRewardsRequest) XXX_DiscardUnknown() {
 var xxx_messageInfo_QueryIBCPetrichorDelegationRewardsRequest proto.InternalMessageInfo
 
 type QueryPetrichorDelegationRewardsResponse struct {
-	Rewards []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,rep,name=rewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"rewards"`
+	Rewards  []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,rep,name=rewards,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"rewards"`
+	Segments []DelegationRewardSegment                 `protobuf:"bytes,2,rep,name=segments,proto3" json:"segments"`
 }
 
 func (m *QueryPetrichorDelegationRewardsResponse) Reset() {
@@ -894,25 +900,27 @@ func (m *QueryPetrichorDelegationRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryPetrichorDelegationRewardsResponse proto.InternalMessageInfo
 
-type QueryPetrichorValidatorResponse struct {
-	ValidatorAddr         string          `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
-	TotalDelegationShares []types.DecCoin `protobuf:"bytes,2,rep,name=total_delegation_shares,json=totalDelegationShares,proto3" json:"total_delegation_shares"`
-	ValidatorShares       []types.DecCoin `protobuf:"bytes,3,rep,name=validator_shares,json=validatorShares,proto3" json:"validator_shares"`
-	TotalStaked           []types.DecCoin `protobuf:"bytes,4,rep,name=total_staked,json=totalStaked,proto3" json:"total_staked"`
+// DelegationRewardSegment is the part of the rewards accrued under a single reward weight.
+// Snapshot segments carry the height of the reward weight change, the last segment carries
+// the current block height and the current reward weight
+type DelegationRewardSegment struct {
+	Height       uint64                                   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	RewardWeight github_com_cosmos_cosmos_sdk_types.Dec   `protobuf:"bytes,2,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	Rewards      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *QueryPetrichorValidatorResponse) Reset()         { *m = QueryPetrichorValidatorResponse{} }
-func (m *QueryPetrichorValidatorResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPetrichorValidatorResponse) ProtoMessage()    {}
-func (*QueryPetrichorValidatorResponse) Descriptor() ([]byte, []int) {
+func (m *DelegationRewardSegment) Reset()         { *m = DelegationRewardSegment{} }
+func (m *DelegationRewardSegment) String() string { return proto.CompactTextString(m) }
+func (*DelegationRewardSegment) ProtoMessage()    {}
+func (*DelegationRewardSegment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a940d30fee11e7d5, []int{20}
 }
-func (m *QueryPetrichorValidatorResponse) XXX_Unmarshal(b []byte) error {
+func (m *DelegationRewardSegment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPetrichorValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DelegationRewardSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPetrichorValidatorResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DelegationRewardSegment.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -922,35 +930,35 @@ func (m *QueryPetrichorValidatorResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryPetrichorValidatorResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPetrichorValidatorResponse.Merge(m, src)
+func (m *DelegationRewardSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationRewardSegment.Merge(m, src)
 }
-func (m *QueryPetrichorValidatorResponse) XXX_Size() int {
+func (m *DelegationRewardSegment) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPetrichorValidatorResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPetrichorValidatorResponse.DiscardUnknown(m)
+func (m *DelegationRewardSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationRewardSegment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPetrichorValidatorResponse proto.InternalMessageInfo
+var xxx_messageInfo_DelegationRewardSegment proto.InternalMessageInfo
 
-type QueryPetrichorValidatorsResponse struct {
-	Validators []QueryPetrichorValidatorResponse `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
-	Pagination *query.PageResponse               `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+type QueryPetrichorDelegatorRewardsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPetrichorValidatorsResponse) Reset()         { *m = QueryPetrichorValidatorsResponse{} }
-func (m *QueryPetrichorValidatorsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPetrichorValidatorsResponse) ProtoMessage()    {}
-func (*QueryPetrichorValidatorsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPetrichorDelegatorRewardsRequest) Reset()         { *m = QueryPetrichorDelegatorRewardsRequest{} }
+func (m *QueryPetrichorDelegatorRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorDelegatorRewardsRequest) ProtoMessage()    {}
+func (*QueryPetrichorDelegatorRewardsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a940d30fee11e7d5, []int{21}
 }
-func (m *QueryPetrichorValidatorsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPetrichorDelegatorRewardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPetrichorValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPetrichorDelegatorRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPetrichorValidatorsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPetrichorDelegatorRewardsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -960,2021 +968,8577 @@ func (m *QueryPetrichorValidatorsResponse) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryPetrichorValidatorsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPetrichorValidatorsResponse.Merge(m, src)
+func (m *QueryPetrichorDelegatorRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorDelegatorRewardsRequest.Merge(m, src)
 }
-func (m *QueryPetrichorValidatorsResponse) XXX_Size() int {
+func (m *QueryPetrichorDelegatorRewardsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPetrichorValidatorsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPetrichorValidatorsResponse.DiscardUnknown(m)
+func (m *QueryPetrichorDelegatorRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorDelegatorRewardsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPetrichorValidatorsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPetrichorDelegatorRewardsRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "petrichor.petrichor.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "petrichor.petrichor.QueryParamsResponse")
-	proto.RegisterType((*QueryPetrichorsRequest)(nil), "petrichor.petrichor.QueryPetrichorsRequest")
-	proto.RegisterType((*QueryPetrichorsResponse)(nil), "petrichor.petrichor.QueryPetrichorsResponse")
-	proto.RegisterType((*QueryPetrichorRequest)(nil), "petrichor.petrichor.QueryPetrichorRequest")
-	proto.RegisterType((*QueryPetrichorResponse)(nil), "petrichor.petrichor.QueryPetrichorResponse")
-	proto.RegisterType((*QueryIBCPetrichorRequest)(nil), "petrichor.petrichor.QueryIBCPetrichorRequest")
-	proto.RegisterType((*QueryPetrichorValidatorRequest)(nil), "petrichor.petrichor.QueryPetrichorValidatorRequest")
-	proto.RegisterType((*QueryAllPetrichorValidatorsRequest)(nil), "petrichor.petrichor.QueryAllPetrichorValidatorsRequest")
-	proto.RegisterType((*QueryAllPetrichorsDelegationsRequest)(nil), "petrichor.petrichor.QueryAllPetrichorsDelegationsRequest")
-	proto.RegisterType((*QueryPetrichorsDelegationsRequest)(nil), "petrichor.petrichor.QueryPetrichorsDelegationsRequest")
-	proto.RegisterType((*QueryPetrichorsDelegationByValidatorRequest)(nil), "petrichor.petrichor.QueryPetrichorsDelegationByValidatorRequest")
-	proto.RegisterType((*DelegationResponse)(nil), "petrichor.petrichor.DelegationResponse")
-	proto.RegisterType((*QueryPetrichorsDelegationsResponse)(nil), "petrichor.petrichor.QueryPetrichorsDelegationsResponse")
-	proto.RegisterType((*QueryPetrichorDelegationRequest)(nil), "petrichor.petrichor.QueryPetrichorDelegationRequest")
-	proto.RegisterType((*QueryIBCPetrichorDelegationRequest)(nil), "petrichor.petrichor.QueryIBCPetrichorDelegationRequest")
-	proto.RegisterType((*QueryPetrichorDelegationResponse)(nil), "petrichor.petrichor.QueryPetrichorDelegationResponse")
-	proto.RegisterType((*QueryPetrichorDelegationRewardsRequest)(nil), "petrichor.petrichor.QueryPetrichorDelegationRewardsRequest")
-	proto.RegisterType((*QueryIBCPetrichorDelegationRewardsRequest)(nil), "petrichor.petrichor.QueryIBCPetrichorDelegationRewardsRequest")
-	proto.RegisterType((*QueryPetrichorDelegationRewardsResponse)(nil), "petrichor.petrichor.QueryPetrichorDelegationRewardsResponse")
-	proto.RegisterType((*QueryPetrichorValidatorResponse)(nil), "petrichor.petrichor.QueryPetrichorValidatorResponse")
-	proto.RegisterType((*QueryPetrichorValidatorsResponse)(nil), "petrichor.petrichor.QueryPetrichorValidatorsResponse")
+// AssetRewards are the rewards of a delegation to a validator for a single asset
+type AssetRewards struct {
+	Denom   string                                   `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func init() { proto.RegisterFile("petrichor/query.proto", fileDescriptor_a940d30fee11e7d5) }
-
-var fileDescriptor_a940d30fee11e7d5 = []byte{
-	// 1287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x4e, 0x9a, 0x92, 0x97, 0x52, 0xe0, 0xe5, 0x47, 0x1d, 0x27, 0xb5, 0xd3, 0xa5,
-	0x69, 0x42, 0x4b, 0x76, 0x69, 0x42, 0x5b, 0xa5, 0x20, 0x20, 0x49, 0xd3, 0x10, 0x20, 0x34, 0x75,
-	0x25, 0x0e, 0xe1, 0x10, 0xd6, 0xde, 0x95, 0x63, 0xc5, 0xf1, 0x3a, 0xbb, 0x9b, 0xb4, 0x51, 0x64,
-	0x09, 0xf5, 0x02, 0xc7, 0x4a, 0x9c, 0x90, 0x38, 0xf4, 0x1f, 0x80, 0x1b, 0x1c, 0xe1, 0xc2, 0xa1,
-	0x17, 0xa4, 0xa2, 0x1e, 0xa8, 0x10, 0x0a, 0x28, 0x29, 0x12, 0x17, 0xfe, 0x07, 0xe4, 0xd9, 0xd9,
-	0x9d, 0xb1, 0x77, 0x6d, 0xaf, 0x1d, 0x1b, 0xa9, 0xa7, 0x6e, 0xc7, 0xf3, 0xde, 0x7c, 0xbe, 0x6f,
-	0xde, 0xbc, 0x79, 0x13, 0x18, 0x2c, 0xea, 0xb6, 0x99, 0xcb, 0x6c, 0x18, 0xa6, 0xb2, 0xbd, 0xa3,
-	0x9b, 0x7b, 0x72, 0xd1, 0x34, 0x6c, 0x03, 0xfb, 0xbd, 0x61, 0xd9, 0xfb, 0x8a, 0x0f, 0x64, 0x8d,
-	0xac, 0x41, 0x7f, 0x57, 0xca, 0x5f, 0xce, 0xd4, 0xf8, 0x68, 0xd6, 0x30, 0xb2, 0x79, 0x5d, 0x51,
-	0x8b, 0x39, 0x45, 0x2d, 0x14, 0x0c, 0x5b, 0xb5, 0x73, 0x46, 0xc1, 0x62, 0xbf, 0x5e, 0xcc, 0x18,
-	0xd6, 0x96, 0x61, 0x29, 0x69, 0xd5, 0xd2, 0x9d, 0x15, 0x94, 0xdd, 0xcb, 0x69, 0xdd, 0x56, 0x2f,
-	0x2b, 0x45, 0x35, 0x9b, 0x2b, 0xd0, 0xc9, 0x6c, 0xee, 0x10, 0x67, 0x29, 0xaa, 0xa6, 0xba, 0xe5,
-	0xfa, 0x18, 0x16, 0xc6, 0x39, 0x16, 0xfd, 0x29, 0x21, 0xba, 0x77, 0x1d, 0x67, 0x8c, 0x9c, 0xeb,
-	0x72, 0x84, 0x9b, 0x6a, 0x7a, 0x5e, 0xcf, 0x8a, 0x6c, 0xd2, 0x00, 0xe0, 0xed, 0x32, 0xd1, 0x2a,
-	0x5d, 0x2c, 0xa5, 0x6f, 0xef, 0xe8, 0x96, 0x2d, 0xad, 0x42, 0x7f, 0xc5, 0xa8, 0x55, 0x34, 0x0a,
-	0x96, 0x8e, 0xb3, 0xd0, 0xe3, 0x40, 0xc5, 0xc8, 0x18, 0x99, 0xec, 0x9b, 0x1e, 0x91, 0x03, 0x42,
-	0x24, 0x3b, 0x46, 0xf3, 0xdd, 0x8f, 0x0e, 0x92, 0x91, 0x14, 0x33, 0x90, 0x3e, 0x83, 0x21, 0xc7,
-	0xa3, 0x3b, 0xcd, 0x5d, 0x0b, 0x6f, 0x02, 0xf0, 0x28, 0x30, 0xc7, 0x17, 0x64, 0x47, 0x93, 0x5c,
-	0xd6, 0x24, 0x3b, 0x9b, 0xc2, 0x94, 0xc9, 0xab, 0x6a, 0x56, 0x67, 0xb6, 0x29, 0xc1, 0x52, 0xfa,
-	0x96, 0xc0, 0x19, 0xdf, 0x12, 0x0c, 0x7c, 0x19, 0xc0, 0xe3, 0x2b, 0xc3, 0x77, 0x4d, 0xf6, 0x4d,
-	0xbf, 0x1a, 0x0c, 0xef, 0x7e, 0xcd, 0x59, 0x96, 0x6e, 0x33, 0x11, 0x82, 0x31, 0x2e, 0x55, 0xe0,
-	0x46, 0x29, 0xee, 0x44, 0x43, 0x5c, 0x87, 0xa3, 0x82, 0x77, 0x0a, 0x06, 0x2b, 0x71, 0xdd, 0x80,
-	0x0c, 0xc0, 0x09, 0x4d, 0x2f, 0x18, 0x5b, 0x34, 0x16, 0xbd, 0x29, 0xe7, 0x3f, 0xd2, 0xa7, 0xd5,
-	0x01, 0xf4, 0xc4, 0xcd, 0x41, 0xaf, 0xc7, 0xc7, 0xe2, 0x17, 0x46, 0x5b, 0x8a, 0x5b, 0x49, 0x32,
-	0xc4, 0xa8, 0xf3, 0xe5, 0xf9, 0x05, 0x1f, 0x0e, 0x42, 0xf7, 0x86, 0x6a, 0x6d, 0x30, 0x1a, 0xfa,
-	0x2d, 0xdd, 0x86, 0x44, 0x25, 0xcc, 0x27, 0x6a, 0x3e, 0xa7, 0xa9, 0x36, 0xb7, 0x1a, 0x87, 0xd3,
-	0xbb, 0xee, 0xd8, 0xba, 0xaa, 0x69, 0x26, 0xb3, 0x7f, 0xd1, 0x1b, 0x9d, 0xd3, 0x34, 0xf3, 0xfa,
-	0x0b, 0x5f, 0x3e, 0x4c, 0x46, 0xfe, 0x79, 0x98, 0x8c, 0x48, 0xbb, 0x20, 0x51, 0x97, 0x73, 0xf9,
-	0xbc, 0xdf, 0x6b, 0xbb, 0x93, 0x45, 0x58, 0xf7, 0x1e, 0x9c, 0xf7, 0xad, 0x6b, 0xdd, 0xe0, 0xe7,
-	0xa4, 0x73, 0x2b, 0x7f, 0x43, 0xe0, 0x5c, 0x55, 0xc2, 0x06, 0xac, 0x3b, 0x0e, 0xa7, 0xd9, 0xa9,
-	0xad, 0x0a, 0xa4, 0x37, 0x5a, 0x0e, 0x64, 0x15, 0x5e, 0xb4, 0x0d, 0x78, 0xbf, 0x10, 0xb8, 0x54,
-	0x13, 0x6f, 0x7e, 0x2f, 0x68, 0xc7, 0xc3, 0x80, 0xfa, 0x13, 0x23, 0x1a, 0x90, 0x18, 0x55, 0x7a,
-	0xba, 0xda, 0x13, 0x6e, 0xe4, 0x02, 0xbc, 0xd3, 0xb3, 0x08, 0xc0, 0xab, 0x22, 0xdb, 0xd7, 0x64,
-	0xe0, 0xf1, 0x11, 0xd4, 0xb3, 0xb2, 0xc0, 0x0d, 0x71, 0x16, 0x4e, 0xa6, 0xd5, 0xbc, 0x5a, 0xc8,
-	0xe8, 0x2c, 0xf8, 0xc3, 0x15, 0xb0, 0x2e, 0xe6, 0x82, 0x91, 0x73, 0xad, 0xdd, 0xf9, 0xd7, 0xbb,
-	0x29, 0xde, 0x8f, 0x84, 0x1d, 0x80, 0x1a, 0xd9, 0xc0, 0x70, 0x6f, 0x41, 0x9f, 0x50, 0xc4, 0x59,
-	0x29, 0x9b, 0x68, 0xc0, 0xeb, 0x5a, 0xb3, 0x95, 0x45, 0x0f, 0xed, 0xab, 0x67, 0xbf, 0x11, 0x48,
-	0x56, 0x0a, 0x10, 0x01, 0x3a, 0x91, 0x23, 0x5e, 0xa1, 0xec, 0x12, 0x0a, 0x65, 0x55, 0xe6, 0x74,
-	0xb7, 0x21, 0x73, 0x9e, 0xba, 0x5b, 0x23, 0x96, 0xc7, 0x4e, 0x8b, 0x73, 0xcb, 0x6e, 0x17, 0x2f,
-	0xbb, 0x1d, 0x90, 0xb6, 0x0d, 0x63, 0xb5, 0xf7, 0x8c, 0xa5, 0xdc, 0x4a, 0xc0, 0x09, 0x69, 0x32,
-	0xe3, 0x04, 0x07, 0xd2, 0x01, 0x81, 0x0b, 0xb5, 0xd7, 0xbc, 0xab, 0x9a, 0x9a, 0xf5, 0x7c, 0xa7,
-	0xcb, 0x9f, 0x04, 0x5e, 0xab, 0x9b, 0x2e, 0x1d, 0xd4, 0xf8, 0xff, 0x64, 0xcd, 0xd7, 0x04, 0x26,
-	0x1a, 0x6e, 0x21, 0xcb, 0x1e, 0x0d, 0x4e, 0x9a, 0xce, 0x10, 0x2b, 0x56, 0x75, 0x0a, 0xa3, 0x52,
-	0x4e, 0x96, 0xdf, 0x0f, 0x92, 0x13, 0xd9, 0x9c, 0xbd, 0xb1, 0x93, 0x96, 0x33, 0xc6, 0x96, 0xc2,
-	0x9a, 0x5b, 0xe7, 0x9f, 0x29, 0x4b, 0xdb, 0x54, 0xec, 0xbd, 0xa2, 0x6e, 0x51, 0x83, 0x94, 0xeb,
-	0x5a, 0x60, 0xfb, 0x39, 0x5a, 0x5d, 0x86, 0x84, 0x9b, 0x8a, 0x31, 0x85, 0x6b, 0x4e, 0x70, 0x0d,
-	0xce, 0xd8, 0x86, 0xad, 0xe6, 0xd7, 0x79, 0xf6, 0xae, 0x5b, 0x1b, 0xaa, 0xa9, 0x5b, 0xb1, 0x28,
-	0x95, 0x32, 0x1a, 0x28, 0xe5, 0x86, 0x9e, 0x11, 0xca, 0xfc, 0x20, 0x75, 0xc1, 0xe3, 0x73, 0x87,
-	0x3a, 0xc0, 0x15, 0x78, 0x99, 0x23, 0x30, 0xa7, 0x5d, 0xa1, 0x9d, 0xbe, 0xe4, 0xd9, 0x32, 0x77,
-	0x8b, 0x70, 0xca, 0x41, 0xb5, 0x6c, 0x75, 0x53, 0xd7, 0x62, 0xdd, 0xa1, 0x5d, 0xf5, 0x51, 0xbb,
-	0x3b, 0xd4, 0x4c, 0x08, 0xe3, 0xaf, 0xa4, 0xba, 0x32, 0x88, 0xcd, 0x18, 0x8b, 0xe3, 0x1a, 0x80,
-	0x07, 0xe2, 0x6e, 0xef, 0x9b, 0x81, 0x95, 0xa1, 0xc1, 0x8e, 0xb8, 0x65, 0x82, 0x7b, 0x6b, 0xdb,
-	0xbd, 0xc4, 0x35, 0x4d, 0x7f, 0xde, 0x0f, 0x27, 0x28, 0x08, 0x96, 0xa0, 0xc7, 0x79, 0xa5, 0xe0,
-	0x44, 0x1d, 0x5c, 0xf1, 0x49, 0x14, 0x9f, 0x6c, 0x3c, 0xd1, 0x59, 0x5c, 0x1a, 0xbb, 0xff, 0xe4,
-	0xd9, 0x57, 0xd1, 0x38, 0xc6, 0x14, 0x5b, 0x37, 0x4d, 0x95, 0xbf, 0xd7, 0x2c, 0xf6, 0xa4, 0xc3,
-	0xfb, 0x04, 0x80, 0x5f, 0xf3, 0x78, 0x29, 0x44, 0xc8, 0x3c, 0x8e, 0xd7, 0xc3, 0x4d, 0x66, 0x2c,
-	0xc3, 0x94, 0xa5, 0x1f, 0x5f, 0xf1, 0xb1, 0xe0, 0x03, 0x02, 0xa7, 0xc4, 0x0a, 0x85, 0x53, 0xb5,
-	0x3d, 0x07, 0xbc, 0x0b, 0xe2, 0x61, 0xa8, 0x3d, 0x8e, 0xf3, 0x94, 0x23, 0x81, 0xa3, 0xfe, 0x98,
-	0xe4, 0xd2, 0x19, 0x65, 0xbf, 0x5c, 0xa8, 0x4a, 0xf8, 0x3d, 0x81, 0x58, 0xad, 0x3e, 0x1c, 0x67,
-	0x6b, 0xaf, 0xd7, 0xa0, 0x77, 0x8f, 0x5f, 0x0b, 0x13, 0xb3, 0x80, 0x6e, 0x4b, 0x1a, 0xa7, 0xd8,
-	0x49, 0x3c, 0xeb, 0xc7, 0x16, 0x7b, 0xa8, 0x1f, 0x08, 0xa0, 0x3f, 0xb9, 0x71, 0xa6, 0xb9, 0xa3,
-	0xe0, 0xb0, 0xb6, 0x74, 0x7e, 0xa4, 0x2b, 0x14, 0x54, 0xc1, 0x29, 0x3f, 0x28, 0x3f, 0x53, 0xca,
-	0x7e, 0x65, 0xd5, 0x2b, 0xe1, 0x77, 0x04, 0x86, 0x82, 0x1f, 0x5c, 0x78, 0x2d, 0x5c, 0xb8, 0x7d,
-	0x4f, 0xb4, 0xf8, 0x95, 0x66, 0x04, 0x58, 0x61, 0x32, 0x44, 0xa8, 0x0a, 0x3f, 0x11, 0x18, 0x08,
-	0xda, 0x32, 0xbc, 0xda, 0xf4, 0x16, 0x1f, 0x33, 0x35, 0xae, 0x52, 0xde, 0x37, 0x50, 0xae, 0x9b,
-	0x1a, 0xca, 0x7e, 0xe5, 0xe5, 0x5e, 0xc2, 0xbf, 0x09, 0x24, 0x1b, 0xbc, 0xa8, 0xf0, 0xbd, 0xe6,
-	0xa0, 0xfc, 0x8f, 0xb1, 0xd6, 0x65, 0x2d, 0x51, 0x59, 0x73, 0xf8, 0x6e, 0x73, 0xb2, 0xfc, 0xa9,
-	0xf5, 0x84, 0x40, 0x7f, 0x40, 0x7b, 0x80, 0x61, 0xf2, 0xdb, 0xd7, 0x5b, 0x87, 0x4a, 0x2a, 0x7f,
-	0xf7, 0x29, 0xdd, 0xa2, 0x6a, 0x96, 0x71, 0xe9, 0x98, 0x6a, 0xca, 0x33, 0x0a, 0xc6, 0x56, 0x09,
-	0xff, 0x20, 0x30, 0x14, 0xdc, 0xd6, 0xd5, 0x3b, 0x30, 0x75, 0xdf, 0x0d, 0xad, 0x6a, 0x4b, 0x51,
-	0x6d, 0x1f, 0xe1, 0x07, 0xc7, 0xd5, 0x26, 0x14, 0xe0, 0x67, 0x04, 0xe2, 0xb5, 0x7b, 0x3a, 0x7c,
-	0xab, 0x49, 0x52, 0xb1, 0xd1, 0x8d, 0xbf, 0xdd, 0x9a, 0x31, 0x53, 0xfb, 0x21, 0x55, 0xbb, 0x88,
-	0x0b, 0x7e, 0xb5, 0xac, 0x07, 0x6c, 0x62, 0x17, 0xff, 0x25, 0x70, 0xb6, 0x6e, 0x73, 0x8e, 0xef,
-	0x34, 0xbf, 0x99, 0x6d, 0x14, 0xfb, 0x31, 0x15, 0xfb, 0x3e, 0xde, 0x3c, 0x8e, 0x58, 0x61, 0x5b,
-	0xbf, 0x20, 0xd0, 0xcb, 0xef, 0xf9, 0x8b, 0xa1, 0x2e, 0xee, 0x16, 0x2e, 0xf9, 0x73, 0x14, 0x7b,
-	0x04, 0x87, 0xfd, 0xd8, 0x2c, 0xf2, 0xf3, 0x2b, 0x8f, 0x0e, 0x13, 0xe4, 0xf1, 0x61, 0x82, 0xfc,
-	0x75, 0x98, 0x20, 0x0f, 0x8e, 0x12, 0x91, 0xc7, 0x47, 0x89, 0xc8, 0xd3, 0xa3, 0x44, 0x64, 0x6d,
-	0x46, 0xe8, 0xf9, 0xa9, 0x61, 0x41, 0xb7, 0xef, 0x1a, 0xe6, 0x26, 0xf7, 0xa2, 0xdc, 0x13, 0xbe,
-	0xe9, 0x23, 0x20, 0xdd, 0x43, 0xff, 0x88, 0x3d, 0xf3, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6d,
-	0x7d, 0xd2, 0x23, 0xc2, 0x17, 0x00, 0x00,
+func (m *AssetRewards) Reset()         { *m = AssetRewards{} }
+func (m *AssetRewards) String() string { return proto.CompactTextString(m) }
+func (*AssetRewards) ProtoMessage()    {}
+func (*AssetRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{22}
+}
+func (m *AssetRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetRewards.Merge(m, src)
+}
+func (m *AssetRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetRewards.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_AssetRewards proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Query paginated petrichors
-	Petrichors(ctx context.Context, in *QueryPetrichorsRequest, opts ...grpc.CallOption) (*QueryPetrichorsResponse, error)
-	// Query a specific petrichor by ibc hash
-	IBCPetrichor(ctx context.Context, in *QueryIBCPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error)
-	// Query all paginated petrichor delegations
-	AllPetrichorsDelegations(ctx context.Context, in *QueryAllPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error)
-	// Query petrichor validator
-	PetrichorValidator(ctx context.Context, in *QueryPetrichorValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorResponse, error)
-	// Query all paginated petrichor validators
-	AllPetrichorValidators(ctx context.Context, in *QueryAllPetrichorValidatorsRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorsResponse, error)
-	// Query all paginated petrichor delegations for a delegator addr
-	PetrichorsDelegation(ctx context.Context, in *QueryPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error)
-	// Query all paginated petrichor delegations for a delegator addr and validator_addr
-	PetrichorsDelegationByValidator(ctx context.Context, in *QueryPetrichorsDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error)
-	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
-	PetrichorDelegation(ctx context.Context, in *QueryPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error)
-	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
-	IBCPetrichorDelegation(ctx context.Context, in *QueryIBCPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	PetrichorDelegationRewards(ctx context.Context, in *QueryPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	IBCPetrichorDelegationRewards(ctx context.Context, in *QueryIBCPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error)
-	// Query a specific petrichor by denom
-	Petrichor(ctx context.Context, in *QueryPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error)
+// ValidatorRewards groups the rewards of a delegator's delegations to a validator
+type ValidatorRewards struct {
+	ValidatorAddr string                                   `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Assets        []AssetRewards                           `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets"`
+	Total         github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *ValidatorRewards) Reset()         { *m = ValidatorRewards{} }
+func (m *ValidatorRewards) String() string { return proto.CompactTextString(m) }
+func (*ValidatorRewards) ProtoMessage()    {}
+func (*ValidatorRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{23}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *ValidatorRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidatorRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Petrichors(ctx context.Context, in *QueryPetrichorsRequest, opts ...grpc.CallOption) (*QueryPetrichorsResponse, error) {
-	out := new(QueryPetrichorsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/Petrichors", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidatorRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorRewards.Merge(m, src)
 }
-
-func (c *queryClient) IBCPetrichor(ctx context.Context, in *QueryIBCPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error) {
-	out := new(QueryPetrichorResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/IBCPetrichor", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidatorRewards) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) AllPetrichorsDelegations(ctx context.Context, in *QueryAllPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error) {
-	out := new(QueryPetrichorsDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/AllPetrichorsDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidatorRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorRewards.DiscardUnknown(m)
 }
 
-func (c *queryClient) PetrichorValidator(ctx context.Context, in *QueryPetrichorValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorResponse, error) {
-	out := new(QueryPetrichorValidatorResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_ValidatorRewards proto.InternalMessageInfo
 
-func (c *queryClient) AllPetrichorValidators(ctx context.Context, in *QueryAllPetrichorValidatorsRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorsResponse, error) {
-	out := new(QueryPetrichorValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/AllPetrichorValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPetrichorDelegatorRewardsResponse struct {
+	Validators []ValidatorRewards `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	// total of the rewards in this page
+	Total      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	Pagination *query.PageResponse                      `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PetrichorsDelegation(ctx context.Context, in *QueryPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error) {
-	out := new(QueryPetrichorsDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorsDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPetrichorDelegatorRewardsResponse) Reset() {
+	*m = QueryPetrichorDelegatorRewardsResponse{}
 }
-
-func (c *queryClient) PetrichorsDelegationByValidator(ctx context.Context, in *QueryPetrichorsDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error) {
-	out := new(QueryPetrichorsDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorsDelegationByValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPetrichorDelegatorRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorDelegatorRewardsResponse) ProtoMessage()    {}
+func (*QueryPetrichorDelegatorRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{24}
 }
-
-func (c *queryClient) PetrichorDelegation(ctx context.Context, in *QueryPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error) {
-	out := new(QueryPetrichorDelegationResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPetrichorDelegatorRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) IBCPetrichorDelegation(ctx context.Context, in *QueryIBCPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error) {
-	out := new(QueryPetrichorDelegationResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/IBCPetrichorDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorDelegatorRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorDelegatorRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPetrichorDelegatorRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorDelegatorRewardsResponse.Merge(m, src)
+}
+func (m *QueryPetrichorDelegatorRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorDelegatorRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorDelegatorRewardsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PetrichorDelegationRewards(ctx context.Context, in *QueryPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error) {
-	out := new(QueryPetrichorDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPetrichorDelegatorRewardsResponse proto.InternalMessageInfo
+
+// Undelegations
+type QueryPetrichorUndelegationsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) IBCPetrichorDelegationRewards(ctx context.Context, in *QueryIBCPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error) {
-	out := new(QueryPetrichorDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/IBCPetrichorDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorUndelegationsRequest) Reset()         { *m = QueryPetrichorUndelegationsRequest{} }
+func (m *QueryPetrichorUndelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorUndelegationsRequest) ProtoMessage()    {}
+func (*QueryPetrichorUndelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{25}
+}
+func (m *QueryPetrichorUndelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorUndelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorUndelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPetrichorUndelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorUndelegationsRequest.Merge(m, src)
+}
+func (m *QueryPetrichorUndelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorUndelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorUndelegationsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Petrichor(ctx context.Context, in *QueryPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error) {
-	out := new(QueryPetrichorResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/Petrichor", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryPetrichorUndelegationsRequest proto.InternalMessageInfo
+
+type QueryPetrichorUndelegationsByValidatorRequest struct {
+	ValidatorAddr string             `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Query paginated petrichors
-	Petrichors(context.Context, *QueryPetrichorsRequest) (*QueryPetrichorsResponse, error)
-	// Query a specific petrichor by ibc hash
-	IBCPetrichor(context.Context, *QueryIBCPetrichorRequest) (*QueryPetrichorResponse, error)
-	// Query all paginated petrichor delegations
-	AllPetrichorsDelegations(context.Context, *QueryAllPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error)
-	// Query petrichor validator
-	PetrichorValidator(context.Context, *QueryPetrichorValidatorRequest) (*QueryPetrichorValidatorResponse, error)
-	// Query all paginated petrichor validators
-	AllPetrichorValidators(context.Context, *QueryAllPetrichorValidatorsRequest) (*QueryPetrichorValidatorsResponse, error)
-	// Query all paginated petrichor delegations for a delegator addr
-	PetrichorsDelegation(context.Context, *QueryPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error)
-	// Query all paginated petrichor delegations for a delegator addr and validator_addr
-	PetrichorsDelegationByValidator(context.Context, *QueryPetrichorsDelegationByValidatorRequest) (*QueryPetrichorsDelegationsResponse, error)
-	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
-	PetrichorDelegation(context.Context, *QueryPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error)
-	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
-	IBCPetrichorDelegation(context.Context, *QueryIBCPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	PetrichorDelegationRewards(context.Context, *QueryPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error)
-	// Query for rewards by delegator addr, validator_addr and denom
-	IBCPetrichorDelegationRewards(context.Context, *QueryIBCPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error)
-	// Query a specific petrichor by denom
-	Petrichor(context.Context, *QueryPetrichorRequest) (*QueryPetrichorResponse, error)
+func (m *QueryPetrichorUndelegationsByValidatorRequest) Reset() {
+	*m = QueryPetrichorUndelegationsByValidatorRequest{}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPetrichorUndelegationsByValidatorRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (*QueryPetrichorUndelegationsByValidatorRequest) ProtoMessage() {}
+func (*QueryPetrichorUndelegationsByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{26}
 }
-func (*UnimplementedQueryServer) Petrichors(ctx context.Context, req *QueryPetrichorsRequest) (*QueryPetrichorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Petrichors not implemented")
+func (m *QueryPetrichorUndelegationsByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IBCPetrichor(ctx context.Context, req *QueryIBCPetrichorRequest) (*QueryPetrichorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCPetrichor not implemented")
+func (m *QueryPetrichorUndelegationsByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorUndelegationsByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllPetrichorsDelegations(ctx context.Context, req *QueryAllPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPetrichorsDelegations not implemented")
+func (m *QueryPetrichorUndelegationsByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorUndelegationsByValidatorRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PetrichorValidator(ctx context.Context, req *QueryPetrichorValidatorRequest) (*QueryPetrichorValidatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PetrichorValidator not implemented")
+func (m *QueryPetrichorUndelegationsByValidatorRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AllPetrichorValidators(ctx context.Context, req *QueryAllPetrichorValidatorsRequest) (*QueryPetrichorValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllPetrichorValidators not implemented")
+func (m *QueryPetrichorUndelegationsByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorUndelegationsByValidatorRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) PetrichorsDelegation(ctx context.Context, req *QueryPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PetrichorsDelegation not implemented")
+
+var xxx_messageInfo_QueryPetrichorUndelegationsByValidatorRequest proto.InternalMessageInfo
+
+type QueryPetrichorUndelegationsByAssetRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) PetrichorsDelegationByValidator(ctx context.Context, req *QueryPetrichorsDelegationByValidatorRequest) (*QueryPetrichorsDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PetrichorsDelegationByValidator not implemented")
+
+func (m *QueryPetrichorUndelegationsByAssetRequest) Reset() {
+	*m = QueryPetrichorUndelegationsByAssetRequest{}
 }
-func (*UnimplementedQueryServer) PetrichorDelegation(ctx context.Context, req *QueryPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PetrichorDelegation not implemented")
+func (m *QueryPetrichorUndelegationsByAssetRequest) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) IBCPetrichorDelegation(ctx context.Context, req *QueryIBCPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCPetrichorDelegation not implemented")
+func (*QueryPetrichorUndelegationsByAssetRequest) ProtoMessage() {}
+func (*QueryPetrichorUndelegationsByAssetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{27}
 }
-func (*UnimplementedQueryServer) PetrichorDelegationRewards(ctx context.Context, req *QueryPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PetrichorDelegationRewards not implemented")
+func (m *QueryPetrichorUndelegationsByAssetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IBCPetrichorDelegationRewards(ctx context.Context, req *QueryIBCPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IBCPetrichorDelegationRewards not implemented")
+func (m *QueryPetrichorUndelegationsByAssetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorUndelegationsByAssetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Petrichor(ctx context.Context, req *QueryPetrichorRequest) (*QueryPetrichorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Petrichor not implemented")
+func (m *QueryPetrichorUndelegationsByAssetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorUndelegationsByAssetRequest.Merge(m, src)
+}
+func (m *QueryPetrichorUndelegationsByAssetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorUndelegationsByAssetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorUndelegationsByAssetRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryPetrichorUndelegationsByAssetRequest proto.InternalMessageInfo
+
+// UndelegationResponse is a queued undelegation. The balance of the undelegation
+// is reduced when the validator is slashed before the completion time
+type UndelegationResponse struct {
+	Undelegation   Undelegation `protobuf:"bytes,1,opt,name=undelegation,proto3" json:"undelegation"`
+	CompletionTime time.Time    `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *UndelegationResponse) Reset()         { *m = UndelegationResponse{} }
+func (m *UndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*UndelegationResponse) ProtoMessage()    {}
+func (*UndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{28}
+}
+func (m *UndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *UndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UndelegationResponse.Merge(m, src)
+}
+func (m *UndelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UndelegationResponse.DiscardUnknown(m)
 }
 
-func _Query_Petrichors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPetrichorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Petrichors(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/Petrichors",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Petrichors(ctx, req.(*QueryPetrichorsRequest))
+var xxx_messageInfo_UndelegationResponse proto.InternalMessageInfo
+
+func (m *UndelegationResponse) GetUndelegation() Undelegation {
+	if m != nil {
+		return m.Undelegation
 	}
-	return interceptor(ctx, in, info, handler)
+	return Undelegation{}
 }
 
-func _Query_IBCPetrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCPetrichorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCPetrichor(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/IBCPetrichor",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCPetrichor(ctx, req.(*QueryIBCPetrichorRequest))
+func (m *UndelegationResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_AllPetrichorsDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPetrichorsDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPetrichorsDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/AllPetrichorsDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPetrichorsDelegations(ctx, req.(*QueryAllPetrichorsDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPetrichorUndelegationsResponse struct {
+	Undelegations []UndelegationResponse `protobuf:"bytes,1,rep,name=undelegations,proto3" json:"undelegations"`
+	Pagination    *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PetrichorValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPetrichorValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PetrichorValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/PetrichorValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PetrichorValidator(ctx, req.(*QueryPetrichorValidatorRequest))
+func (m *QueryPetrichorUndelegationsResponse) Reset()         { *m = QueryPetrichorUndelegationsResponse{} }
+func (m *QueryPetrichorUndelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorUndelegationsResponse) ProtoMessage()    {}
+func (*QueryPetrichorUndelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{29}
+}
+func (m *QueryPetrichorUndelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorUndelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorUndelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPetrichorUndelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorUndelegationsResponse.Merge(m, src)
+}
+func (m *QueryPetrichorUndelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorUndelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorUndelegationsResponse.DiscardUnknown(m)
 }
 
-func _Query_AllPetrichorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPetrichorValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllPetrichorValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/AllPetrichorValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllPetrichorValidators(ctx, req.(*QueryAllPetrichorValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryPetrichorUndelegationsResponse proto.InternalMessageInfo
+
+// Redelegations
+type QueryPetrichorRedelegationsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_PetrichorsDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPetrichorsDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PetrichorsDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/PetrichorsDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PetrichorsDelegation(ctx, req.(*QueryPetrichorsDelegationsRequest))
+func (m *QueryPetrichorRedelegationsRequest) Reset()         { *m = QueryPetrichorRedelegationsRequest{} }
+func (m *QueryPetrichorRedelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorRedelegationsRequest) ProtoMessage()    {}
+func (*QueryPetrichorRedelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{30}
+}
+func (m *QueryPetrichorRedelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorRedelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorRedelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPetrichorRedelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorRedelegationsRequest.Merge(m, src)
+}
+func (m *QueryPetrichorRedelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorRedelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorRedelegationsRequest.DiscardUnknown(m)
 }
 
-func _Query_PetrichorsDelegationByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPetrichorsDelegationByValidatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PetrichorsDelegationByValidator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/PetrichorsDelegationByValidator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PetrichorsDelegationByValidator(ctx, req.(*QueryPetrichorsDelegationByValidatorRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryPetrichorRedelegationsRequest proto.InternalMessageInfo
 
-func _Query_PetrichorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPetrichorDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PetrichorDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/PetrichorDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PetrichorDelegation(ctx, req.(*QueryPetrichorDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPetrichorRedelegationsByValidatorRequest struct {
+	ValidatorAddr string             `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_IBCPetrichorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCPetrichorDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCPetrichorDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/IBCPetrichorDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCPetrichorDelegation(ctx, req.(*QueryIBCPetrichorDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPetrichorRedelegationsByValidatorRequest) Reset() {
+	*m = QueryPetrichorRedelegationsByValidatorRequest{}
 }
-
-func _Query_PetrichorDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPetrichorDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PetrichorDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/PetrichorDelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PetrichorDelegationRewards(ctx, req.(*QueryPetrichorDelegationRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPetrichorRedelegationsByValidatorRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func _Query_IBCPetrichorDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIBCPetrichorDelegationRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IBCPetrichorDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/IBCPetrichorDelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IBCPetrichorDelegationRewards(ctx, req.(*QueryIBCPetrichorDelegationRewardsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (*QueryPetrichorRedelegationsByValidatorRequest) ProtoMessage() {}
+func (*QueryPetrichorRedelegationsByValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{31}
 }
-
-func _Query_Petrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPetrichorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Petrichor(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Query/Petrichor",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Petrichor(ctx, req.(*QueryPetrichorRequest))
+func (m *QueryPetrichorRedelegationsByValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorRedelegationsByValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorRedelegationsByValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPetrichorRedelegationsByValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorRedelegationsByValidatorRequest.Merge(m, src)
+}
+func (m *QueryPetrichorRedelegationsByValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorRedelegationsByValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorRedelegationsByValidatorRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "petrichor.petrichor.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Petrichors",
-			Handler:    _Query_Petrichors_Handler,
-		},
-		{
-			MethodName: "IBCPetrichor",
-			Handler:    _Query_IBCPetrichor_Handler,
-		},
-		{
-			MethodName: "AllPetrichorsDelegations",
-			Handler:    _Query_AllPetrichorsDelegations_Handler,
-		},
-		{
-			MethodName: "PetrichorValidator",
-			Handler:    _Query_PetrichorValidator_Handler,
-		},
-		{
-			MethodName: "AllPetrichorValidators",
-			Handler:    _Query_AllPetrichorValidators_Handler,
-		},
-		{
-			MethodName: "PetrichorsDelegation",
-			Handler:    _Query_PetrichorsDelegation_Handler,
-		},
-		{
-			MethodName: "PetrichorsDelegationByValidator",
-			Handler:    _Query_PetrichorsDelegationByValidator_Handler,
-		},
-		{
-			MethodName: "PetrichorDelegation",
-			Handler:    _Query_PetrichorDelegation_Handler,
-		},
-		{
-			MethodName: "IBCPetrichorDelegation",
-			Handler:    _Query_IBCPetrichorDelegation_Handler,
-		},
-		{
-			MethodName: "PetrichorDelegationRewards",
-			Handler:    _Query_PetrichorDelegationRewards_Handler,
-		},
-		{
-			MethodName: "IBCPetrichorDelegationRewards",
-			Handler:    _Query_IBCPetrichorDelegationRewards_Handler,
-		},
-		{
-			MethodName: "Petrichor",
-			Handler:    _Query_Petrichor_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "petrichor/query.proto",
+var xxx_messageInfo_QueryPetrichorRedelegationsByValidatorRequest proto.InternalMessageInfo
+
+type QueryPetrichorRedelegationsByAssetRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorRedelegationsByAssetRequest) Reset() {
+	*m = QueryPetrichorRedelegationsByAssetRequest{}
+}
+func (m *QueryPetrichorRedelegationsByAssetRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPetrichorRedelegationsByAssetRequest) ProtoMessage() {}
+func (*QueryPetrichorRedelegationsByAssetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{32}
+}
+func (m *QueryPetrichorRedelegationsByAssetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorRedelegationsByAssetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorRedelegationsByAssetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorRedelegationsByAssetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorRedelegationsByAssetRequest.Merge(m, src)
+}
+func (m *QueryPetrichorRedelegationsByAssetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorRedelegationsByAssetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorRedelegationsByAssetRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPetrichorRedelegationsByAssetRequest proto.InternalMessageInfo
+
+// RedelegationResponse is a redelegation that has not completed yet. The redelegation
+// keeps the redelegated balance, balance is what is left of it at the destination validator after slashing
+type RedelegationResponse struct {
+	Redelegation   Redelegation `protobuf:"bytes,1,opt,name=redelegation,proto3" json:"redelegation"`
+	CompletionTime time.Time    `protobuf:"bytes,2,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+	Balance        types.Coin   `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
+func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*RedelegationResponse) ProtoMessage()    {}
+func (*RedelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{33}
+}
+func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *RedelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedelegationResponse.Merge(m, src)
+}
+func (m *RedelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_RedelegationResponse proto.InternalMessageInfo
+
+func (m *RedelegationResponse) GetRedelegation() Redelegation {
+	if m != nil {
+		return m.Redelegation
+	}
+	return Redelegation{}
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *RedelegationResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryPetrichorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RedelegationResponse) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryPetrichorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPetrichorRedelegationsResponse struct {
+	Redelegations []RedelegationResponse `protobuf:"bytes,1,rep,name=redelegations,proto3" json:"redelegations"`
+	Pagination    *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPetrichorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPetrichorRedelegationsResponse) Reset()         { *m = QueryPetrichorRedelegationsResponse{} }
+func (m *QueryPetrichorRedelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorRedelegationsResponse) ProtoMessage()    {}
+func (*QueryPetrichorRedelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{34}
+}
+func (m *QueryPetrichorRedelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorRedelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorRedelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPetrichorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPetrichorRedelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorRedelegationsResponse.Merge(m, src)
+}
+func (m *QueryPetrichorRedelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorRedelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorRedelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPetrichorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPetrichorRedelegationsResponse proto.InternalMessageInfo
+
+type QueryPetrichorValidatorResponse struct {
+	ValidatorAddr         string                                   `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	TotalDelegationShares []types.DecCoin                          `protobuf:"bytes,2,rep,name=total_delegation_shares,json=totalDelegationShares,proto3" json:"total_delegation_shares"`
+	ValidatorShares       []types.DecCoin                          `protobuf:"bytes,3,rep,name=validator_shares,json=validatorShares,proto3" json:"validator_shares"`
+	TotalStaked           []types.DecCoin                          `protobuf:"bytes,4,rep,name=total_staked,json=totalStaked,proto3" json:"total_staked"`
+	Commission            *types1.Commission                       `protobuf:"bytes,5,opt,name=commission,proto3" json:"commission,omitempty"`
+	AccumulatedCommission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=accumulated_commission,json=accumulatedCommission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accumulated_commission"`
 }
 
-func (m *QueryPetrichorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Petrichors) > 0 {
-		for iNdEx := len(m.Petrichors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Petrichors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPetrichorValidatorResponse) Reset()         { *m = QueryPetrichorValidatorResponse{} }
+func (m *QueryPetrichorValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorValidatorResponse) ProtoMessage()    {}
+func (*QueryPetrichorValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{35}
+}
+func (m *QueryPetrichorValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPetrichorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPetrichorValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorValidatorResponse.Merge(m, src)
 }
-
-func (m *QueryPetrichorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPetrichorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPetrichorValidatorResponse proto.InternalMessageInfo
+
+type QueryPetrichorValidatorsResponse struct {
+	Validators []QueryPetrichorValidatorResponse `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	Pagination *query.PageResponse               `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPetrichorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorValidatorsResponse) Reset()         { *m = QueryPetrichorValidatorsResponse{} }
+func (m *QueryPetrichorValidatorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorValidatorsResponse) ProtoMessage()    {}
+func (*QueryPetrichorValidatorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{36}
+}
+func (m *QueryPetrichorValidatorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorValidatorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorValidatorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPetrichorValidatorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorValidatorsResponse.Merge(m, src)
+}
+func (m *QueryPetrichorValidatorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorValidatorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorValidatorsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPetrichorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPetrichorValidatorsResponse proto.InternalMessageInfo
+
+type QueryPetrichorCapacityRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// optional validator to include the per validator cap
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
 }
 
-func (m *QueryPetrichorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Petrichor != nil {
-		{
-			size, err := m.Petrichor.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPetrichorCapacityRequest) Reset()         { *m = QueryPetrichorCapacityRequest{} }
+func (m *QueryPetrichorCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorCapacityRequest) ProtoMessage()    {}
+func (*QueryPetrichorCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{37}
+}
+func (m *QueryPetrichorCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPetrichorCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorCapacityRequest.Merge(m, src)
+}
+func (m *QueryPetrichorCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorCapacityRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIBCPetrichorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPetrichorCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryPetrichorCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryIBCPetrichorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorCapacityRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
 }
 
-func (m *QueryIBCPetrichorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryPetrichorCapacityResponse struct {
+	// remaining tokens before max_total_tokens is reached. Empty if the asset has no total cap
+	RemainingTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=remaining_total_tokens,json=remainingTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_total_tokens,omitempty"`
+	// remaining tokens that can be delegated to the validator, including the total cap.
+	// Empty if no validator was requested or no cap applies
+	RemainingValidatorTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=remaining_validator_tokens,json=remainingValidatorTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_validator_tokens,omitempty"`
 }
 
-func (m *QueryPetrichorValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorCapacityResponse) Reset()         { *m = QueryPetrichorCapacityResponse{} }
+func (m *QueryPetrichorCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorCapacityResponse) ProtoMessage()    {}
+func (*QueryPetrichorCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{38}
+}
+func (m *QueryPetrichorCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPetrichorCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorCapacityResponse.Merge(m, src)
+}
+func (m *QueryPetrichorCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPetrichorValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPetrichorCapacityResponse proto.InternalMessageInfo
+
+type QueryPetrichorTakeRateRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPetrichorValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPetrichorTakeRateRequest) Reset()         { *m = QueryPetrichorTakeRateRequest{} }
+func (m *QueryPetrichorTakeRateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorTakeRateRequest) ProtoMessage()    {}
+func (*QueryPetrichorTakeRateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{39}
+}
+func (m *QueryPetrichorTakeRateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorTakeRateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorTakeRateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPetrichorTakeRateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorTakeRateRequest.Merge(m, src)
+}
+func (m *QueryPetrichorTakeRateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorTakeRateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorTakeRateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPetrichorValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPetrichorTakeRateRequest proto.InternalMessageInfo
+
+func (m *QueryPetrichorTakeRateRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPetrichorValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorTakeRateRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAllPetrichorValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryPetrichorTakeRateResponse struct {
+	Accrual TakeRateAccrual `protobuf:"bytes,1,opt,name=accrual,proto3" json:"accrual"`
+	// take rate deducted at each claim, oldest first
+	History    []TakeRateRecord    `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllPetrichorsDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorTakeRateResponse) Reset()         { *m = QueryPetrichorTakeRateResponse{} }
+func (m *QueryPetrichorTakeRateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorTakeRateResponse) ProtoMessage()    {}
+func (*QueryPetrichorTakeRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{40}
+}
+func (m *QueryPetrichorTakeRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorTakeRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorTakeRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPetrichorTakeRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorTakeRateResponse.Merge(m, src)
+}
+func (m *QueryPetrichorTakeRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorTakeRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorTakeRateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPetrichorsDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPetrichorTakeRateResponse proto.InternalMessageInfo
+
+func (m *QueryPetrichorTakeRateResponse) GetAccrual() TakeRateAccrual {
+	if m != nil {
+		return m.Accrual
+	}
+	return TakeRateAccrual{}
 }
 
-func (m *QueryAllPetrichorsDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPetrichorTakeRateResponse) GetHistory() []TakeRateRecord {
+	if m != nil {
+		return m.History
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPetrichorsDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorTakeRateResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPetrichorsDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPetrichorValidatorSlashesRequest struct {
+	ValidatorAddr string             `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	StartHeight   uint64             `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPetrichorsDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPetrichorValidatorSlashesRequest) Reset()         { *m = QueryPetrichorValidatorSlashesRequest{} }
+func (m *QueryPetrichorValidatorSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorValidatorSlashesRequest) ProtoMessage()    {}
+func (*QueryPetrichorValidatorSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{41}
+}
+func (m *QueryPetrichorValidatorSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorValidatorSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorValidatorSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryPetrichorValidatorSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorValidatorSlashesRequest.Merge(m, src)
+}
+func (m *QueryPetrichorValidatorSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorValidatorSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorValidatorSlashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPetrichorValidatorSlashesRequest proto.InternalMessageInfo
+
+func (m *QueryPetrichorValidatorSlashesRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryPetrichorsDelegationByValidatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorValidatorSlashesRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPetrichorsDelegationByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorValidatorSlashesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPetrichorsDelegationByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+type QueryPetrichorDelegationSlashesRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	ValidatorAddr string             `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+	Denom         string             `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	StartHeight   uint64             `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPetrichorDelegationSlashesRequest) Reset() {
+	*m = QueryPetrichorDelegationSlashesRequest{}
+}
+func (m *QueryPetrichorDelegationSlashesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorDelegationSlashesRequest) ProtoMessage()    {}
+func (*QueryPetrichorDelegationSlashesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{42}
+}
+func (m *QueryPetrichorDelegationSlashesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorDelegationSlashesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorDelegationSlashesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryPetrichorDelegationSlashesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorDelegationSlashesRequest.Merge(m, src)
+}
+func (m *QueryPetrichorDelegationSlashesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorDelegationSlashesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorDelegationSlashesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPetrichorDelegationSlashesRequest proto.InternalMessageInfo
+
+func (m *QueryPetrichorDelegationSlashesRequest) GetDelegatorAddr() string {
+	if m != nil {
+		return m.DelegatorAddr
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryPetrichorDelegationSlashesRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorDelegationSlashesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorDelegationSlashesRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPetrichorDelegationSlashesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPetrichorsDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryPetrichorSlashesResponse struct {
+	// slashes ordered by height, oldest first
+	Slashes    []SlashRecord       `protobuf:"bytes,1,rep,name=slashes,proto3" json:"slashes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPetrichorsDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorSlashesResponse) Reset()         { *m = QueryPetrichorSlashesResponse{} }
+func (m *QueryPetrichorSlashesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorSlashesResponse) ProtoMessage()    {}
+func (*QueryPetrichorSlashesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{43}
 }
-
-func (m *QueryPetrichorsDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Delegations) > 0 {
-		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPetrichorSlashesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorSlashesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorSlashesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPetrichorDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPetrichorSlashesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorSlashesResponse.Merge(m, src)
 }
-
-func (m *QueryPetrichorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPetrichorSlashesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorSlashesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorSlashesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPetrichorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryPetrichorSlashesResponse proto.InternalMessageInfo
+
+func (m *QueryPetrichorSlashesResponse) GetSlashes() []SlashRecord {
+	if m != nil {
+		return m.Slashes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryIBCPetrichorDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorSlashesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIBCPetrichorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPetrichorInsuranceFundRequest struct {
 }
 
-func (m *QueryIBCPetrichorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPetrichorInsuranceFundRequest) Reset()         { *m = QueryPetrichorInsuranceFundRequest{} }
+func (m *QueryPetrichorInsuranceFundRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorInsuranceFundRequest) ProtoMessage()    {}
+func (*QueryPetrichorInsuranceFundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{44}
+}
+func (m *QueryPetrichorInsuranceFundRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorInsuranceFundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorInsuranceFundRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPetrichorDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPetrichorInsuranceFundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorInsuranceFundRequest.Merge(m, src)
+}
+func (m *QueryPetrichorInsuranceFundRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorInsuranceFundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorInsuranceFundRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPetrichorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPetrichorInsuranceFundRequest proto.InternalMessageInfo
+
+type QueryPetrichorInsuranceFundResponse struct {
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
 }
 
-func (m *QueryPetrichorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPetrichorInsuranceFundResponse) Reset()         { *m = QueryPetrichorInsuranceFundResponse{} }
+func (m *QueryPetrichorInsuranceFundResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorInsuranceFundResponse) ProtoMessage()    {}
+func (*QueryPetrichorInsuranceFundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{45}
+}
+func (m *QueryPetrichorInsuranceFundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorInsuranceFundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorInsuranceFundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPetrichorInsuranceFundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorInsuranceFundResponse.Merge(m, src)
+}
+func (m *QueryPetrichorInsuranceFundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorInsuranceFundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorInsuranceFundResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPetrichorDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPetrichorInsuranceFundResponse proto.InternalMessageInfo
+
+func (m *QueryPetrichorInsuranceFundResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPetrichorDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPetrichorInsuranceClaimsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryPetrichorDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPetrichorInsuranceClaimsRequest) Reset()         { *m = QueryPetrichorInsuranceClaimsRequest{} }
+func (m *QueryPetrichorInsuranceClaimsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorInsuranceClaimsRequest) ProtoMessage()    {}
+func (*QueryPetrichorInsuranceClaimsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{46}
+}
+func (m *QueryPetrichorInsuranceClaimsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorInsuranceClaimsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorInsuranceClaimsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryPetrichorInsuranceClaimsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorInsuranceClaimsRequest.Merge(m, src)
+}
+func (m *QueryPetrichorInsuranceClaimsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorInsuranceClaimsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorInsuranceClaimsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPetrichorInsuranceClaimsRequest proto.InternalMessageInfo
+
+func (m *QueryPetrichorInsuranceClaimsRequest) GetDelegatorAddr() string {
+	if m != nil {
+		return m.DelegatorAddr
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryIBCPetrichorDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPetrichorInsuranceClaimsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIBCPetrichorDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPetrichorInsuranceClaimsResponse struct {
+	Claims     []InsuranceClaim    `protobuf:"bytes,1,rep,name=claims,proto3" json:"claims"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIBCPetrichorDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPetrichorInsuranceClaimsResponse) Reset()         { *m = QueryPetrichorInsuranceClaimsResponse{} }
+func (m *QueryPetrichorInsuranceClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPetrichorInsuranceClaimsResponse) ProtoMessage()    {}
+func (*QueryPetrichorInsuranceClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a940d30fee11e7d5, []int{47}
+}
+func (m *QueryPetrichorInsuranceClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPetrichorInsuranceClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPetrichorInsuranceClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryPetrichorInsuranceClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPetrichorInsuranceClaimsResponse.Merge(m, src)
+}
+func (m *QueryPetrichorInsuranceClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPetrichorInsuranceClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPetrichorInsuranceClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPetrichorInsuranceClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryPetrichorInsuranceClaimsResponse) GetClaims() []InsuranceClaim {
+	if m != nil {
+		return m.Claims
 	}
-	if len(m.DelegatorAddr) > 0 {
-		i -= len(m.DelegatorAddr)
-		copy(dAtA[i:], m.DelegatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryPetrichorInsuranceClaimsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPetrichorDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "petrichor.petrichor.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "petrichor.petrichor.QueryParamsResponse")
+	proto.RegisterType((*QueryPetrichorsRequest)(nil), "petrichor.petrichor.QueryPetrichorsRequest")
+	proto.RegisterType((*QueryPetrichorsResponse)(nil), "petrichor.petrichor.QueryPetrichorsResponse")
+	proto.RegisterType((*QueryPetrichorRequest)(nil), "petrichor.petrichor.QueryPetrichorRequest")
+	proto.RegisterType((*QueryPetrichorResponse)(nil), "petrichor.petrichor.QueryPetrichorResponse")
+	proto.RegisterType((*QueryIBCPetrichorRequest)(nil), "petrichor.petrichor.QueryIBCPetrichorRequest")
+	proto.RegisterType((*QueryPetrichorValidatorRequest)(nil), "petrichor.petrichor.QueryPetrichorValidatorRequest")
+	proto.RegisterType((*QueryAllPetrichorValidatorsRequest)(nil), "petrichor.petrichor.QueryAllPetrichorValidatorsRequest")
+	proto.RegisterType((*QueryAllPetrichorsDelegationsRequest)(nil), "petrichor.petrichor.QueryAllPetrichorsDelegationsRequest")
+	proto.RegisterType((*QueryPetrichorsDelegationsRequest)(nil), "petrichor.petrichor.QueryPetrichorsDelegationsRequest")
+	proto.RegisterType((*QueryPetrichorsDelegationByValidatorRequest)(nil), "petrichor.petrichor.QueryPetrichorsDelegationByValidatorRequest")
+	proto.RegisterType((*DelegationResponse)(nil), "petrichor.petrichor.DelegationResponse")
+	proto.RegisterType((*QueryPetrichorsDelegationsResponse)(nil), "petrichor.petrichor.QueryPetrichorsDelegationsResponse")
+	proto.RegisterType((*QueryPetrichorDelegationRequest)(nil), "petrichor.petrichor.QueryPetrichorDelegationRequest")
+	proto.RegisterType((*QueryIBCPetrichorDelegationRequest)(nil), "petrichor.petrichor.QueryIBCPetrichorDelegationRequest")
+	proto.RegisterType((*QueryPetrichorDelegationResponse)(nil), "petrichor.petrichor.QueryPetrichorDelegationResponse")
+	proto.RegisterType((*QueryPetrichorDelegationRewardsRequest)(nil), "petrichor.petrichor.QueryPetrichorDelegationRewardsRequest")
+	proto.RegisterType((*QueryIBCPetrichorDelegationRewardsRequest)(nil), "petrichor.petrichor.QueryIBCPetrichorDelegationRewardsRequest")
+	proto.RegisterType((*QueryPetrichorDelegationRewardsResponse)(nil), "petrichor.petrichor.QueryPetrichorDelegationRewardsResponse")
+	proto.RegisterType((*DelegationRewardSegment)(nil), "petrichor.petrichor.DelegationRewardSegment")
+	proto.RegisterType((*QueryPetrichorDelegatorRewardsRequest)(nil), "petrichor.petrichor.QueryPetrichorDelegatorRewardsRequest")
+	proto.RegisterType((*AssetRewards)(nil), "petrichor.petrichor.AssetRewards")
+	proto.RegisterType((*ValidatorRewards)(nil), "petrichor.petrichor.ValidatorRewards")
+	proto.RegisterType((*QueryPetrichorDelegatorRewardsResponse)(nil), "petrichor.petrichor.QueryPetrichorDelegatorRewardsResponse")
+	proto.RegisterType((*QueryPetrichorUndelegationsRequest)(nil), "petrichor.petrichor.QueryPetrichorUndelegationsRequest")
+	proto.RegisterType((*QueryPetrichorUndelegationsByValidatorRequest)(nil), "petrichor.petrichor.QueryPetrichorUndelegationsByValidatorRequest")
+	proto.RegisterType((*QueryPetrichorUndelegationsByAssetRequest)(nil), "petrichor.petrichor.QueryPetrichorUndelegationsByAssetRequest")
+	proto.RegisterType((*UndelegationResponse)(nil), "petrichor.petrichor.UndelegationResponse")
+	proto.RegisterType((*QueryPetrichorUndelegationsResponse)(nil), "petrichor.petrichor.QueryPetrichorUndelegationsResponse")
+	proto.RegisterType((*QueryPetrichorRedelegationsRequest)(nil), "petrichor.petrichor.QueryPetrichorRedelegationsRequest")
+	proto.RegisterType((*QueryPetrichorRedelegationsByValidatorRequest)(nil), "petrichor.petrichor.QueryPetrichorRedelegationsByValidatorRequest")
+	proto.RegisterType((*QueryPetrichorRedelegationsByAssetRequest)(nil), "petrichor.petrichor.QueryPetrichorRedelegationsByAssetRequest")
+	proto.RegisterType((*RedelegationResponse)(nil), "petrichor.petrichor.RedelegationResponse")
+	proto.RegisterType((*QueryPetrichorRedelegationsResponse)(nil), "petrichor.petrichor.QueryPetrichorRedelegationsResponse")
+	proto.RegisterType((*QueryPetrichorValidatorResponse)(nil), "petrichor.petrichor.QueryPetrichorValidatorResponse")
+	proto.RegisterType((*QueryPetrichorValidatorsResponse)(nil), "petrichor.petrichor.QueryPetrichorValidatorsResponse")
+	proto.RegisterType((*QueryPetrichorCapacityRequest)(nil), "petrichor.petrichor.QueryPetrichorCapacityRequest")
+	proto.RegisterType((*QueryPetrichorCapacityResponse)(nil), "petrichor.petrichor.QueryPetrichorCapacityResponse")
+	proto.RegisterType((*QueryPetrichorTakeRateRequest)(nil), "petrichor.petrichor.QueryPetrichorTakeRateRequest")
+	proto.RegisterType((*QueryPetrichorTakeRateResponse)(nil), "petrichor.petrichor.QueryPetrichorTakeRateResponse")
+	proto.RegisterType((*QueryPetrichorValidatorSlashesRequest)(nil), "petrichor.petrichor.QueryPetrichorValidatorSlashesRequest")
+	proto.RegisterType((*QueryPetrichorDelegationSlashesRequest)(nil), "petrichor.petrichor.QueryPetrichorDelegationSlashesRequest")
+	proto.RegisterType((*QueryPetrichorSlashesResponse)(nil), "petrichor.petrichor.QueryPetrichorSlashesResponse")
+	proto.RegisterType((*QueryPetrichorInsuranceFundRequest)(nil), "petrichor.petrichor.QueryPetrichorInsuranceFundRequest")
+	proto.RegisterType((*QueryPetrichorInsuranceFundResponse)(nil), "petrichor.petrichor.QueryPetrichorInsuranceFundResponse")
+	proto.RegisterType((*QueryPetrichorInsuranceClaimsRequest)(nil), "petrichor.petrichor.QueryPetrichorInsuranceClaimsRequest")
+	proto.RegisterType((*QueryPetrichorInsuranceClaimsResponse)(nil), "petrichor.petrichor.QueryPetrichorInsuranceClaimsResponse")
+}
+
+func init() { proto.RegisterFile("petrichor/query.proto", fileDescriptor_a940d30fee11e7d5) }
+
+var fileDescriptor_a940d30fee11e7d5 = []byte{
+	// 2425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xf6, 0xb5, 0x1d, 0x27, 0x39, 0x71, 0xd2, 0xf6, 0xc6, 0x71, 0xec, 0x49, 0xbc, 0x6b, 0x8f,
+	0xed, 0x38, 0x4d, 0xe2, 0xdd, 0xd4, 0x26, 0x6d, 0x7e, 0x80, 0xd6, 0x76, 0x7e, 0x6a, 0x4a, 0xda,
+	0x74, 0x9c, 0x82, 0x14, 0x90, 0xcc, 0x78, 0xf7, 0xb2, 0x3b, 0xf2, 0xee, 0xcc, 0x66, 0x66, 0x36,
+	0xa9, 0x15, 0xf9, 0xa5, 0x2f, 0xf0, 0x00, 0x52, 0xa4, 0x0a, 0x89, 0x07, 0x90, 0xf2, 0x08, 0x45,
+	0x20, 0x24, 0x04, 0x82, 0x07, 0xa8, 0x84, 0x84, 0x94, 0x17, 0x44, 0x51, 0x1f, 0xa8, 0x2a, 0x94,
+	0xa0, 0xa4, 0xfc, 0x3d, 0xf0, 0x04, 0x12, 0x6f, 0x08, 0xcd, 0x9d, 0x7b, 0x67, 0xee, 0xfc, 0xec,
+	0xec, 0xcc, 0x7a, 0x37, 0x6d, 0x9f, 0x76, 0x77, 0x76, 0xce, 0xb9, 0xdf, 0x77, 0xee, 0x77, 0xef,
+	0x9c, 0x7b, 0xce, 0xc0, 0xa1, 0x06, 0xb1, 0x4d, 0xad, 0x54, 0x35, 0xcc, 0xe2, 0xcd, 0x26, 0x31,
+	0xb7, 0x0a, 0x0d, 0xd3, 0xb0, 0x0d, 0x7c, 0xd0, 0xbb, 0x5c, 0xf0, 0xbe, 0x49, 0x23, 0x15, 0xa3,
+	0x62, 0xd0, 0xff, 0x8b, 0xce, 0x37, 0xf7, 0x56, 0xe9, 0x68, 0xc5, 0x30, 0x2a, 0x35, 0x52, 0x54,
+	0x1b, 0x5a, 0x51, 0xd5, 0x75, 0xc3, 0x56, 0x6d, 0xcd, 0xd0, 0x2d, 0xf6, 0xef, 0x89, 0x92, 0x61,
+	0xd5, 0x0d, 0xab, 0xb8, 0xa1, 0x5a, 0xc4, 0x1d, 0xa1, 0x78, 0xeb, 0xb9, 0x0d, 0x62, 0xab, 0xcf,
+	0x15, 0x1b, 0x6a, 0x45, 0xd3, 0xe9, 0xcd, 0xec, 0xde, 0x51, 0x1f, 0x4b, 0x43, 0x35, 0xd5, 0x3a,
+	0xf7, 0x31, 0x2e, 0x5c, 0xf7, 0x61, 0xd1, 0xbf, 0x72, 0xa2, 0x7b, 0xee, 0xb8, 0x64, 0x68, 0xdc,
+	0xe5, 0x11, 0xdf, 0xb4, 0x4c, 0x6a, 0xa4, 0x12, 0xc0, 0x96, 0x67, 0xc8, 0xe9, 0xaf, 0x8d, 0xe6,
+	0xd7, 0x8b, 0xb6, 0x56, 0x27, 0x96, 0xad, 0xd6, 0x1b, 0xec, 0x86, 0x19, 0xe6, 0xdd, 0xb2, 0xd5,
+	0x4d, 0x4d, 0xaf, 0x78, 0x03, 0xb0, 0xdf, 0xee, 0x5d, 0xf2, 0x08, 0xe0, 0xd7, 0x1d, 0x62, 0xd7,
+	0x28, 0x66, 0x85, 0xdc, 0x6c, 0x12, 0xcb, 0x96, 0xaf, 0xc1, 0xc1, 0xc0, 0x55, 0xab, 0x61, 0xe8,
+	0x16, 0xc1, 0xe7, 0x60, 0xc8, 0xe5, 0x36, 0x86, 0x26, 0xd1, 0xf1, 0x7d, 0x0b, 0x47, 0x0a, 0x31,
+	0x91, 0x2e, 0xb8, 0x46, 0xcb, 0x83, 0xf7, 0x1f, 0xe4, 0xfb, 0x14, 0x66, 0x20, 0x7f, 0x0d, 0x46,
+	0x5d, 0x8f, 0xfc, 0x36, 0x3e, 0x16, 0xbe, 0x0c, 0xe0, 0x07, 0x93, 0x39, 0x3e, 0x56, 0x70, 0xc1,
+	0x17, 0x9c, 0xd0, 0x14, 0xdc, 0xb9, 0x65, 0xf8, 0x0b, 0xd7, 0xd4, 0x0a, 0x61, 0xb6, 0x8a, 0x60,
+	0x29, 0xff, 0x18, 0xc1, 0xe1, 0xc8, 0x10, 0x0c, 0xf8, 0x2a, 0x80, 0x87, 0xcf, 0x01, 0x3f, 0x70,
+	0x7c, 0xdf, 0xc2, 0x74, 0x3c, 0x78, 0xfe, 0x6d, 0xc9, 0xb2, 0x88, 0xcd, 0x48, 0x08, 0xc6, 0xf8,
+	0x4a, 0x00, 0x6e, 0x3f, 0x85, 0x3b, 0xd7, 0x16, 0xae, 0x8b, 0x23, 0x80, 0x77, 0x1e, 0x0e, 0x05,
+	0xe1, 0xf2, 0x80, 0x8c, 0xc0, 0xae, 0x32, 0xd1, 0x8d, 0x3a, 0x8d, 0xc5, 0x5e, 0xc5, 0xfd, 0x21,
+	0x7f, 0x25, 0x1c, 0x40, 0x8f, 0xdc, 0x12, 0xec, 0xf5, 0xf0, 0xb1, 0xf8, 0xa5, 0xe1, 0xa6, 0xf8,
+	0x56, 0x72, 0x01, 0xc6, 0xa8, 0xf3, 0xd5, 0xe5, 0x95, 0x08, 0x1c, 0x0c, 0x83, 0x55, 0xd5, 0xaa,
+	0x32, 0x34, 0xf4, 0xbb, 0xfc, 0x3a, 0xe4, 0x82, 0x60, 0xbe, 0xa4, 0xd6, 0xb4, 0xb2, 0x6a, 0xfb,
+	0x56, 0xb3, 0x70, 0xe0, 0x16, 0xbf, 0xb6, 0xae, 0x96, 0xcb, 0x26, 0xb3, 0xdf, 0xef, 0x5d, 0x5d,
+	0x2a, 0x97, 0xcd, 0xf3, 0x7b, 0xbe, 0x79, 0x2f, 0xdf, 0xf7, 0x8f, 0x7b, 0xf9, 0x3e, 0xf9, 0x16,
+	0xc8, 0xd4, 0xe5, 0x52, 0xad, 0x16, 0xf5, 0xda, 0x6d, 0xb1, 0x08, 0xe3, 0xbe, 0x09, 0x33, 0x91,
+	0x71, 0xad, 0x8b, 0xfe, 0x72, 0xeb, 0xdd, 0xc8, 0xdf, 0x43, 0x30, 0x15, 0x12, 0x6c, 0xcc, 0xb8,
+	0xb3, 0x70, 0x80, 0x2d, 0xfe, 0x50, 0x20, 0xbd, 0xab, 0x4e, 0x20, 0xf1, 0xe5, 0x18, 0x59, 0xee,
+	0x0c, 0xde, 0xef, 0x11, 0x9c, 0x6c, 0x09, 0x6f, 0x79, 0x2b, 0x6e, 0xc6, 0xd3, 0x00, 0x8d, 0x0a,
+	0xa3, 0x3f, 0x46, 0x18, 0x21, 0x3e, 0x03, 0xdd, 0x09, 0x37, 0xf6, 0x09, 0x78, 0xab, 0xe7, 0x12,
+	0x80, 0xbf, 0xb9, 0xb2, 0x79, 0xcd, 0xc7, 0x2e, 0x1f, 0x81, 0x3d, 0xdb, 0x16, 0x7c, 0x43, 0x7c,
+	0x0e, 0x76, 0x6f, 0xa8, 0x35, 0x55, 0x2f, 0x11, 0x16, 0xfc, 0xf1, 0x00, 0x58, 0x0e, 0x73, 0xc5,
+	0xd0, 0xb8, 0x35, 0xbf, 0xff, 0xfc, 0x20, 0x85, 0xf7, 0x1b, 0x04, 0x72, 0xcb, 0x70, 0xfb, 0x3b,
+	0xd9, 0x6b, 0xb0, 0xcf, 0x1f, 0x95, 0x6f, 0x65, 0x73, 0x6d, 0xf0, 0x72, 0x6b, 0x36, 0xb2, 0xe8,
+	0xa1, 0x7b, 0xfb, 0xd9, 0x9f, 0x10, 0xe4, 0x83, 0x04, 0x44, 0x00, 0xbd, 0xd0, 0x88, 0xb7, 0x51,
+	0x0e, 0x08, 0x1b, 0x65, 0x48, 0x39, 0x83, 0x5d, 0x50, 0xce, 0x07, 0x7c, 0x6a, 0xc4, 0xed, 0xb1,
+	0xd7, 0xe4, 0xf8, 0xb6, 0x3b, 0xe0, 0x6f, 0xbb, 0x3d, 0xa0, 0x76, 0x13, 0x26, 0x5b, 0xcf, 0x19,
+	0x93, 0xdc, 0xd5, 0x98, 0x15, 0x92, 0x51, 0x71, 0x82, 0x03, 0xf9, 0x01, 0x82, 0x63, 0xad, 0xc7,
+	0xbc, 0xad, 0x9a, 0x65, 0xeb, 0xd3, 0x2d, 0x97, 0x87, 0x08, 0x9e, 0x4d, 0x94, 0x4b, 0x0f, 0x39,
+	0x3e, 0x19, 0xd5, 0xfc, 0x13, 0xc1, 0x5c, 0xdb, 0x29, 0x64, 0xea, 0x29, 0xc3, 0x6e, 0xd3, 0xbd,
+	0xc4, 0x36, 0xab, 0x84, 0x8d, 0xb1, 0xe8, 0x88, 0xe5, 0xc3, 0x07, 0xf9, 0xb9, 0x8a, 0x66, 0x57,
+	0x9b, 0x1b, 0x85, 0x92, 0x51, 0x2f, 0xb2, 0x2c, 0xd6, 0xfd, 0x98, 0xb7, 0xca, 0x9b, 0x45, 0x7b,
+	0xab, 0x41, 0x2c, 0x6a, 0xa0, 0x70, 0xd7, 0xf8, 0x55, 0xd8, 0x63, 0x91, 0x4a, 0x9d, 0xe8, 0xb6,
+	0x35, 0xd6, 0x4f, 0x87, 0x39, 0xd5, 0x56, 0xa1, 0x8e, 0xe5, 0x9a, 0x6b, 0xc4, 0x64, 0xea, 0xf9,
+	0x10, 0xb8, 0xfe, 0x17, 0xc1, 0xe1, 0x16, 0x56, 0x78, 0x14, 0x86, 0xaa, 0x44, 0xab, 0x54, 0x6d,
+	0x3a, 0x67, 0x83, 0x0a, 0xfb, 0x85, 0xd7, 0x60, 0xbf, 0x0b, 0x6c, 0xfd, 0xb6, 0xfb, 0x37, 0x9d,
+	0xab, 0xe5, 0x02, 0xa3, 0x77, 0x2c, 0x05, 0xbd, 0x8b, 0xa4, 0xa4, 0x0c, 0xbb, 0x4e, 0xbe, 0xec,
+	0x3a, 0x25, 0x7e, 0x20, 0x07, 0xda, 0x05, 0xf2, 0xb4, 0x33, 0xd2, 0x3b, 0x0f, 0xf3, 0xc7, 0x53,
+	0x06, 0xd2, 0xf2, 0x22, 0x29, 0x30, 0xbf, 0x87, 0x60, 0x36, 0x76, 0x96, 0x9d, 0x67, 0x7e, 0x27,
+	0x1a, 0xee, 0x7e, 0x8e, 0xf2, 0x1d, 0x04, 0xc3, 0x6e, 0x32, 0xcb, 0x74, 0x10, 0x9b, 0x3b, 0x8b,
+	0xa1, 0xeb, 0x7f, 0x22, 0xa1, 0xfb, 0x3b, 0x82, 0xa7, 0x85, 0x04, 0xc9, 0xc5, 0x96, 0x2e, 0x25,
+	0xc6, 0x2f, 0xc2, 0x90, 0xea, 0x50, 0xe2, 0x58, 0xa7, 0x62, 0x85, 0x2c, 0xb2, 0xe6, 0x47, 0x2d,
+	0xd7, 0x0c, 0xab, 0xb0, 0xcb, 0x36, 0x6c, 0xb5, 0xd6, 0x0b, 0x99, 0xb8, 0x9e, 0x05, 0xa6, 0x3f,
+	0xea, 0x6f, 0xb1, 0x9b, 0x1b, 0x66, 0x78, 0x27, 0x78, 0x05, 0xc0, 0x63, 0xca, 0x37, 0x83, 0xd9,
+	0x58, 0x72, 0xe1, 0xd0, 0xf1, 0xa7, 0x88, 0x6f, 0xee, 0x93, 0xec, 0xef, 0x15, 0xc9, 0x50, 0x66,
+	0x34, 0xd0, 0x71, 0x66, 0x24, 0x44, 0xeb, 0xfb, 0x91, 0x24, 0xef, 0x0d, 0xbd, 0xfc, 0x09, 0xca,
+	0xf9, 0x7f, 0x80, 0x60, 0x3e, 0x01, 0x5f, 0x7c, 0xd6, 0x9f, 0x46, 0xd4, 0xdd, 0x87, 0xfa, 0x36,
+	0x7f, 0xca, 0xb6, 0x82, 0xca, 0x56, 0x48, 0xc2, 0x99, 0xba, 0x07, 0xa8, 0x7e, 0x89, 0x60, 0x44,
+	0xc4, 0x21, 0x88, 0x7f, 0xb8, 0xa9, 0x47, 0xd2, 0xa8, 0xf8, 0xb5, 0x2d, 0x3a, 0x60, 0xd2, 0x0f,
+	0x18, 0xe3, 0xab, 0xf0, 0x54, 0xc9, 0xa8, 0x37, 0x6a, 0xc4, 0xf9, 0xb5, 0x6e, 0x6b, 0x75, 0x7e,
+	0xe8, 0x90, 0x0a, 0x6e, 0x55, 0xa8, 0xc0, 0xab, 0x42, 0x85, 0xeb, 0xbc, 0x2a, 0xb4, 0xbc, 0xc7,
+	0x71, 0x74, 0xf7, 0x61, 0x1e, 0x29, 0x07, 0x7c, 0x63, 0xe7, 0x6f, 0x76, 0x00, 0xf9, 0x03, 0x82,
+	0xe9, 0x44, 0x6d, 0x32, 0x26, 0x6f, 0xc0, 0x7e, 0x11, 0x0c, 0x5f, 0xc9, 0xcf, 0xb6, 0xa5, 0x12,
+	0xca, 0x09, 0x83, 0x5e, 0xba, 0x76, 0x0e, 0x49, 0x5c, 0x6d, 0x0a, 0xf9, 0x64, 0xaf, 0x36, 0x85,
+	0x7c, 0x6a, 0x56, 0x9b, 0x42, 0x3e, 0xfe, 0xd5, 0xf6, 0x6f, 0x04, 0x23, 0x22, 0x0e, 0x71, 0xb5,
+	0x99, 0x24, 0xe5, 0x6a, 0x13, 0x1d, 0xf0, 0xd5, 0x66, 0x92, 0x9e, 0xad, 0x36, 0xb1, 0x52, 0x30,
+	0xd0, 0x51, 0xa5, 0x20, 0xba, 0x50, 0x43, 0xb2, 0xf6, 0x17, 0xaa, 0x49, 0xd2, 0x2e, 0xd4, 0xb8,
+	0x30, 0xf2, 0x85, 0x6a, 0x92, 0x1e, 0x2f, 0xd4, 0x6f, 0x0f, 0x86, 0x4b, 0x07, 0x82, 0xf2, 0x19,
+	0x9b, 0x94, 0xd2, 0xbf, 0x01, 0x87, 0xe9, 0xd3, 0x7b, 0xdd, 0x87, 0xbc, 0x6e, 0x55, 0x55, 0x93,
+	0xf0, 0x74, 0xea, 0x68, 0x6c, 0xb4, 0x2f, 0x92, 0x92, 0x10, 0xf0, 0x43, 0xd4, 0x85, 0x9f, 0xf5,
+	0xaf, 0x51, 0x07, 0xf8, 0x2a, 0x3c, 0xed, 0x43, 0x60, 0x4e, 0x07, 0x52, 0x3b, 0x7d, 0xca, 0xb3,
+	0x65, 0xee, 0x2e, 0xc1, 0xb0, 0x0b, 0xd5, 0xa9, 0xc8, 0x93, 0xf2, 0xd8, 0x60, 0x6a, 0x57, 0xfb,
+	0xa8, 0xdd, 0x1a, 0x35, 0xc3, 0xcb, 0x00, 0x25, 0xa3, 0x5e, 0xd7, 0x2c, 0xcb, 0x99, 0x8f, 0x5d,
+	0x74, 0x3e, 0x64, 0xee, 0x84, 0x17, 0xfb, 0x7d, 0x55, 0xf1, 0x3b, 0x15, 0xc1, 0x0a, 0xbf, 0x85,
+	0x60, 0x54, 0x2d, 0x95, 0x9a, 0xf5, 0x66, 0x4d, 0xb5, 0x49, 0x79, 0x5d, 0x70, 0x38, 0xd4, 0xfd,
+	0xfc, 0xea, 0x90, 0x30, 0x94, 0x0f, 0x48, 0xd0, 0xc3, 0x1f, 0x11, 0x4c, 0xb6, 0xd0, 0x83, 0x2f,
+	0xef, 0x1b, 0x31, 0xe9, 0xe4, 0x67, 0x62, 0xb5, 0xdd, 0x46, 0x5a, 0x31, 0xd9, 0x65, 0x0f, 0x34,
+	0xfe, 0x55, 0x98, 0x08, 0xe2, 0x58, 0x51, 0x1b, 0x6a, 0x49, 0xb3, 0xb7, 0x92, 0x37, 0xcd, 0x74,
+	0xe7, 0x7e, 0xf9, 0x7f, 0x08, 0x72, 0xad, 0xdc, 0x7b, 0x07, 0xf1, 0x51, 0x93, 0xd4, 0x55, 0x4d,
+	0xd7, 0xf4, 0xca, 0xba, 0x2b, 0x3c, 0xdb, 0xd8, 0x24, 0xba, 0xdb, 0xcc, 0x71, 0x4f, 0xa7, 0x28,
+	0xe5, 0xe9, 0x74, 0x55, 0xb7, 0x95, 0x11, 0xcf, 0xdb, 0x75, 0xc7, 0xd9, 0x75, 0xea, 0x0b, 0xd7,
+	0x40, 0xf2, 0x47, 0xf1, 0x91, 0xb3, 0x91, 0xfa, 0x3b, 0x1a, 0x69, 0xcc, 0xf3, 0xe8, 0xcd, 0x9d,
+	0x3b, 0x9a, 0x10, 0xde, 0xed, 0x70, 0x78, 0xaf, 0xab, 0x9b, 0x44, 0x51, 0x6d, 0xf2, 0x44, 0x9e,
+	0x49, 0xf2, 0x7f, 0x22, 0xf1, 0xf7, 0xc7, 0x67, 0xf1, 0xbf, 0x08, 0xbb, 0xd5, 0x52, 0xc9, 0x6c,
+	0xaa, 0x35, 0xf6, 0x38, 0x9a, 0x89, 0x15, 0x2b, 0xb7, 0x5b, 0x72, 0xef, 0xe5, 0x8f, 0x00, 0x66,
+	0x8a, 0x57, 0x60, 0x77, 0x55, 0xb3, 0x6c, 0xc3, 0xdc, 0x62, 0xfb, 0xd9, 0x74, 0xa2, 0x17, 0x85,
+	0x94, 0x0c, 0xb3, 0xcc, 0x9d, 0x30, 0xcb, 0xae, 0x9d, 0x6c, 0xe4, 0x5f, 0x45, 0x4a, 0x04, 0xde,
+	0x0c, 0xad, 0xd5, 0x54, 0xab, 0x4a, 0xac, 0x8c, 0x99, 0xcb, 0x14, 0x0c, 0x5b, 0xb6, 0x6a, 0xda,
+	0xeb, 0x55, 0xbf, 0x70, 0x32, 0xa8, 0xec, 0xa3, 0xd7, 0x5e, 0xa6, 0x97, 0xba, 0xd5, 0x19, 0x70,
+	0x0a, 0x3b, 0x2d, 0xeb, 0x90, 0x51, 0xf0, 0x3d, 0xaf, 0x43, 0x86, 0x99, 0x0f, 0xb6, 0x63, 0xbe,
+	0xab, 0x63, 0xe6, 0xef, 0xa0, 0xf0, 0x62, 0xf1, 0xf8, 0x32, 0xad, 0xbe, 0x04, 0xbb, 0x2d, 0xf7,
+	0x12, 0xdb, 0x58, 0x27, 0x63, 0x55, 0x46, 0xcd, 0x82, 0x12, 0x63, 0x66, 0xdd, 0x6b, 0x2b, 0xcc,
+	0x84, 0x73, 0xf8, 0x55, 0xdd, 0x6a, 0x9a, 0xaa, 0x5e, 0x22, 0x97, 0x9b, 0x7a, 0x99, 0x37, 0xac,
+	0xbf, 0x15, 0xc9, 0x89, 0x42, 0xb7, 0x31, 0x62, 0xc4, 0x4f, 0xbe, 0x50, 0x0f, 0x2a, 0x41, 0xcc,
+	0xb7, 0x53, 0x97, 0x9a, 0x69, 0x01, 0x67, 0xa5, 0xa6, 0x6a, 0xf5, 0x8f, 0xe9, 0xec, 0x21, 0xff,
+	0x2c, 0xb2, 0x5e, 0x23, 0xb8, 0xbc, 0xa6, 0xf2, 0x50, 0x89, 0x5e, 0x49, 0xec, 0x96, 0x07, 0xad,
+	0x79, 0x1d, 0xca, 0x35, 0xec, 0x9a, 0x04, 0x16, 0xbe, 0x3b, 0x07, 0xbb, 0x28, 0x6a, 0xbc, 0x0d,
+	0x43, 0xee, 0xdb, 0x05, 0x78, 0x2e, 0xe1, 0x49, 0x2f, 0xbe, 0xca, 0x20, 0x1d, 0x6f, 0x7f, 0xa3,
+	0x3b, 0xa4, 0x3c, 0xf9, 0xd6, 0xfb, 0x1f, 0xbd, 0xdd, 0x2f, 0xe1, 0xb1, 0xa2, 0x4d, 0x4c, 0x53,
+	0xf5, 0x5f, 0xd7, 0xb0, 0xd8, 0x1b, 0x1d, 0x4e, 0x9a, 0x04, 0x7e, 0x7b, 0x0e, 0x9f, 0x4c, 0x91,
+	0x6d, 0x78, 0x38, 0x4e, 0xa5, 0xbb, 0x99, 0x61, 0x19, 0xa7, 0x58, 0x0e, 0xe2, 0x67, 0x22, 0x58,
+	0xf0, 0x5d, 0x04, 0xc3, 0x62, 0x67, 0x01, 0xcf, 0xb7, 0xf6, 0x1c, 0xd3, 0xcf, 0x97, 0xd2, 0xa0,
+	0xf6, 0x70, 0xcc, 0x50, 0x1c, 0x39, 0x7c, 0x34, 0x1a, 0x13, 0x6d, 0xa3, 0x54, 0xbc, 0xe3, 0x34,
+	0x18, 0xb6, 0xf1, 0xcf, 0x11, 0x8c, 0xb5, 0xea, 0x9f, 0xe3, 0x73, 0xad, 0xc7, 0x6b, 0xd3, 0x73,
+	0x97, 0x5e, 0x48, 0x13, 0xb3, 0x98, 0x2e, 0xa9, 0x3c, 0x4b, 0x61, 0xe7, 0xf1, 0x44, 0x14, 0xb6,
+	0x78, 0x94, 0xf9, 0x05, 0x02, 0x1c, 0x7d, 0x72, 0xe1, 0xc5, 0x6c, 0x59, 0xa4, 0x8b, 0xb5, 0xa3,
+	0xd4, 0x53, 0x3e, 0x43, 0x81, 0x16, 0xf1, 0x7c, 0x14, 0xa8, 0x9f, 0x8e, 0x16, 0xef, 0x04, 0x1f,
+	0x2b, 0xdb, 0xf8, 0x27, 0x08, 0x46, 0xe3, 0x5f, 0x94, 0xc0, 0x2f, 0xa4, 0x0b, 0x77, 0xe4, 0xd5,
+	0x0a, 0xe9, 0x4c, 0x16, 0x02, 0x56, 0x1a, 0x85, 0x08, 0x09, 0xf5, 0xbb, 0x08, 0x46, 0xe2, 0xa6,
+	0x0c, 0x3f, 0x9f, 0x79, 0x8a, 0x77, 0x28, 0x8d, 0xe7, 0x29, 0xde, 0xd3, 0xb8, 0x90, 0x28, 0x8d,
+	0xe2, 0x9d, 0xe0, 0xb6, 0xbc, 0x8d, 0xff, 0x8a, 0x20, 0xdf, 0xe6, 0x4d, 0x08, 0xfc, 0x52, 0x36,
+	0x50, 0xd1, 0x02, 0x4f, 0xe7, 0xb4, 0xae, 0x50, 0x5a, 0x4b, 0xf8, 0xc5, 0x6c, 0xb4, 0xa2, 0xd2,
+	0x7a, 0x1f, 0xc1, 0xc1, 0x98, 0x8c, 0x08, 0xa7, 0xd1, 0x77, 0xa4, 0x27, 0x2e, 0x9d, 0xc9, 0x68,
+	0xc5, 0xd8, 0xbc, 0x46, 0xd9, 0xac, 0xe2, 0x2b, 0x3b, 0x64, 0xe3, 0xdc, 0xa1, 0x1b, 0xf5, 0x6d,
+	0xfc, 0x67, 0x04, 0xa3, 0xf1, 0xed, 0xd8, 0xa4, 0x05, 0x93, 0xd8, 0xef, 0xef, 0x94, 0x9b, 0x42,
+	0xb9, 0x7d, 0x11, 0x7f, 0x61, 0xa7, 0xdc, 0x84, 0x0d, 0xf8, 0x23, 0x04, 0x52, 0xeb, 0x5e, 0x2c,
+	0xbe, 0x90, 0x11, 0xa9, 0xd8, 0xdc, 0x93, 0x3e, 0xdb, 0x99, 0x31, 0x63, 0xfb, 0x0a, 0x65, 0x7b,
+	0x09, 0xaf, 0x44, 0xd9, 0xb2, 0xb6, 0x59, 0x86, 0x59, 0xbc, 0x8f, 0x60, 0xbc, 0x65, 0x9f, 0x09,
+	0x9f, 0x4f, 0x0f, 0x34, 0xdc, 0xc1, 0x94, 0x2e, 0x74, 0x64, 0xcb, 0x38, 0x2e, 0x50, 0x8e, 0xa7,
+	0xf0, 0x89, 0xf4, 0x1c, 0xf1, 0xbf, 0x10, 0x4c, 0x24, 0xbe, 0x1f, 0x80, 0x3f, 0x9f, 0x5d, 0x97,
+	0x5d, 0x9c, 0xb7, 0x57, 0x29, 0xa7, 0x97, 0xf1, 0xe5, 0x9d, 0xcc, 0x9b, 0xa0, 0xd0, 0xdf, 0x21,
+	0x18, 0x8d, 0x6f, 0x2c, 0xe0, 0x34, 0x7b, 0x5e, 0x5c, 0x9b, 0x4c, 0x3a, 0x9b, 0xdd, 0x90, 0xb1,
+	0x3b, 0x4b, 0xd9, 0x2d, 0xe0, 0xd3, 0x51, 0x76, 0x81, 0xae, 0x44, 0x74, 0xde, 0xfe, 0x86, 0x60,
+	0xaa, 0x6d, 0x73, 0x0c, 0x2f, 0x67, 0x45, 0x16, 0xf3, 0x28, 0xe8, 0x9c, 0xdd, 0x0a, 0x65, 0xf7,
+	0x39, 0x7c, 0x21, 0x53, 0x52, 0x11, 0x64, 0x8e, 0x7f, 0x8b, 0x60, 0x22, 0xb1, 0xb5, 0x96, 0x24,
+	0xd0, 0x34, 0x3d, 0xb9, 0x1d, 0x10, 0x9c, 0xa3, 0x04, 0xa7, 0x70, 0xbe, 0xcd, 0xf4, 0x05, 0x55,
+	0xa7, 0x90, 0xac, 0xaa, 0x8b, 0x6b, 0x17, 0x49, 0x67, 0xb3, 0x1b, 0xb6, 0x57, 0x9d, 0x49, 0xd2,
+	0xab, 0x4e, 0x21, 0x3b, 0x50, 0x5d, 0x9b, 0x0e, 0xd3, 0x0e, 0xd8, 0x75, 0xa8, 0x3a, 0x93, 0xb4,
+	0x54, 0x9d, 0x42, 0x3a, 0x54, 0x5d, 0x42, 0x6f, 0x6a, 0x07, 0x04, 0x13, 0x54, 0x17, 0x24, 0xf1,
+	0x0d, 0x04, 0x7b, 0xfd, 0xe3, 0xd9, 0x89, 0x54, 0x03, 0x76, 0x70, 0x36, 0x9b, 0xa2, 0x78, 0x8e,
+	0xe0, 0xf1, 0x28, 0x1e, 0xfe, 0xc0, 0xbc, 0x87, 0xe0, 0x99, 0x48, 0x45, 0x18, 0x2f, 0xa4, 0x18,
+	0x25, 0x54, 0x9d, 0x96, 0x16, 0x33, 0xd9, 0x30, 0x84, 0x32, 0x45, 0x78, 0x14, 0x4b, 0x51, 0x84,
+	0x25, 0x0e, 0xe6, 0x87, 0x22, 0x44, 0x5e, 0xb6, 0x4c, 0x05, 0x31, 0x54, 0xe1, 0x95, 0x16, 0x33,
+	0xd9, 0x30, 0x88, 0x27, 0x29, 0xc4, 0x59, 0x3c, 0x1d, 0x85, 0xe8, 0xb4, 0x57, 0xd6, 0x4d, 0xd5,
+	0x26, 0x5e, 0x38, 0xdf, 0x15, 0xf3, 0x8f, 0x70, 0xa5, 0x33, 0x55, 0xfe, 0xd1, 0xa2, 0x3c, 0x2a,
+	0xa5, 0xe1, 0x1b, 0x2a, 0xd2, 0x25, 0xa5, 0x1d, 0xac, 0x0a, 0x17, 0xcd, 0xee, 0x3f, 0x8c, 0x4f,
+	0x14, 0x39, 0x85, 0x6c, 0x89, 0x62, 0x17, 0x38, 0x24, 0xa4, 0x87, 0x1e, 0x87, 0xd4, 0xe9, 0xe1,
+	0x4f, 0xc5, 0xdd, 0x3e, 0x50, 0xff, 0x4b, 0xb5, 0xdb, 0xc7, 0x15, 0x16, 0xa5, 0xb3, 0xd9, 0x0d,
+	0x19, 0xb5, 0x69, 0x4a, 0x6d, 0x02, 0x1f, 0x89, 0x52, 0xd3, 0xb8, 0x01, 0xfe, 0x35, 0x82, 0xb1,
+	0x56, 0xb5, 0xb8, 0xa4, 0xca, 0x49, 0x9b, 0xba, 0xa2, 0x74, 0xbe, 0x13, 0x53, 0x06, 0xfc, 0x04,
+	0x05, 0x3e, 0x83, 0xe5, 0x04, 0xe0, 0x45, 0xb7, 0xc6, 0xb7, 0x7c, 0xf5, 0xfe, 0xa3, 0x1c, 0x7a,
+	0xef, 0x51, 0x0e, 0xfd, 0xe5, 0x51, 0x0e, 0xdd, 0x7d, 0x9c, 0xeb, 0x7b, 0xef, 0x71, 0xae, 0xef,
+	0x83, 0xc7, 0xb9, 0xbe, 0x1b, 0x8b, 0x42, 0xd5, 0x94, 0x7a, 0xd0, 0x89, 0x7d, 0xdb, 0x30, 0x37,
+	0x7d, 0x77, 0xc5, 0x37, 0x85, 0xef, 0xb4, 0x8c, 0xba, 0x31, 0x44, 0x3b, 0xe9, 0x8b, 0xff, 0x1f,
+	0x00, 0xba, 0xe5, 0x1c, 0x43, 0xd9, 0x35, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query paginated petrichors
+	Petrichors(ctx context.Context, in *QueryPetrichorsRequest, opts ...grpc.CallOption) (*QueryPetrichorsResponse, error)
+	// Query a specific petrichor by ibc hash
+	IBCPetrichor(ctx context.Context, in *QueryIBCPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error)
+	// Query all paginated petrichor delegations
+	AllPetrichorsDelegations(ctx context.Context, in *QueryAllPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error)
+	// Query petrichor validator
+	PetrichorValidator(ctx context.Context, in *QueryPetrichorValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorResponse, error)
+	// Query all paginated petrichor validators
+	AllPetrichorValidators(ctx context.Context, in *QueryAllPetrichorValidatorsRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorsResponse, error)
+	// Query all paginated petrichor delegations for a delegator addr
+	PetrichorsDelegation(ctx context.Context, in *QueryPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error)
+	// Query all paginated petrichor delegations for a delegator addr and validator_addr
+	PetrichorsDelegationByValidator(ctx context.Context, in *QueryPetrichorsDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error)
+	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
+	PetrichorDelegation(ctx context.Context, in *QueryPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error)
+	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
+	IBCPetrichorDelegation(ctx context.Context, in *QueryIBCPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	PetrichorDelegationRewards(ctx context.Context, in *QueryPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error)
+	// Query paginated rewards for all petrichor delegations of a delegator addr
+	PetrichorDelegatorRewards(ctx context.Context, in *QueryPetrichorDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegatorRewardsResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	IBCPetrichorDelegationRewards(ctx context.Context, in *QueryIBCPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error)
+	// Query paginated undelegations of a delegator addr
+	PetrichorUndelegations(ctx context.Context, in *QueryPetrichorUndelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorUndelegationsResponse, error)
+	// Query paginated undelegations from a validator addr
+	PetrichorUndelegationsByValidator(ctx context.Context, in *QueryPetrichorUndelegationsByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorUndelegationsResponse, error)
+	// Query paginated undelegations of an asset by denom
+	PetrichorUndelegationsByAsset(ctx context.Context, in *QueryPetrichorUndelegationsByAssetRequest, opts ...grpc.CallOption) (*QueryPetrichorUndelegationsResponse, error)
+	// Query paginated redelegations of a delegator addr
+	PetrichorRedelegations(ctx context.Context, in *QueryPetrichorRedelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorRedelegationsResponse, error)
+	// Query paginated redelegations from a source validator addr
+	PetrichorRedelegationsByValidator(ctx context.Context, in *QueryPetrichorRedelegationsByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorRedelegationsResponse, error)
+	// Query paginated redelegations of an asset by denom
+	PetrichorRedelegationsByAsset(ctx context.Context, in *QueryPetrichorRedelegationsByAssetRequest, opts ...grpc.CallOption) (*QueryPetrichorRedelegationsResponse, error)
+	// Query a specific petrichor by denom
+	Petrichor(ctx context.Context, in *QueryPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error)
+	// Query how many more tokens can be delegated for an asset, optionally to a specific validator
+	PetrichorCapacity(ctx context.Context, in *QueryPetrichorCapacityRequest, opts ...grpc.CallOption) (*QueryPetrichorCapacityResponse, error)
+	// Query the cumulative take rate deducted from a petrichor asset and its history
+	PetrichorTakeRate(ctx context.Context, in *QueryPetrichorTakeRateRequest, opts ...grpc.CallOption) (*QueryPetrichorTakeRateResponse, error)
+	// Query the slashes of a validator's petrichor positions since a height
+	PetrichorValidatorSlashes(ctx context.Context, in *QueryPetrichorValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryPetrichorSlashesResponse, error)
+	// Query the slashes that affected a petrichor delegation since a height, including slashes of
+	// immature redelegations into the delegation
+	PetrichorDelegationSlashes(ctx context.Context, in *QueryPetrichorDelegationSlashesRequest, opts ...grpc.CallOption) (*QueryPetrichorSlashesResponse, error)
+	// Query the balance of the insurance fund that covers slashed undelegations
+	PetrichorInsuranceFund(ctx context.Context, in *QueryPetrichorInsuranceFundRequest, opts ...grpc.CallOption) (*QueryPetrichorInsuranceFundResponse, error)
+	// Query paginated insurance claims of slashed undelegations, optionally of a single delegator
+	PetrichorInsuranceClaims(ctx context.Context, in *QueryPetrichorInsuranceClaimsRequest, opts ...grpc.CallOption) (*QueryPetrichorInsuranceClaimsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPetrichorDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPetrichorDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Rewards[iNdEx].Size()
-				i -= size
-				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Petrichors(ctx context.Context, in *QueryPetrichorsRequest, opts ...grpc.CallOption) (*QueryPetrichorsResponse, error) {
+	out := new(QueryPetrichorsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/Petrichors", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPetrichorValidatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) IBCPetrichor(ctx context.Context, in *QueryIBCPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error) {
+	out := new(QueryPetrichorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/IBCPetrichor", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPetrichorValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllPetrichorsDelegations(ctx context.Context, in *QueryAllPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error) {
+	out := new(QueryPetrichorsDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/AllPetrichorsDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPetrichorValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalStaked) > 0 {
-		for iNdEx := len(m.TotalStaked) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalStaked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ValidatorShares) > 0 {
-		for iNdEx := len(m.ValidatorShares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ValidatorShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TotalDelegationShares) > 0 {
-		for iNdEx := len(m.TotalDelegationShares) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalDelegationShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ValidatorAddr) > 0 {
-		i -= len(m.ValidatorAddr)
-		copy(dAtA[i:], m.ValidatorAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) PetrichorValidator(ctx context.Context, in *QueryPetrichorValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorResponse, error) {
+	out := new(QueryPetrichorValidatorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPetrichorValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllPetrichorValidators(ctx context.Context, in *QueryAllPetrichorValidatorsRequest, opts ...grpc.CallOption) (*QueryPetrichorValidatorsResponse, error) {
+	out := new(QueryPetrichorValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/AllPetrichorValidators", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPetrichorValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) PetrichorsDelegation(ctx context.Context, in *QueryPetrichorsDelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error) {
+	out := new(QueryPetrichorsDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorsDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPetrichorValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) PetrichorsDelegationByValidator(ctx context.Context, in *QueryPetrichorsDelegationByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorsDelegationsResponse, error) {
+	out := new(QueryPetrichorsDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorsDelegationByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) PetrichorDelegation(ctx context.Context, in *QueryPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error) {
+	out := new(QueryPetrichorDelegationResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) IBCPetrichorDelegation(ctx context.Context, in *QueryIBCPetrichorDelegationRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationResponse, error) {
+	out := new(QueryPetrichorDelegationResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/IBCPetrichorDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PetrichorDelegationRewards(ctx context.Context, in *QueryPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error) {
+	out := new(QueryPetrichorDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PetrichorDelegatorRewards(ctx context.Context, in *QueryPetrichorDelegatorRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegatorRewardsResponse, error) {
+	out := new(QueryPetrichorDelegatorRewardsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorDelegatorRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Petrichors) > 0 {
-		for _, e := range m.Petrichors {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) IBCPetrichorDelegationRewards(ctx context.Context, in *QueryIBCPetrichorDelegationRewardsRequest, opts ...grpc.CallOption) (*QueryPetrichorDelegationRewardsResponse, error) {
+	out := new(QueryPetrichorDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/IBCPetrichorDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PetrichorUndelegations(ctx context.Context, in *QueryPetrichorUndelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorUndelegationsResponse, error) {
+	out := new(QueryPetrichorUndelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorUndelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Petrichor != nil {
-		l = m.Petrichor.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PetrichorUndelegationsByValidator(ctx context.Context, in *QueryPetrichorUndelegationsByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorUndelegationsResponse, error) {
+	out := new(QueryPetrichorUndelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorUndelegationsByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryIBCPetrichorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PetrichorUndelegationsByAsset(ctx context.Context, in *QueryPetrichorUndelegationsByAssetRequest, opts ...grpc.CallOption) (*QueryPetrichorUndelegationsResponse, error) {
+	out := new(QueryPetrichorUndelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorUndelegationsByAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PetrichorRedelegations(ctx context.Context, in *QueryPetrichorRedelegationsRequest, opts ...grpc.CallOption) (*QueryPetrichorRedelegationsResponse, error) {
+	out := new(QueryPetrichorRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorRedelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) PetrichorRedelegationsByValidator(ctx context.Context, in *QueryPetrichorRedelegationsByValidatorRequest, opts ...grpc.CallOption) (*QueryPetrichorRedelegationsResponse, error) {
+	out := new(QueryPetrichorRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorRedelegationsByValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPetrichorValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PetrichorRedelegationsByAsset(ctx context.Context, in *QueryPetrichorRedelegationsByAssetRequest, opts ...grpc.CallOption) (*QueryPetrichorRedelegationsResponse, error) {
+	out := new(QueryPetrichorRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorRedelegationsByAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAllPetrichorsDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Petrichor(ctx context.Context, in *QueryPetrichorRequest, opts ...grpc.CallOption) (*QueryPetrichorResponse, error) {
+	out := new(QueryPetrichorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/Petrichor", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorsDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PetrichorCapacity(ctx context.Context, in *QueryPetrichorCapacityRequest, opts ...grpc.CallOption) (*QueryPetrichorCapacityResponse, error) {
+	out := new(QueryPetrichorCapacityResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorsDelegationByValidatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PetrichorTakeRate(ctx context.Context, in *QueryPetrichorTakeRateRequest, opts ...grpc.CallOption) (*QueryPetrichorTakeRateResponse, error) {
+	out := new(QueryPetrichorTakeRateResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorTakeRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *DelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PetrichorValidatorSlashes(ctx context.Context, in *QueryPetrichorValidatorSlashesRequest, opts ...grpc.CallOption) (*QueryPetrichorSlashesResponse, error) {
+	out := new(QueryPetrichorSlashesResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorValidatorSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Balance.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorsDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Delegations) > 0 {
-		for _, e := range m.Delegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) PetrichorDelegationSlashes(ctx context.Context, in *QueryPetrichorDelegationSlashesRequest, opts ...grpc.CallOption) (*QueryPetrichorSlashesResponse, error) {
+	out := new(QueryPetrichorSlashesResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorDelegationSlashes", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) PetrichorInsuranceFund(ctx context.Context, in *QueryPetrichorInsuranceFundRequest, opts ...grpc.CallOption) (*QueryPetrichorInsuranceFundResponse, error) {
+	out := new(QueryPetrichorInsuranceFundResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorInsuranceFund", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryPetrichorDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PetrichorInsuranceClaims(ctx context.Context, in *QueryPetrichorInsuranceClaimsRequest, opts ...grpc.CallOption) (*QueryPetrichorInsuranceClaimsResponse, error) {
+	out := new(QueryPetrichorInsuranceClaimsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Query/PetrichorInsuranceClaims", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query paginated petrichors
+	Petrichors(context.Context, *QueryPetrichorsRequest) (*QueryPetrichorsResponse, error)
+	// Query a specific petrichor by ibc hash
+	IBCPetrichor(context.Context, *QueryIBCPetrichorRequest) (*QueryPetrichorResponse, error)
+	// Query all paginated petrichor delegations
+	AllPetrichorsDelegations(context.Context, *QueryAllPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error)
+	// Query petrichor validator
+	PetrichorValidator(context.Context, *QueryPetrichorValidatorRequest) (*QueryPetrichorValidatorResponse, error)
+	// Query all paginated petrichor validators
+	AllPetrichorValidators(context.Context, *QueryAllPetrichorValidatorsRequest) (*QueryPetrichorValidatorsResponse, error)
+	// Query all paginated petrichor delegations for a delegator addr
+	PetrichorsDelegation(context.Context, *QueryPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error)
+	// Query all paginated petrichor delegations for a delegator addr and validator_addr
+	PetrichorsDelegationByValidator(context.Context, *QueryPetrichorsDelegationByValidatorRequest) (*QueryPetrichorsDelegationsResponse, error)
+	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
+	PetrichorDelegation(context.Context, *QueryPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error)
+	// Query a delegation to an petrichor by delegator addr, validator_addr and denom
+	IBCPetrichorDelegation(context.Context, *QueryIBCPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	PetrichorDelegationRewards(context.Context, *QueryPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error)
+	// Query paginated rewards for all petrichor delegations of a delegator addr
+	PetrichorDelegatorRewards(context.Context, *QueryPetrichorDelegatorRewardsRequest) (*QueryPetrichorDelegatorRewardsResponse, error)
+	// Query for rewards by delegator addr, validator_addr and denom
+	IBCPetrichorDelegationRewards(context.Context, *QueryIBCPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error)
+	// Query paginated undelegations of a delegator addr
+	PetrichorUndelegations(context.Context, *QueryPetrichorUndelegationsRequest) (*QueryPetrichorUndelegationsResponse, error)
+	// Query paginated undelegations from a validator addr
+	PetrichorUndelegationsByValidator(context.Context, *QueryPetrichorUndelegationsByValidatorRequest) (*QueryPetrichorUndelegationsResponse, error)
+	// Query paginated undelegations of an asset by denom
+	PetrichorUndelegationsByAsset(context.Context, *QueryPetrichorUndelegationsByAssetRequest) (*QueryPetrichorUndelegationsResponse, error)
+	// Query paginated redelegations of a delegator addr
+	PetrichorRedelegations(context.Context, *QueryPetrichorRedelegationsRequest) (*QueryPetrichorRedelegationsResponse, error)
+	// Query paginated redelegations from a source validator addr
+	PetrichorRedelegationsByValidator(context.Context, *QueryPetrichorRedelegationsByValidatorRequest) (*QueryPetrichorRedelegationsResponse, error)
+	// Query paginated redelegations of an asset by denom
+	PetrichorRedelegationsByAsset(context.Context, *QueryPetrichorRedelegationsByAssetRequest) (*QueryPetrichorRedelegationsResponse, error)
+	// Query a specific petrichor by denom
+	Petrichor(context.Context, *QueryPetrichorRequest) (*QueryPetrichorResponse, error)
+	// Query how many more tokens can be delegated for an asset, optionally to a specific validator
+	PetrichorCapacity(context.Context, *QueryPetrichorCapacityRequest) (*QueryPetrichorCapacityResponse, error)
+	// Query the cumulative take rate deducted from a petrichor asset and its history
+	PetrichorTakeRate(context.Context, *QueryPetrichorTakeRateRequest) (*QueryPetrichorTakeRateResponse, error)
+	// Query the slashes of a validator's petrichor positions since a height
+	PetrichorValidatorSlashes(context.Context, *QueryPetrichorValidatorSlashesRequest) (*QueryPetrichorSlashesResponse, error)
+	// Query the slashes that affected a petrichor delegation since a height, including slashes of
+	// immature redelegations into the delegation
+	PetrichorDelegationSlashes(context.Context, *QueryPetrichorDelegationSlashesRequest) (*QueryPetrichorSlashesResponse, error)
+	// Query the balance of the insurance fund that covers slashed undelegations
+	PetrichorInsuranceFund(context.Context, *QueryPetrichorInsuranceFundRequest) (*QueryPetrichorInsuranceFundResponse, error)
+	// Query paginated insurance claims of slashed undelegations, optionally of a single delegator
+	PetrichorInsuranceClaims(context.Context, *QueryPetrichorInsuranceClaimsRequest) (*QueryPetrichorInsuranceClaimsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Petrichors(ctx context.Context, req *QueryPetrichorsRequest) (*QueryPetrichorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Petrichors not implemented")
+}
+func (*UnimplementedQueryServer) IBCPetrichor(ctx context.Context, req *QueryIBCPetrichorRequest) (*QueryPetrichorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCPetrichor not implemented")
+}
+func (*UnimplementedQueryServer) AllPetrichorsDelegations(ctx context.Context, req *QueryAllPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPetrichorsDelegations not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorValidator(ctx context.Context, req *QueryPetrichorValidatorRequest) (*QueryPetrichorValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorValidator not implemented")
+}
+func (*UnimplementedQueryServer) AllPetrichorValidators(ctx context.Context, req *QueryAllPetrichorValidatorsRequest) (*QueryPetrichorValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPetrichorValidators not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorsDelegation(ctx context.Context, req *QueryPetrichorsDelegationsRequest) (*QueryPetrichorsDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorsDelegation not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorsDelegationByValidator(ctx context.Context, req *QueryPetrichorsDelegationByValidatorRequest) (*QueryPetrichorsDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorsDelegationByValidator not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorDelegation(ctx context.Context, req *QueryPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorDelegation not implemented")
+}
+func (*UnimplementedQueryServer) IBCPetrichorDelegation(ctx context.Context, req *QueryIBCPetrichorDelegationRequest) (*QueryPetrichorDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCPetrichorDelegation not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorDelegationRewards(ctx context.Context, req *QueryPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorDelegatorRewards(ctx context.Context, req *QueryPetrichorDelegatorRewardsRequest) (*QueryPetrichorDelegatorRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorDelegatorRewards not implemented")
+}
+func (*UnimplementedQueryServer) IBCPetrichorDelegationRewards(ctx context.Context, req *QueryIBCPetrichorDelegationRewardsRequest) (*QueryPetrichorDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IBCPetrichorDelegationRewards not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorUndelegations(ctx context.Context, req *QueryPetrichorUndelegationsRequest) (*QueryPetrichorUndelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorUndelegations not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorUndelegationsByValidator(ctx context.Context, req *QueryPetrichorUndelegationsByValidatorRequest) (*QueryPetrichorUndelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorUndelegationsByValidator not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorUndelegationsByAsset(ctx context.Context, req *QueryPetrichorUndelegationsByAssetRequest) (*QueryPetrichorUndelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorUndelegationsByAsset not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorRedelegations(ctx context.Context, req *QueryPetrichorRedelegationsRequest) (*QueryPetrichorRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorRedelegations not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorRedelegationsByValidator(ctx context.Context, req *QueryPetrichorRedelegationsByValidatorRequest) (*QueryPetrichorRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorRedelegationsByValidator not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorRedelegationsByAsset(ctx context.Context, req *QueryPetrichorRedelegationsByAssetRequest) (*QueryPetrichorRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorRedelegationsByAsset not implemented")
+}
+func (*UnimplementedQueryServer) Petrichor(ctx context.Context, req *QueryPetrichorRequest) (*QueryPetrichorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Petrichor not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorCapacity(ctx context.Context, req *QueryPetrichorCapacityRequest) (*QueryPetrichorCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorCapacity not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorTakeRate(ctx context.Context, req *QueryPetrichorTakeRateRequest) (*QueryPetrichorTakeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorTakeRate not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorValidatorSlashes(ctx context.Context, req *QueryPetrichorValidatorSlashesRequest) (*QueryPetrichorSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorValidatorSlashes not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorDelegationSlashes(ctx context.Context, req *QueryPetrichorDelegationSlashesRequest) (*QueryPetrichorSlashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorDelegationSlashes not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorInsuranceFund(ctx context.Context, req *QueryPetrichorInsuranceFundRequest) (*QueryPetrichorInsuranceFundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorInsuranceFund not implemented")
+}
+func (*UnimplementedQueryServer) PetrichorInsuranceClaims(ctx context.Context, req *QueryPetrichorInsuranceClaimsRequest) (*QueryPetrichorInsuranceClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PetrichorInsuranceClaims not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/Params",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIBCPetrichorDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func _Query_Petrichors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Petrichors(ctx, in)
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/Petrichors",
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Petrichors(ctx, req.(*QueryPetrichorsRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPetrichorDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Delegation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func _Query_IBCPetrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCPetrichorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCPetrichor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/IBCPetrichor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCPetrichor(ctx, req.(*QueryIBCPetrichorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPetrichorDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllPetrichorsDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPetrichorsDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AllPetrichorsDelegations(ctx, in)
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/AllPetrichorsDelegations",
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPetrichorsDelegations(ctx, req.(*QueryAllPetrichorsDelegationsRequest))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorValidator(ctx, req.(*QueryPetrichorValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryIBCPetrichorDelegationRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AllPetrichorValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPetrichorValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AllPetrichorValidators(ctx, in)
 	}
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/AllPetrichorValidators",
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPetrichorValidators(ctx, req.(*QueryAllPetrichorValidatorsRequest))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorsDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorsDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorsDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorsDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorsDelegation(ctx, req.(*QueryPetrichorsDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPetrichorDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PetrichorsDelegationByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorsDelegationByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorsDelegationByValidator(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorsDelegationByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorsDelegationByValidator(ctx, req.(*QueryPetrichorsDelegationByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPetrichorValidatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PetrichorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorDelegation(ctx, in)
 	}
-	if len(m.TotalDelegationShares) > 0 {
-		for _, e := range m.TotalDelegationShares {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorDelegation",
 	}
-	if len(m.ValidatorShares) > 0 {
-		for _, e := range m.ValidatorShares {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorDelegation(ctx, req.(*QueryPetrichorDelegationRequest))
 	}
-	if len(m.TotalStaked) > 0 {
-		for _, e := range m.TotalStaked {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IBCPetrichorDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCPetrichorDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).IBCPetrichorDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/IBCPetrichorDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCPetrichorDelegation(ctx, req.(*QueryIBCPetrichorDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPetrichorValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_PetrichorDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorDelegationRewards(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorDelegationRewards",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorDelegationRewards(ctx, req.(*QueryPetrichorDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Query_PetrichorDelegatorRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorDelegatorRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorDelegatorRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorDelegatorRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorDelegatorRewards(ctx, req.(*QueryPetrichorDelegatorRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Query_IBCPetrichorDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIBCPetrichorDelegationRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IBCPetrichorDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/IBCPetrichorDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IBCPetrichorDelegationRewards(ctx, req.(*QueryIBCPetrichorDelegationRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+
+func _Query_PetrichorUndelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorUndelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorUndelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorUndelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorUndelegations(ctx, req.(*QueryPetrichorUndelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorUndelegationsByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorUndelegationsByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorUndelegationsByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorUndelegationsByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorUndelegationsByValidator(ctx, req.(*QueryPetrichorUndelegationsByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorUndelegationsByAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorUndelegationsByAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorUndelegationsByAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorUndelegationsByAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorUndelegationsByAsset(ctx, req.(*QueryPetrichorUndelegationsByAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorRedelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorRedelegations(ctx, req.(*QueryPetrichorRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorRedelegationsByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorRedelegationsByValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorRedelegationsByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorRedelegationsByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorRedelegationsByValidator(ctx, req.(*QueryPetrichorRedelegationsByValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorRedelegationsByAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorRedelegationsByAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorRedelegationsByAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorRedelegationsByAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorRedelegationsByAsset(ctx, req.(*QueryPetrichorRedelegationsByAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Petrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Petrichor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/Petrichor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Petrichor(ctx, req.(*QueryPetrichorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorCapacity(ctx, req.(*QueryPetrichorCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorTakeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorTakeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorTakeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorTakeRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorTakeRate(ctx, req.(*QueryPetrichorTakeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorValidatorSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorValidatorSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorValidatorSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorValidatorSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorValidatorSlashes(ctx, req.(*QueryPetrichorValidatorSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorDelegationSlashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorDelegationSlashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorDelegationSlashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorDelegationSlashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorDelegationSlashes(ctx, req.(*QueryPetrichorDelegationSlashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorInsuranceFund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorInsuranceFundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorInsuranceFund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorInsuranceFund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorInsuranceFund(ctx, req.(*QueryPetrichorInsuranceFundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PetrichorInsuranceClaims_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPetrichorInsuranceClaimsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PetrichorInsuranceClaims(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Query/PetrichorInsuranceClaims",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PetrichorInsuranceClaims(ctx, req.(*QueryPetrichorInsuranceClaimsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "petrichor.petrichor.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Petrichors",
+			Handler:    _Query_Petrichors_Handler,
+		},
+		{
+			MethodName: "IBCPetrichor",
+			Handler:    _Query_IBCPetrichor_Handler,
+		},
+		{
+			MethodName: "AllPetrichorsDelegations",
+			Handler:    _Query_AllPetrichorsDelegations_Handler,
+		},
+		{
+			MethodName: "PetrichorValidator",
+			Handler:    _Query_PetrichorValidator_Handler,
+		},
+		{
+			MethodName: "AllPetrichorValidators",
+			Handler:    _Query_AllPetrichorValidators_Handler,
+		},
+		{
+			MethodName: "PetrichorsDelegation",
+			Handler:    _Query_PetrichorsDelegation_Handler,
+		},
+		{
+			MethodName: "PetrichorsDelegationByValidator",
+			Handler:    _Query_PetrichorsDelegationByValidator_Handler,
+		},
+		{
+			MethodName: "PetrichorDelegation",
+			Handler:    _Query_PetrichorDelegation_Handler,
+		},
+		{
+			MethodName: "IBCPetrichorDelegation",
+			Handler:    _Query_IBCPetrichorDelegation_Handler,
+		},
+		{
+			MethodName: "PetrichorDelegationRewards",
+			Handler:    _Query_PetrichorDelegationRewards_Handler,
+		},
+		{
+			MethodName: "PetrichorDelegatorRewards",
+			Handler:    _Query_PetrichorDelegatorRewards_Handler,
+		},
+		{
+			MethodName: "IBCPetrichorDelegationRewards",
+			Handler:    _Query_IBCPetrichorDelegationRewards_Handler,
+		},
+		{
+			MethodName: "PetrichorUndelegations",
+			Handler:    _Query_PetrichorUndelegations_Handler,
+		},
+		{
+			MethodName: "PetrichorUndelegationsByValidator",
+			Handler:    _Query_PetrichorUndelegationsByValidator_Handler,
+		},
+		{
+			MethodName: "PetrichorUndelegationsByAsset",
+			Handler:    _Query_PetrichorUndelegationsByAsset_Handler,
+		},
+		{
+			MethodName: "PetrichorRedelegations",
+			Handler:    _Query_PetrichorRedelegations_Handler,
+		},
+		{
+			MethodName: "PetrichorRedelegationsByValidator",
+			Handler:    _Query_PetrichorRedelegationsByValidator_Handler,
+		},
+		{
+			MethodName: "PetrichorRedelegationsByAsset",
+			Handler:    _Query_PetrichorRedelegationsByAsset_Handler,
+		},
+		{
+			MethodName: "Petrichor",
+			Handler:    _Query_Petrichor_Handler,
+		},
+		{
+			MethodName: "PetrichorCapacity",
+			Handler:    _Query_PetrichorCapacity_Handler,
+		},
+		{
+			MethodName: "PetrichorTakeRate",
+			Handler:    _Query_PetrichorTakeRate_Handler,
+		},
+		{
+			MethodName: "PetrichorValidatorSlashes",
+			Handler:    _Query_PetrichorValidatorSlashes_Handler,
+		},
+		{
+			MethodName: "PetrichorDelegationSlashes",
+			Handler:    _Query_PetrichorDelegationSlashes_Handler,
+		},
+		{
+			MethodName: "PetrichorInsuranceFund",
+			Handler:    _Query_PetrichorInsuranceFund_Handler,
+		},
+		{
+			MethodName: "PetrichorInsuranceClaims",
+			Handler:    _Query_PetrichorInsuranceClaims_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "petrichor/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Petrichors) > 0 {
+		for iNdEx := len(m.Petrichors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Petrichors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Petrichor != nil {
+		{
+			size, err := m.Petrichor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCPetrichorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCPetrichorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCPetrichorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPetrichorValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPetrichorValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPetrichorValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPetrichorsDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPetrichorsDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPetrichorsDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorsDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorsDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorsDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorsDelegationByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorsDelegationByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorsDelegationByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorsDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorsDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorsDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCPetrichorDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCPetrichorDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCPetrichorDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIBCPetrichorDelegationRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIBCPetrichorDelegationRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIBCPetrichorDelegationRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Segments) > 0 {
+		for iNdEx := len(m.Segments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Segments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Rewards[iNdEx].Size()
+				i -= size
+				if _, err := m.Rewards[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationRewardSegment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationRewardSegment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationRewardSegment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorDelegatorRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorDelegatorRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorDelegatorRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorDelegatorRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorDelegatorRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorDelegatorRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorUndelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorUndelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorUndelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorUndelegationsByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorUndelegationsByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorUndelegationsByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorUndelegationsByAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorUndelegationsByAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorUndelegationsByAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Undelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorUndelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorUndelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorUndelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Undelegations) > 0 {
+		for iNdEx := len(m.Undelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Undelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorRedelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorRedelegationsByValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorRedelegationsByValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorRedelegationsByValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorRedelegationsByAssetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorRedelegationsByAssetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorRedelegationsByAssetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n29, err29 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintQuery(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorRedelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AccumulatedCommission) > 0 {
+		for iNdEx := len(m.AccumulatedCommission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatedCommission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Commission != nil {
+		{
+			size, err := m.Commission.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalStaked) > 0 {
+		for iNdEx := len(m.TotalStaked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalStaked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorShares) > 0 {
+		for iNdEx := len(m.ValidatorShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalDelegationShares) > 0 {
+		for iNdEx := len(m.TotalDelegationShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalDelegationShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingValidatorTokens != nil {
+		{
+			size := m.RemainingValidatorTokens.Size()
+			i -= size
+			if _, err := m.RemainingValidatorTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RemainingTotalTokens != nil {
+		{
+			size := m.RemainingTotalTokens.Size()
+			i -= size
+			if _, err := m.RemainingTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorTakeRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorTakeRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorTakeRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorTakeRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorTakeRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorTakeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Accrual.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorValidatorSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorValidatorSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorValidatorSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorDelegationSlashesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorDelegationSlashesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorDelegationSlashesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorSlashesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorSlashesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorSlashesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Slashes) > 0 {
+		for iNdEx := len(m.Slashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Slashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorInsuranceFundRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorInsuranceFundRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorInsuranceFundRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorInsuranceFundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorInsuranceFundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorInsuranceFundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorInsuranceClaimsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorInsuranceClaimsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorInsuranceClaimsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPetrichorInsuranceClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPetrichorInsuranceClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPetrichorInsuranceClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPetrichorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Petrichors) > 0 {
+		for _, e := range m.Petrichors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Petrichor != nil {
+		l = m.Petrichor.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCPetrichorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPetrichorValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPetrichorsDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorsDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorsDelegationByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPetrichorsDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCPetrichorDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPetrichorDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIBCPetrichorDelegationRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Segments) > 0 {
+		for _, e := range m.Segments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegationRewardSegment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPetrichorDelegatorRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AssetRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPetrichorDelegatorRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorUndelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorUndelegationsByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorUndelegationsByAssetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Undelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPetrichorUndelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Undelegations) > 0 {
+		for _, e := range m.Undelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorRedelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorRedelegationsByValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorRedelegationsByAssetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RedelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Redelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPetrichorRedelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.TotalDelegationShares) > 0 {
+		for _, e := range m.TotalDelegationShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ValidatorShares) > 0 {
+		for _, e := range m.ValidatorShares {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalStaked) > 0 {
+		for _, e := range m.TotalStaked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.AccumulatedCommission) > 0 {
+		for _, e := range m.AccumulatedCommission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPetrichorValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RemainingTotalTokens != nil {
+		l = m.RemainingTotalTokens.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RemainingValidatorTokens != nil {
+		l = m.RemainingValidatorTokens.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorTakeRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorTakeRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Accrual.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorValidatorSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorDelegationSlashesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorSlashesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Slashes) > 0 {
+		for _, e := range m.Slashes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorInsuranceFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPetrichorInsuranceFundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPetrichorInsuranceClaimsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPetrichorInsuranceClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Petrichors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Petrichors = append(m.Petrichors, PetrichorAsset{})
+			if err := m.Petrichors[len(m.Petrichors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Petrichor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Petrichor == nil {
+				m.Petrichor = &PetrichorAsset{}
+			}
+			if err := m.Petrichor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCPetrichorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCPetrichorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCPetrichorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPetrichorValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPetrichorValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPetrichorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPetrichorsDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPetrichorsDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorsDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorsDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorsDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorsDelegationByValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorsDelegationByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorsDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorsDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorsDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, DelegationResponse{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIBCPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Segments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Segments = append(m.Segments, DelegationRewardSegment{})
+			if err := m.Segments[len(m.Segments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegationRewardSegment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationRewardSegment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationRewardSegment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorDelegatorRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorDelegatorRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorDelegatorRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, AssetRewards{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorDelegatorRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorDelegatorRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorDelegatorRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ValidatorRewards{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorUndelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2996,7 +9560,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorUndelegationsByValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3019,15 +9583,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsByValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3054,7 +9650,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3079,7 +9678,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorUndelegationsByAssetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3102,13 +9701,45 @@ func (m *QueryPetrichorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsByAssetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsByAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3165,7 +9796,7 @@ func (m *QueryPetrichorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorsResponse) Unmarshal(dAtA []byte) error {
+func (m *UndelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3188,15 +9819,15 @@ func (m *QueryPetrichorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UndelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Petrichors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3223,14 +9854,13 @@ func (m *QueryPetrichorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Petrichors = append(m.Petrichors, PetrichorAsset{})
-			if err := m.Petrichors[len(m.Petrichors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Undelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3257,10 +9887,7 @@ func (m *QueryPetrichorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3285,7 +9912,7 @@ func (m *QueryPetrichorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorUndelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,17 +9935,17 @@ func (m *QueryPetrichorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorUndelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Undelegations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3328,23 +9955,61 @@ func (m *QueryPetrichorRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Undelegations = append(m.Undelegations, UndelegationResponse{})
+			if err := m.Undelegations[len(m.Undelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3367,7 +10032,7 @@ func (m *QueryPetrichorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorRedelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,15 +10055,47 @@ func (m *QueryPetrichorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Petrichor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3425,10 +10122,10 @@ func (m *QueryPetrichorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Petrichor == nil {
-				m.Petrichor = &PetrichorAsset{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Petrichor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3453,7 +10150,7 @@ func (m *QueryPetrichorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCPetrichorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorRedelegationsByValidatorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3476,15 +10173,15 @@ func (m *QueryIBCPetrichorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCPetrichorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsByValidatorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCPetrichorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3501,18 +10198,54 @@ func (m *QueryIBCPetrichorRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3535,7 +10268,7 @@ func (m *QueryIBCPetrichorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorValidatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorRedelegationsByAssetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3558,15 +10291,15 @@ func (m *QueryPetrichorValidatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorValidatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsByAssetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsByAssetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3594,7 +10327,43 @@ func (m *QueryPetrichorValidatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3617,7 +10386,7 @@ func (m *QueryPetrichorValidatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPetrichorValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *RedelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3640,15 +10409,15 @@ func (m *QueryAllPetrichorValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPetrichorValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPetrichorValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3675,10 +10444,73 @@ func (m *QueryAllPetrichorValidatorsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			if err := m.Redelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3703,7 +10535,7 @@ func (m *QueryAllPetrichorValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorRedelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3726,13 +10558,47 @@ func (m *QueryAllPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPetrichorsDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPetrichorsDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, RedelegationResponse{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3762,7 +10628,7 @@ func (m *QueryAllPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3789,7 +10655,7 @@ func (m *QueryAllPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorValidatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3812,15 +10678,15 @@ func (m *QueryPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorsDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorValidatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorsDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3848,11 +10714,11 @@ func (m *QueryPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegationShares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3879,68 +10745,50 @@ func (m *QueryPetrichorsDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TotalDelegationShares = append(m.TotalDelegationShares, types.DecCoin{})
+			if err := m.TotalDelegationShares[len(m.TotalDelegationShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPetrichorsDelegationByValidatorRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
+			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorsDelegationByValidatorRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorsDelegationByValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3950,29 +10798,31 @@ func (m *QueryPetrichorsDelegationByValidatorRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			m.TotalStaked = append(m.TotalStaked, types.DecCoin{})
+			if err := m.TotalStaked[len(m.TotalStaked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3982,27 +10832,31 @@ func (m *QueryPetrichorsDelegationByValidatorRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			if m.Commission == nil {
+				m.Commission = &types1.Commission{}
+			}
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedCommission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4029,10 +10883,8 @@ func (m *QueryPetrichorsDelegationByValidatorRequest) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AccumulatedCommission = append(m.AccumulatedCommission, types.Coin{})
+			if err := m.AccumulatedCommission[len(m.AccumulatedCommission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4057,7 +10909,7 @@ func (m *QueryPetrichorsDelegationByValidatorRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorValidatorsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4080,15 +10932,15 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorValidatorsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4115,13 +10967,14 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Validators = append(m.Validators, QueryPetrichorValidatorResponse{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4148,7 +11001,10 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4173,7 +11029,7 @@ func (m *DelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorsDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorCapacityRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4196,17 +11052,17 @@ func (m *QueryPetrichorsDelegationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorsDelegationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorCapacityRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorsDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4216,31 +11072,29 @@ func (m *QueryPetrichorsDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegations = append(m.Delegations, DelegationResponse{})
-			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4250,27 +11104,23 @@ func (m *QueryPetrichorsDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4293,7 +11143,7 @@ func (m *QueryPetrichorsDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorCapacityResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4316,15 +11166,15 @@ func (m *QueryPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorCapacityResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingTotalTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4352,11 +11202,15 @@ func (m *QueryPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.RemainingTotalTokens = &v
+			if err := m.RemainingTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingValidatorTokens", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4384,9 +11238,63 @@ func (m *QueryPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.RemainingValidatorTokens = &v
+			if err := m.RemainingValidatorTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorTakeRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorTakeRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorTakeRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -4418,7 +11326,7 @@ func (m *QueryPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4475,7 +11383,7 @@ func (m *QueryPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorTakeRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4498,17 +11406,17 @@ func (m *QueryIBCPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorTakeRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorTakeRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrual", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4518,29 +11426,30 @@ func (m *QueryIBCPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Accrual.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4550,57 +11459,27 @@ func (m *QueryIBCPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.History = append(m.History, TakeRateRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4630,7 +11509,7 @@ func (m *QueryIBCPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4657,7 +11536,7 @@ func (m *QueryIBCPetrichorDelegationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorDelegationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorValidatorSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4680,15 +11559,66 @@ func (m *QueryPetrichorDelegationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorValidatorSlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorValidatorSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4715,7 +11645,10 @@ func (m *QueryPetrichorDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Delegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4740,7 +11673,7 @@ func (m *QueryPetrichorDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorDelegationSlashesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4763,10 +11696,10 @@ func (m *QueryPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorDelegationSlashesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorDelegationSlashesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4866,6 +11799,25 @@ func (m *QueryPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4922,7 +11874,7 @@ func (m *QueryPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIBCPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorSlashesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4945,49 +11897,17 @@ func (m *QueryIBCPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorSlashesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIBCPetrichorDelegationRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorSlashesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4997,57 +11917,27 @@ func (m *QueryIBCPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Slashes = append(m.Slashes, SlashRecord{})
+			if err := m.Slashes[len(m.Slashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5077,7 +11967,7 @@ func (m *QueryIBCPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5104,7 +11994,7 @@ func (m *QueryIBCPetrichorDelegationRewardsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryPetrichorDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorInsuranceFundRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5127,15 +12017,65 @@ func (m *QueryPetrichorDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorInsuranceFundRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorInsuranceFundRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPetrichorInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPetrichorInsuranceFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPetrichorInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5162,8 +12102,8 @@ func (m *QueryPetrichorDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5188,7 +12128,7 @@ func (m *QueryPetrichorDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorValidatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorInsuranceClaimsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5211,15 +12151,15 @@ func (m *QueryPetrichorValidatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorValidatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorInsuranceClaimsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorInsuranceClaimsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5247,45 +12187,11 @@ func (m *QueryPetrichorValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalDelegationShares", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalDelegationShares = append(m.TotalDelegationShares, types.DecCoin{})
-			if err := m.TotalDelegationShares[len(m.TotalDelegationShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorShares", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5312,42 +12218,10 @@ func (m *QueryPetrichorValidatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorShares = append(m.ValidatorShares, types.DecCoin{})
-			if err := m.ValidatorShares[len(m.ValidatorShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalStaked", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			m.TotalStaked = append(m.TotalStaked, types.DecCoin{})
-			if err := m.TotalStaked[len(m.TotalStaked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5372,7 +12246,7 @@ func (m *QueryPetrichorValidatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPetrichorValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPetrichorInsuranceClaimsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5395,15 +12269,15 @@ func (m *QueryPetrichorValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPetrichorValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPetrichorInsuranceClaimsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPetrichorValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPetrichorInsuranceClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5430,8 +12304,8 @@ func (m *QueryPetrichorValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, QueryPetrichorValidatorResponse{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Claims = append(m.Claims, InsuranceClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
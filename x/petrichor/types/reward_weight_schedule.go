@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateRewardWeightSchedule checks that a schedule is only set in schedule mode, that its points are strictly
+// ordered by time and that every target is a positive weight
+func ValidateRewardWeightSchedule(mode RewardWeightMode, schedule []RewardWeightSchedulePoint, interpolation RewardWeightInterpolation) error {
+	if _, ok := RewardWeightInterpolation_name[int32(interpolation)]; !ok {
+		return fmt.Errorf("unknown reward weight interpolation %d", interpolation)
+	}
+	if mode != RewardWeightModeSchedule {
+		if len(schedule) > 0 {
+			return fmt.Errorf("reward weight schedule can only be set in schedule mode")
+		}
+		return nil
+	}
+	if len(schedule) == 0 {
+		return fmt.Errorf("reward weight schedule must have at least one point in schedule mode")
+	}
+	for i, point := range schedule {
+		if point.TargetWeight.IsNil() || !point.TargetWeight.IsPositive() {
+			return fmt.Errorf("reward weight target at %s must be a positive number", point.Time)
+		}
+		if i > 0 && !point.Time.After(schedule[i-1].Time) {
+			return fmt.Errorf("reward weight schedule must be strictly ordered by time")
+		}
+	}
+	return nil
+}
+
+func (a PetrichorAsset) IsScheduleWeighted() bool {
+	return a.RewardWeightMode == RewardWeightModeSchedule
+}
+
+// ScheduledRewardWeight returns the reward weight set by the schedule at t, bounded by min_reward_weight and
+// max_reward_weight. found is false before the first point of the schedule, in which case the weight is left as is.
+// A linearly interpolated weight moves in steps of RewardWeightUpdateInterval from the last reached point so that it
+// does not change on every block
+func (a PetrichorAsset) ScheduledRewardWeight(t time.Time) (weight sdk.Dec, found bool) {
+	schedule := a.RewardWeightSchedule
+	if len(schedule) == 0 || t.Before(schedule[0].Time) {
+		return sdk.Dec{}, false
+	}
+	// Find the last point that has been reached
+	i := 0
+	for i+1 < len(schedule) && !t.Before(schedule[i+1].Time) {
+		i++
+	}
+	weight = schedule[i].TargetWeight
+	if a.RewardWeightInterpolation == RewardWeightInterpolationLinear && i+1 < len(schedule) {
+		from, to := schedule[i], schedule[i+1]
+		interval := a.RewardWeightUpdateInterval()
+		elapsed := t.Sub(from.Time) / interval * interval
+		progress := sdk.NewDec(elapsed.Nanoseconds()).Quo(sdk.NewDec(to.Time.Sub(from.Time).Nanoseconds()))
+		weight = from.TargetWeight.Add(to.TargetWeight.Sub(from.TargetWeight).Mul(progress))
+	}
+	return a.ClampRewardWeight(weight), true
+}
@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRewardWeightSchedule(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	schedule := []types.RewardWeightSchedulePoint{
+		{Time: start, TargetWeight: sdk.NewDec(2)},
+		{Time: start.Add(time.Hour), TargetWeight: sdk.NewDec(1)},
+	}
+	require.NoError(t, types.ValidateRewardWeightSchedule(types.RewardWeightModeStatic, nil, types.RewardWeightInterpolationLinear))
+	require.NoError(t, types.ValidateRewardWeightSchedule(types.RewardWeightModeSchedule, schedule, types.RewardWeightInterpolationStep))
+
+	// A schedule is only used in schedule mode
+	require.Error(t, types.ValidateRewardWeightSchedule(types.RewardWeightModeStatic, schedule, types.RewardWeightInterpolationLinear))
+	// Schedule mode requires at least one point
+	require.Error(t, types.ValidateRewardWeightSchedule(types.RewardWeightModeSchedule, nil, types.RewardWeightInterpolationLinear))
+	// Points must be strictly ordered by time
+	require.Error(t, types.ValidateRewardWeightSchedule(types.RewardWeightModeSchedule, []types.RewardWeightSchedulePoint{
+		{Time: start, TargetWeight: sdk.NewDec(2)},
+		{Time: start, TargetWeight: sdk.NewDec(1)},
+	}, types.RewardWeightInterpolationLinear))
+	// Targets must be positive
+	require.Error(t, types.ValidateRewardWeightSchedule(types.RewardWeightModeSchedule, []types.RewardWeightSchedulePoint{
+		{Time: start, TargetWeight: sdk.ZeroDec()},
+	}, types.RewardWeightInterpolationLinear))
+	// Unknown interpolations are rejected
+	require.Error(t, types.ValidateRewardWeightSchedule(types.RewardWeightModeSchedule, schedule, types.RewardWeightInterpolation(5)))
+}
+
+func TestScheduledRewardWeight(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	asset := types.PetrichorAsset{
+		RewardWeight:     sdk.NewDec(1),
+		RewardWeightMode: types.RewardWeightModeSchedule,
+		RewardWeightSchedule: []types.RewardWeightSchedulePoint{
+			{Time: start, TargetWeight: sdk.NewDec(2)},
+			{Time: start.Add(time.Hour * 4), TargetWeight: sdk.NewDec(4)},
+			{Time: start.Add(time.Hour * 6), TargetWeight: sdk.NewDec(1)},
+		},
+	}
+
+	// Nothing is scheduled before the first point
+	_, found := asset.ScheduledRewardWeight(start.Add(-time.Second))
+	require.False(t, found)
+
+	// Linear interpolation between points in steps of the update interval and the last target afterwards
+	weight, found := asset.ScheduledRewardWeight(start)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(2), weight)
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Minute * 59))
+	require.Equal(t, sdk.NewDec(2), weight)
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Hour * 2))
+	require.Equal(t, sdk.NewDec(3), weight)
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Minute * 330))
+	require.Equal(t, sdk.MustNewDecFromStr("2.5"), weight)
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Hour * 7))
+	require.Equal(t, sdk.NewDec(1), weight)
+
+	// A longer reward change interval makes longer steps
+	asset.RewardChangeInterval = time.Hour * 2
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Hour * 3))
+	require.Equal(t, sdk.NewDec(3), weight)
+	asset.RewardChangeInterval = 0
+
+	// Step interpolation holds each target until the next point
+	asset.RewardWeightInterpolation = types.RewardWeightInterpolationStep
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Hour*4 - time.Second))
+	require.Equal(t, sdk.NewDec(2), weight)
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Hour * 4))
+	require.Equal(t, sdk.NewDec(4), weight)
+
+	// Targets are bounded by the floor and ceiling
+	floor := sdk.MustNewDecFromStr("1.5")
+	ceiling := sdk.NewDec(3)
+	asset.MinRewardWeight = &floor
+	asset.MaxRewardWeight = &ceiling
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Hour * 4))
+	require.Equal(t, ceiling, weight)
+	weight, _ = asset.ScheduledRewardWeight(start.Add(time.Hour * 6))
+	require.Equal(t, floor, weight)
+}
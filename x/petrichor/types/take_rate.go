@@ -0,0 +1,64 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TakeRateDestinations lists the take rate destinations in the order in which proceeds are distributed
+var TakeRateDestinations = []TakeRateDestination{
+	TakeRateDestinationFeeCollector,
+	TakeRateDestinationCommunityPool,
+	TakeRateDestinationBuyback,
+	TakeRateDestinationBurn,
+	TakeRateDestinationInsuranceFund,
+}
+
+// ValidateTakeRateSplits checks that every destination is known and used once and that the ratios sum to 1.
+// An empty split is valid and sends everything to the fee collector
+func ValidateTakeRateSplits(splits []TakeRateSplit) error {
+	if len(splits) == 0 {
+		return nil
+	}
+	seen := map[TakeRateDestination]bool{}
+	total := sdk.ZeroDec()
+	for _, split := range splits {
+		if _, ok := TakeRateDestination_name[int32(split.Destination)]; !ok {
+			return fmt.Errorf("unknown take rate destination %d", split.Destination)
+		}
+		if seen[split.Destination] {
+			return fmt.Errorf("take rate destination %s is used more than once", split.Destination)
+		}
+		seen[split.Destination] = true
+		if split.Ratio.IsNil() || !split.Ratio.IsPositive() {
+			return fmt.Errorf("take rate ratio of %s must be a positive number", split.Destination)
+		}
+		total = total.Add(split.Ratio)
+	}
+	if !total.Equal(sdk.OneDec()) {
+		return fmt.Errorf("take rate ratios must sum to 1, got %s", total)
+	}
+	return nil
+}
+
+// SplitTakeRate divides amount across the destinations of the split. Amounts are truncated and the remainder goes
+// to the last destination of the split so that nothing is left behind
+func SplitTakeRate(amount math.Int, splits []TakeRateSplit) map[TakeRateDestination]math.Int {
+	if len(splits) == 0 {
+		return map[TakeRateDestination]math.Int{TakeRateDestinationFeeCollector: amount}
+	}
+	amounts := map[TakeRateDestination]math.Int{}
+	remaining := amount
+	for i, split := range splits {
+		if i == len(splits)-1 {
+			amounts[split.Destination] = remaining
+			break
+		}
+		share := split.Ratio.MulInt(amount).TruncateInt()
+		amounts[split.Destination] = share
+		remaining = remaining.Sub(share)
+	}
+	return amounts
+}
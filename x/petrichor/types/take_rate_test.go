@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/petrinetwork/petrichor/x/petrichor/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTakeRateSplits(t *testing.T) {
+	require.NoError(t, types.ValidateTakeRateSplits(nil))
+	require.NoError(t, types.ValidateTakeRateSplits([]types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationFeeCollector, Ratio: sdk.MustNewDecFromStr("0.6")},
+		{Destination: types.TakeRateDestinationBurn, Ratio: sdk.MustNewDecFromStr("0.4")},
+	}))
+
+	// Ratios must sum to 1
+	require.Error(t, types.ValidateTakeRateSplits([]types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationFeeCollector, Ratio: sdk.MustNewDecFromStr("0.6")},
+	}))
+	// Destinations can only be used once
+	require.Error(t, types.ValidateTakeRateSplits([]types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationBurn, Ratio: sdk.MustNewDecFromStr("0.5")},
+		{Destination: types.TakeRateDestinationBurn, Ratio: sdk.MustNewDecFromStr("0.5")},
+	}))
+	// Ratios must be positive
+	require.Error(t, types.ValidateTakeRateSplits([]types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationFeeCollector, Ratio: sdk.MustNewDecFromStr("1.5")},
+		{Destination: types.TakeRateDestinationBurn, Ratio: sdk.MustNewDecFromStr("-0.5")},
+	}))
+	// Unknown destinations are rejected
+	require.Error(t, types.ValidateTakeRateSplits([]types.TakeRateSplit{
+		{Destination: types.TakeRateDestination(10), Ratio: sdk.OneDec()},
+	}))
+}
+
+func TestSplitTakeRate(t *testing.T) {
+	amounts := types.SplitTakeRate(sdk.NewInt(10), []types.TakeRateSplit{
+		{Destination: types.TakeRateDestinationFeeCollector, Ratio: sdk.MustNewDecFromStr("0.33")},
+		{Destination: types.TakeRateDestinationCommunityPool, Ratio: sdk.MustNewDecFromStr("0.33")},
+		{Destination: types.TakeRateDestinationBurn, Ratio: sdk.MustNewDecFromStr("0.34")},
+	})
+	require.Equal(t, sdk.NewInt(3), amounts[types.TakeRateDestinationFeeCollector])
+	require.Equal(t, sdk.NewInt(3), amounts[types.TakeRateDestinationCommunityPool])
+	require.Equal(t, sdk.NewInt(4), amounts[types.TakeRateDestinationBurn])
+
+	amounts = types.SplitTakeRate(sdk.NewInt(10), nil)
+	require.Equal(t, sdk.NewInt(10), amounts[types.TakeRateDestinationFeeCollector])
+}
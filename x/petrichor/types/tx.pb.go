@@ -7,24 +7,29 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -333,666 +338,10445 @@ func (m *MsgClaimDelegationRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimDelegationRewardsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgDelegate)(nil), "petrichor.petrichor.MsgDelegate")
-	proto.RegisterType((*MsgDelegateResponse)(nil), "petrichor.petrichor.MsgDelegateResponse")
-	proto.RegisterType((*MsgUndelegate)(nil), "petrichor.petrichor.MsgUndelegate")
-	proto.RegisterType((*MsgUndelegateResponse)(nil), "petrichor.petrichor.MsgUndelegateResponse")
-	proto.RegisterType((*MsgRedelegate)(nil), "petrichor.petrichor.MsgRedelegate")
-	proto.RegisterType((*MsgRedelegateResponse)(nil), "petrichor.petrichor.MsgRedelegateResponse")
-	proto.RegisterType((*MsgClaimDelegationRewards)(nil), "petrichor.petrichor.MsgClaimDelegationRewards")
-	proto.RegisterType((*MsgClaimDelegationRewardsResponse)(nil), "petrichor.petrichor.MsgClaimDelegationRewardsResponse")
+// MsgLiquidDelegate delegates an petrichor asset through the liquid staking pool
+// and mints a transferable receipt to the delegator
+type MsgLiquidDelegate struct {
+	DelegatorAddress string                                  `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string                                  `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 }
 
-func init() { proto.RegisterFile("petrichor/tx.proto", fileDescriptor_83a06f40bda03baf) }
-
-var fileDescriptor_83a06f40bda03baf = []byte{
-	// 552 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xb7, 0x13, 0xa8, 0xe0, 0x2a, 0x24, 0x70, 0x12, 0x9a, 0x64, 0x70, 0x42, 0x18, 0x88, 0x2a,
-	0xd5, 0x56, 0x5a, 0x89, 0x81, 0x8d, 0xb4, 0x6c, 0x78, 0x71, 0x05, 0x03, 0x42, 0xaa, 0xfc, 0xe7,
-	0x74, 0xb5, 0x1a, 0xfb, 0xa2, 0x7b, 0xd7, 0xb4, 0x6c, 0x88, 0x89, 0x91, 0x0f, 0x80, 0x44, 0xf9,
-	0x06, 0x0c, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x62, 0x28, 0x28, 0x19, 0xe0, 0x13, 0x30, 0xa3, 0xf8,
-	0x2e, 0x67, 0x57, 0x89, 0x95, 0x22, 0x21, 0xc1, 0xc0, 0x74, 0xef, 0xee, 0xfd, 0xde, 0xcf, 0x7e,
-	0xbf, 0xf7, 0xee, 0x1d, 0x32, 0x86, 0x98, 0xb3, 0x28, 0xd8, 0xa7, 0xcc, 0xe6, 0xc7, 0xd6, 0x90,
-	0x51, 0x4e, 0x8d, 0x8a, 0x3a, 0xb3, 0x94, 0xd5, 0xac, 0x12, 0x4a, 0x68, 0xea, 0xb7, 0xa7, 0x96,
-	0x80, 0x36, 0x1b, 0x01, 0x85, 0x98, 0xc2, 0x9e, 0x70, 0x88, 0x8d, 0x74, 0xad, 0x89, 0x9d, 0x1d,
-	0x03, 0xb1, 0x47, 0xbd, 0xe9, 0x22, 0x1d, 0xa6, 0x74, 0xf8, 0x1e, 0x60, 0x7b, 0xd4, 0xf3, 0x31,
-	0xf7, 0x7a, 0x76, 0x40, 0xa3, 0x44, 0xf8, 0x3b, 0xef, 0x4a, 0x68, 0xd5, 0x01, 0xb2, 0x83, 0x07,
-	0x98, 0x78, 0x1c, 0x1b, 0x8f, 0xd0, 0xad, 0x50, 0xd8, 0x94, 0xed, 0x79, 0x61, 0xc8, 0x30, 0x40,
-	0x5d, 0x6f, 0xeb, 0xdd, 0xeb, 0xfd, 0xfa, 0xa7, 0x8f, 0x1b, 0x55, 0xf9, 0xd5, 0x87, 0xc2, 0xb3,
-	0xcb, 0x59, 0x94, 0x10, 0xf7, 0xa6, 0x0a, 0x91, 0xe7, 0x53, 0x9a, 0x91, 0x37, 0x88, 0xc2, 0x0b,
-	0x34, 0xa5, 0x65, 0x34, 0x2a, 0x64, 0x46, 0xe3, 0xa3, 0x15, 0x2f, 0xa6, 0x87, 0x09, 0xaf, 0x97,
-	0xdb, 0x7a, 0x77, 0x75, 0xb3, 0x61, 0xc9, 0xc0, 0x69, 0x3a, 0x96, 0x4c, 0xc7, 0xda, 0xa6, 0x51,
-	0xd2, 0xb7, 0x4f, 0xcf, 0x5b, 0xda, 0x97, 0xf3, 0xd6, 0x3d, 0x12, 0xf1, 0xfd, 0x43, 0xdf, 0x0a,
-	0x68, 0x2c, 0x25, 0x92, 0xcb, 0x06, 0x84, 0x07, 0x36, 0x7f, 0x31, 0xc4, 0x90, 0x06, 0xb8, 0x92,
-	0xf9, 0x81, 0xf9, 0xfa, 0xa4, 0xa5, 0xfd, 0x38, 0x69, 0x69, 0xaf, 0xbe, 0x7f, 0x58, 0x9f, 0x4f,
-	0xbe, 0x53, 0x43, 0x95, 0x9c, 0x40, 0x2e, 0x86, 0x21, 0x4d, 0x00, 0x77, 0xde, 0x97, 0xd0, 0x0d,
-	0x07, 0xc8, 0x93, 0x24, 0xfc, 0x2f, 0x5d, 0x91, 0x74, 0x6b, 0xa8, 0x76, 0x41, 0x22, 0x25, 0xde,
-	0x4f, 0x21, 0x9e, 0x8b, 0xff, 0xb4, 0x78, 0x8f, 0x51, 0x2d, 0x13, 0x0f, 0x58, 0x70, 0x69, 0x01,
-	0x2b, 0x2a, 0x6c, 0x97, 0x05, 0x0b, 0xd9, 0x42, 0xe0, 0x8a, 0xad, 0x7c, 0x69, 0xb6, 0x1d, 0xe0,
-	0xf3, 0x15, 0xb9, 0xf2, 0x97, 0x2b, 0x92, 0xe9, 0xae, 0x2a, 0xf2, 0x55, 0x47, 0x0d, 0x07, 0xc8,
-	0xf6, 0xc0, 0x8b, 0x62, 0xd9, 0xeb, 0x11, 0x4d, 0x5c, 0x7c, 0xe4, 0xb1, 0x10, 0xfe, 0xb1, 0xd6,
-	0xae, 0xa2, 0xab, 0x21, 0x4e, 0x68, 0x2c, 0xca, 0xe0, 0x8a, 0xcd, 0xd2, 0xd4, 0xef, 0xa2, 0x3b,
-	0x85, 0x09, 0xce, 0x64, 0xd8, 0x7c, 0x5b, 0x46, 0x65, 0x07, 0x88, 0xf1, 0x14, 0x5d, 0x53, 0x23,
-	0xb1, 0x6d, 0x2d, 0x18, 0xd1, 0x56, 0x6e, 0x26, 0x34, 0xbb, 0xcb, 0x10, 0x33, 0x7e, 0xe3, 0x39,
-	0x42, 0xb9, 0xa6, 0xef, 0x14, 0xc5, 0x65, 0x98, 0xe6, 0xfa, 0x72, 0x4c, 0x9e, 0x3d, 0x37, 0x8f,
-	0x0a, 0xd9, 0x33, 0x4c, 0x31, 0xfb, 0xfc, 0xa5, 0x35, 0x5e, 0xea, 0xe8, 0x76, 0x41, 0x7f, 0x58,
-	0x45, 0x34, 0x8b, 0xf1, 0xcd, 0xfb, 0xbf, 0x87, 0x9f, 0xfd, 0x42, 0xdf, 0x39, 0x1d, 0x9b, 0xfa,
-	0xd9, 0xd8, 0xd4, 0xbf, 0x8d, 0x4d, 0xfd, 0xcd, 0xc4, 0xd4, 0xce, 0x26, 0xa6, 0xf6, 0x79, 0x62,
-	0x6a, 0xcf, 0xb6, 0x72, 0x37, 0x25, 0x65, 0x4c, 0x30, 0x3f, 0xa2, 0xec, 0xc0, 0xce, 0x9e, 0xdc,
-	0xe3, 0x9c, 0x9d, 0x5e, 0x1d, 0x7f, 0x25, 0x7d, 0x03, 0xb7, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x5a, 0x94, 0x6c, 0x24, 0x98, 0x07, 0x00, 0x00,
+func (m *MsgLiquidDelegate) Reset()         { *m = MsgLiquidDelegate{} }
+func (m *MsgLiquidDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidDelegate) ProtoMessage()    {}
+func (*MsgLiquidDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{8}
+}
+func (m *MsgLiquidDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLiquidDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLiquidDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidDelegate.Merge(m, src)
+}
+func (m *MsgLiquidDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidDelegate.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgLiquidDelegate proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
-	Redelegate(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
-	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
-	ClaimDelegationRewards(ctx context.Context, in *MsgClaimDelegationRewards, opts ...grpc.CallOption) (*MsgClaimDelegationRewardsResponse, error)
+type MsgLiquidDelegateResponse struct {
+	Receipt github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=receipt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"receipt"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgLiquidDelegateResponse) Reset()         { *m = MsgLiquidDelegateResponse{} }
+func (m *MsgLiquidDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLiquidDelegateResponse) ProtoMessage()    {}
+func (*MsgLiquidDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{9}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgLiquidDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error) {
-	out := new(MsgDelegateResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/Delegate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgLiquidDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLiquidDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgLiquidDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLiquidDelegateResponse.Merge(m, src)
+}
+func (m *MsgLiquidDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLiquidDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLiquidDelegateResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Redelegate(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
-	out := new(MsgRedelegateResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/Redelegate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgLiquidDelegateResponse proto.InternalMessageInfo
+
+// MsgRedeemLiquidReceipt burns a liquid staking receipt and undelegates the
+// underlying shares to the holder
+type MsgRedeemLiquidReceipt struct {
+	HolderAddress string                                  `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	Amount        github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 }
 
-func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
-	out := new(MsgUndelegateResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/Undelegate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRedeemLiquidReceipt) Reset()         { *m = MsgRedeemLiquidReceipt{} }
+func (m *MsgRedeemLiquidReceipt) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemLiquidReceipt) ProtoMessage()    {}
+func (*MsgRedeemLiquidReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{10}
+}
+func (m *MsgRedeemLiquidReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemLiquidReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemLiquidReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRedeemLiquidReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemLiquidReceipt.Merge(m, src)
+}
+func (m *MsgRedeemLiquidReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemLiquidReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemLiquidReceipt.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimDelegationRewards(ctx context.Context, in *MsgClaimDelegationRewards, opts ...grpc.CallOption) (*MsgClaimDelegationRewardsResponse, error) {
-	out := new(MsgClaimDelegationRewardsResponse)
-	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/ClaimDelegationRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgRedeemLiquidReceipt proto.InternalMessageInfo
+
+type MsgRedeemLiquidReceiptResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
-	Redelegate(context.Context, *MsgRedelegate) (*MsgRedelegateResponse, error)
-	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
-	ClaimDelegationRewards(context.Context, *MsgClaimDelegationRewards) (*MsgClaimDelegationRewardsResponse, error)
+func (m *MsgRedeemLiquidReceiptResponse) Reset()         { *m = MsgRedeemLiquidReceiptResponse{} }
+func (m *MsgRedeemLiquidReceiptResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemLiquidReceiptResponse) ProtoMessage()    {}
+func (*MsgRedeemLiquidReceiptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{11}
+}
+func (m *MsgRedeemLiquidReceiptResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemLiquidReceiptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemLiquidReceiptResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemLiquidReceiptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemLiquidReceiptResponse.Merge(m, src)
+}
+func (m *MsgRedeemLiquidReceiptResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemLiquidReceiptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemLiquidReceiptResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgRedeemLiquidReceiptResponse proto.InternalMessageInfo
+
+type MsgClaimLiquidReceiptRewards struct {
+	HolderAddress string `protobuf:"bytes,1,opt,name=holder_address,json=holderAddress,proto3" json:"holder_address,omitempty"`
+	ReceiptDenom  string `protobuf:"bytes,2,opt,name=receipt_denom,json=receiptDenom,proto3" json:"receipt_denom,omitempty"`
 }
 
-func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (*MsgDelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
+func (m *MsgClaimLiquidReceiptRewards) Reset()         { *m = MsgClaimLiquidReceiptRewards{} }
+func (m *MsgClaimLiquidReceiptRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLiquidReceiptRewards) ProtoMessage()    {}
+func (*MsgClaimLiquidReceiptRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{12}
 }
-func (*UnimplementedMsgServer) Redelegate(ctx context.Context, req *MsgRedelegate) (*MsgRedelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Redelegate not implemented")
+func (m *MsgClaimLiquidReceiptRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+func (m *MsgClaimLiquidReceiptRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLiquidReceiptRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) ClaimDelegationRewards(ctx context.Context, req *MsgClaimDelegationRewards) (*MsgClaimDelegationRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimDelegationRewards not implemented")
+func (m *MsgClaimLiquidReceiptRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLiquidReceiptRewards.Merge(m, src)
+}
+func (m *MsgClaimLiquidReceiptRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLiquidReceiptRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLiquidReceiptRewards.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgClaimLiquidReceiptRewards proto.InternalMessageInfo
+
+type MsgClaimLiquidReceiptRewardsResponse struct {
 }
 
-func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Delegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Msg/Delegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Delegate(ctx, req.(*MsgDelegate))
+func (m *MsgClaimLiquidReceiptRewardsResponse) Reset()         { *m = MsgClaimLiquidReceiptRewardsResponse{} }
+func (m *MsgClaimLiquidReceiptRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimLiquidReceiptRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimLiquidReceiptRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{13}
+}
+func (m *MsgClaimLiquidReceiptRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimLiquidReceiptRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimLiquidReceiptRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimLiquidReceiptRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimLiquidReceiptRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimLiquidReceiptRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimLiquidReceiptRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimLiquidReceiptRewardsResponse.DiscardUnknown(m)
 }
 
-func _Msg_Redelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRedelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Redelegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Msg/Redelegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Redelegate(ctx, req.(*MsgRedelegate))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgClaimLiquidReceiptRewardsResponse proto.InternalMessageInfo
+
+// MsgSetAutoCompound turns auto-compounding of a delegation's rewards on or off
+type MsgSetAutoCompound struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Denom            string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Enabled          bool   `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndelegate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Undelegate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Msg/Undelegate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{14}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
 }
 
-func _Msg_ClaimDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimDelegationRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimDelegationRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/petrichor.petrichor.Msg/ClaimDelegationRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimDelegationRewards(ctx, req.(*MsgClaimDelegationRewards))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+type MsgSetAutoCompoundResponse struct {
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "petrichor.petrichor.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Delegate",
-			Handler:    _Msg_Delegate_Handler,
-		},
-		{
-			MethodName: "Redelegate",
-			Handler:    _Msg_Redelegate_Handler,
-		},
-		{
-			MethodName: "Undelegate",
-			Handler:    _Msg_Undelegate_Handler,
-		},
-		{
-			MethodName: "ClaimDelegationRewards",
-			Handler:    _Msg_ClaimDelegationRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "petrichor/tx.proto",
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{15}
 }
-
-func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
+// MsgSetRewardWithdrawAddress sets the address petrichor rewards of the delegator are paid to
+type MsgSetRewardWithdrawAddress struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	WithdrawAddress  string `protobuf:"bytes,2,opt,name=withdraw_address,json=withdrawAddress,proto3" json:"withdraw_address,omitempty"`
 }
 
-func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetRewardWithdrawAddress) Reset()         { *m = MsgSetRewardWithdrawAddress{} }
+func (m *MsgSetRewardWithdrawAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddress) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{16}
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgDelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetRewardWithdrawAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.Merge(m, src)
 }
-
-func (m *MsgDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetRewardWithdrawAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardWithdrawAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddress.DiscardUnknown(m)
 }
 
-func (m *MsgDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetRewardWithdrawAddress proto.InternalMessageInfo
+
+type MsgSetRewardWithdrawAddressResponse struct {
 }
 
-func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetRewardWithdrawAddressResponse) Reset()         { *m = MsgSetRewardWithdrawAddressResponse{} }
+func (m *MsgSetRewardWithdrawAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRewardWithdrawAddressResponse) ProtoMessage()    {}
+func (*MsgSetRewardWithdrawAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{17}
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.Merge(m, src)
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRewardWithdrawAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRewardWithdrawAddressResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetRewardWithdrawAddressResponse proto.InternalMessageInfo
+
+// MsgSetFallbackValidator sets the validator petrichor delegations of the delegator are moved to when their validator
+// is tombstoned. An empty validator address removes the fallback and delegations are spread across the active set
+type MsgSetFallbackValidator struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
 }
 
-func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetFallbackValidator) Reset()         { *m = MsgSetFallbackValidator{} }
+func (m *MsgSetFallbackValidator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFallbackValidator) ProtoMessage()    {}
+func (*MsgSetFallbackValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{18}
+}
+func (m *MsgSetFallbackValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFallbackValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFallbackValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetFallbackValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFallbackValidator.Merge(m, src)
 }
-
-func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFallbackValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFallbackValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFallbackValidator.DiscardUnknown(m)
 }
 
-func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgSetFallbackValidator proto.InternalMessageInfo
+
+type MsgSetFallbackValidatorResponse struct {
 }
 
-func (m *MsgRedelegate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetFallbackValidatorResponse) Reset()         { *m = MsgSetFallbackValidatorResponse{} }
+func (m *MsgSetFallbackValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFallbackValidatorResponse) ProtoMessage()    {}
+func (*MsgSetFallbackValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{19}
+}
+func (m *MsgSetFallbackValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFallbackValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFallbackValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetFallbackValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFallbackValidatorResponse.Merge(m, src)
+}
+func (m *MsgSetFallbackValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFallbackValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFallbackValidatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRedelegate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetFallbackValidatorResponse proto.InternalMessageInfo
+
+// MsgClaimAllDelegationRewards claims the rewards of all delegations of the delegator,
+// optionally filtered by validator and denom
+type MsgClaimAllDelegationRewards struct {
+	DelegatorAddress string `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// validator_address restricts the claim to a single validator when set
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// denom restricts the claim to a single petrichor asset when set
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// limit is the maximum number of delegations claimed, defaults to and is capped at the module maximum
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// start_key continues a previous claim from the next_key it returned
+	StartKey []byte `protobuf:"bytes,5,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
 }
 
-func (m *MsgRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgClaimAllDelegationRewards) Reset()         { *m = MsgClaimAllDelegationRewards{} }
+func (m *MsgClaimAllDelegationRewards) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllDelegationRewards) ProtoMessage()    {}
+func (*MsgClaimAllDelegationRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{20}
+}
+func (m *MsgClaimAllDelegationRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllDelegationRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllDelegationRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ValidatorDstAddress) > 0 {
-		i -= len(m.ValidatorDstAddress)
-		copy(dAtA[i:], m.ValidatorDstAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.ValidatorSrcAddress) > 0 {
-		i -= len(m.ValidatorSrcAddress)
-		copy(dAtA[i:], m.ValidatorSrcAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRedelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgClaimAllDelegationRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllDelegationRewards.Merge(m, src)
 }
-
-func (m *MsgRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAllDelegationRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllDelegationRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllDelegationRewards.DiscardUnknown(m)
 }
 
-func (m *MsgRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgClaimAllDelegationRewards proto.InternalMessageInfo
+
+type MsgClaimAllDelegationRewardsResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
+	Claimed uint32                                   `protobuf:"varint,2,opt,name=claimed,proto3" json:"claimed,omitempty"`
+	// next_key is the start_key of the next claim, empty when every delegation was reached
+	NextKey []byte `protobuf:"bytes,3,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
 }
 
-func (m *MsgClaimDelegationRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimAllDelegationRewardsResponse) Reset()         { *m = MsgClaimAllDelegationRewardsResponse{} }
+func (m *MsgClaimAllDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllDelegationRewardsResponse) ProtoMessage()    {}
+func (*MsgClaimAllDelegationRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{21}
+}
+func (m *MsgClaimAllDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllDelegationRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllDelegationRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgClaimDelegationRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAllDelegationRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllDelegationRewardsResponse.Merge(m, src)
+}
+func (m *MsgClaimAllDelegationRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllDelegationRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllDelegationRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimDelegationRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgClaimAllDelegationRewardsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAllDelegationRewardsResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgClaimDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimAllDelegationRewardsResponse) GetClaimed() uint32 {
+	if m != nil {
+		return m.Claimed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgClaimDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAllDelegationRewardsResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
+	}
+	return nil
 }
 
-func (m *MsgClaimDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCancelUndelegation restores part or all of an immature undelegation back into a delegation
+// on the original validator
+type MsgCancelUndelegation struct {
+	DelegatorAddress string                                  `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	ValidatorAddress string                                  `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Amount           github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// completion_time of the undelegation to cancel
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgCancelUndelegation) Reset()         { *m = MsgCancelUndelegation{} }
+func (m *MsgCancelUndelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUndelegation) ProtoMessage()    {}
+func (*MsgCancelUndelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{22}
 }
-func (m *MsgDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelUndelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUndelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUndelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgCancelUndelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUndelegation.Merge(m, src)
+}
+func (m *MsgCancelUndelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUndelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUndelegation.DiscardUnknown(m)
 }
 
-func (m *MsgDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgCancelUndelegation proto.InternalMessageInfo
+
+type MsgCancelUndelegationResponse struct {
 }
 
-func (m *MsgUndelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelUndelegationResponse) Reset()         { *m = MsgCancelUndelegationResponse{} }
+func (m *MsgCancelUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelUndelegationResponse) ProtoMessage()    {}
+func (*MsgCancelUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{23}
+}
+func (m *MsgCancelUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgCancelUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelUndelegationResponse.Merge(m, src)
+}
+func (m *MsgCancelUndelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelUndelegationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUndelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgCancelUndelegationResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the petrichor module params through governance
+type MsgUpdateParams struct {
+	// authority is the address of the governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params to set. The last take rate claim and auto-compound times are kept from the current params
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgRedelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorSrcAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorDstAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{24}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func (m *MsgRedelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MsgClaimDelegationRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{25}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgCreatePetrichor whitelists a new petrichor asset through governance
+type MsgCreatePetrichor struct {
+	// authority is the address of the governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom of the asset. It could either be a native token or an IBC token
+	Denom                string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	RewardWeight         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	TakeRate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate"`
+	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
+	RewardChangeInterval time.Duration                          `protobuf:"bytes,6,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
+	// Optional cap on the total amount of tokens that can be delegated for the asset
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Optional cap on the ratio of the asset that can be delegated to a single validator
+	MaxValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share,omitempty"`
+	// Optional minimum amount of tokens a delegation must hold. Partial undelegations and redelegations cannot leave less
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// How the reward weight is set. In oracle mode it follows the price of the asset and in schedule mode the
+	// reward weight schedule
+	RewardWeightMode RewardWeightMode `protobuf:"varint,10,opt,name=reward_weight_mode,json=rewardWeightMode,proto3,enum=petrichor.petrichor.RewardWeightMode" json:"reward_weight_mode,omitempty"`
+	// Lower bound of the reward weight in oracle and schedule mode
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Upper bound of the reward weight in oracle and schedule mode
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Optional split of the take rate proceeds of the asset. The module wide split is used when empty
+	TakeRateSplits []TakeRateSplit `protobuf:"bytes,13,rep,name=take_rate_splits,json=takeRateSplits,proto3" json:"take_rate_splits"`
+	// Reward weight targets applied in schedule mode, ordered by time
+	RewardWeightSchedule []RewardWeightSchedulePoint `protobuf:"bytes,14,rep,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule"`
+	// How the reward weight moves between the points of the schedule
+	RewardWeightInterpolation RewardWeightInterpolation `protobuf:"varint,15,opt,name=reward_weight_interpolation,json=rewardWeightInterpolation,proto3,enum=petrichor.petrichor.RewardWeightInterpolation" json:"reward_weight_interpolation,omitempty"`
+	// Optional ceiling on the slashed tokens of the asset covered by the insurance fund in each slash. Only
+	// undelegations that are still unbonding are covered, slashed delegations and redelegations are not.
+	// Slashes of the asset are not covered when unset
+	InsuranceCoverageCeiling *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=insurance_coverage_ceiling,json=insuranceCoverageCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"insurance_coverage_ceiling,omitempty"`
+}
+
+func (m *MsgCreatePetrichor) Reset()         { *m = MsgCreatePetrichor{} }
+func (m *MsgCreatePetrichor) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePetrichor) ProtoMessage()    {}
+func (*MsgCreatePetrichor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{26}
+}
+func (m *MsgCreatePetrichor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePetrichor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePetrichor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePetrichor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePetrichor.Merge(m, src)
+}
+func (m *MsgCreatePetrichor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePetrichor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePetrichor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePetrichor proto.InternalMessageInfo
+
+type MsgCreatePetrichorResponse struct {
+}
+
+func (m *MsgCreatePetrichorResponse) Reset()         { *m = MsgCreatePetrichorResponse{} }
+func (m *MsgCreatePetrichorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePetrichorResponse) ProtoMessage()    {}
+func (*MsgCreatePetrichorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{27}
+}
+func (m *MsgCreatePetrichorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePetrichorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePetrichorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreatePetrichorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePetrichorResponse.Merge(m, src)
+}
+func (m *MsgCreatePetrichorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePetrichorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePetrichorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePetrichorResponse proto.InternalMessageInfo
+
+// MsgUpdatePetrichor updates a whitelisted petrichor asset through governance
+type MsgUpdatePetrichor struct {
+	// authority is the address of the governance module account
+	Authority            string                                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom                string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	RewardWeight         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	TakeRate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate"`
+	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
+	RewardChangeInterval time.Duration                          `protobuf:"bytes,6,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
+	// Optional cap on the total amount of tokens that can be delegated for the asset
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Optional cap on the ratio of the asset that can be delegated to a single validator
+	MaxValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share,omitempty"`
+	// Optional minimum amount of tokens a delegation must hold. Partial undelegations and redelegations cannot leave less
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// How the reward weight is set. In oracle mode it follows the price of the asset and in schedule mode the
+	// reward weight schedule
+	RewardWeightMode RewardWeightMode `protobuf:"varint,10,opt,name=reward_weight_mode,json=rewardWeightMode,proto3,enum=petrichor.petrichor.RewardWeightMode" json:"reward_weight_mode,omitempty"`
+	// Lower bound of the reward weight in oracle and schedule mode
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Upper bound of the reward weight in oracle and schedule mode
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Optional split of the take rate proceeds of the asset. The module wide split is used when empty
+	TakeRateSplits []TakeRateSplit `protobuf:"bytes,13,rep,name=take_rate_splits,json=takeRateSplits,proto3" json:"take_rate_splits"`
+	// Reward weight targets applied in schedule mode, ordered by time
+	RewardWeightSchedule []RewardWeightSchedulePoint `protobuf:"bytes,14,rep,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule"`
+	// How the reward weight moves between the points of the schedule
+	RewardWeightInterpolation RewardWeightInterpolation `protobuf:"varint,15,opt,name=reward_weight_interpolation,json=rewardWeightInterpolation,proto3,enum=petrichor.petrichor.RewardWeightInterpolation" json:"reward_weight_interpolation,omitempty"`
+	// Optional ceiling on the slashed tokens of the asset covered by the insurance fund in each slash. Only
+	// undelegations that are still unbonding are covered, slashed delegations and redelegations are not.
+	// Slashes of the asset are not covered when unset
+	InsuranceCoverageCeiling *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=insurance_coverage_ceiling,json=insuranceCoverageCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"insurance_coverage_ceiling,omitempty"`
+}
+
+func (m *MsgUpdatePetrichor) Reset()         { *m = MsgUpdatePetrichor{} }
+func (m *MsgUpdatePetrichor) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePetrichor) ProtoMessage()    {}
+func (*MsgUpdatePetrichor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{28}
+}
+func (m *MsgUpdatePetrichor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePetrichor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePetrichor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePetrichor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePetrichor.Merge(m, src)
+}
+func (m *MsgUpdatePetrichor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePetrichor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePetrichor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePetrichor proto.InternalMessageInfo
+
+type MsgUpdatePetrichorResponse struct {
+}
+
+func (m *MsgUpdatePetrichorResponse) Reset()         { *m = MsgUpdatePetrichorResponse{} }
+func (m *MsgUpdatePetrichorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePetrichorResponse) ProtoMessage()    {}
+func (*MsgUpdatePetrichorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{29}
+}
+func (m *MsgUpdatePetrichorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePetrichorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePetrichorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePetrichorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePetrichorResponse.Merge(m, src)
+}
+func (m *MsgUpdatePetrichorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePetrichorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePetrichorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePetrichorResponse proto.InternalMessageInfo
+
+// MsgDeletePetrichor removes a petrichor asset without delegations through governance
+type MsgDeletePetrichor struct {
+	// authority is the address of the governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgDeletePetrichor) Reset()         { *m = MsgDeletePetrichor{} }
+func (m *MsgDeletePetrichor) String() string { return proto.CompactTextString(m) }
+func (*MsgDeletePetrichor) ProtoMessage()    {}
+func (*MsgDeletePetrichor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{30}
+}
+func (m *MsgDeletePetrichor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeletePetrichor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeletePetrichor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeletePetrichor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeletePetrichor.Merge(m, src)
+}
+func (m *MsgDeletePetrichor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeletePetrichor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeletePetrichor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeletePetrichor proto.InternalMessageInfo
+
+type MsgDeletePetrichorResponse struct {
+}
+
+func (m *MsgDeletePetrichorResponse) Reset()         { *m = MsgDeletePetrichorResponse{} }
+func (m *MsgDeletePetrichorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeletePetrichorResponse) ProtoMessage()    {}
+func (*MsgDeletePetrichorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{31}
+}
+func (m *MsgDeletePetrichorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeletePetrichorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeletePetrichorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeletePetrichorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeletePetrichorResponse.Merge(m, src)
+}
+func (m *MsgDeletePetrichorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeletePetrichorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeletePetrichorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeletePetrichorResponse proto.InternalMessageInfo
+
+// PetrichorAssetConfig holds the governance controlled fields of a petrichor asset
+type PetrichorAssetConfig struct {
+	Denom                string                                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	RewardWeight         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=reward_weight,json=rewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_weight"`
+	TakeRate             github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate"`
+	RewardChangeRate     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=reward_change_rate,json=rewardChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_change_rate"`
+	RewardChangeInterval time.Duration                          `protobuf:"bytes,5,opt,name=reward_change_interval,json=rewardChangeInterval,proto3,stdduration" json:"reward_change_interval"`
+	// Optional cap on the total amount of tokens that can be delegated for the asset
+	MaxTotalTokens *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_total_tokens,json=maxTotalTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_total_tokens,omitempty"`
+	// Optional cap on the ratio of the asset that can be delegated to a single validator
+	MaxValidatorShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_validator_share,json=maxValidatorShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_validator_share,omitempty"`
+	// Optional minimum amount of tokens a delegation must hold. Partial undelegations and redelegations cannot leave less
+	MinDelegationAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=min_delegation_amount,json=minDelegationAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_delegation_amount,omitempty"`
+	// How the reward weight is set. In oracle mode it follows the price of the asset and in schedule mode the
+	// reward weight schedule
+	RewardWeightMode RewardWeightMode `protobuf:"varint,9,opt,name=reward_weight_mode,json=rewardWeightMode,proto3,enum=petrichor.petrichor.RewardWeightMode" json:"reward_weight_mode,omitempty"`
+	// Lower bound of the reward weight in oracle and schedule mode
+	MinRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=min_reward_weight,json=minRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_reward_weight,omitempty"`
+	// Upper bound of the reward weight in oracle and schedule mode
+	MaxRewardWeight *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=max_reward_weight,json=maxRewardWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_reward_weight,omitempty"`
+	// Optional split of the take rate proceeds of the asset. The module wide split is used when empty
+	TakeRateSplits []TakeRateSplit `protobuf:"bytes,12,rep,name=take_rate_splits,json=takeRateSplits,proto3" json:"take_rate_splits"`
+	// Reward weight targets applied in schedule mode, ordered by time
+	RewardWeightSchedule []RewardWeightSchedulePoint `protobuf:"bytes,13,rep,name=reward_weight_schedule,json=rewardWeightSchedule,proto3" json:"reward_weight_schedule"`
+	// How the reward weight moves between the points of the schedule
+	RewardWeightInterpolation RewardWeightInterpolation `protobuf:"varint,14,opt,name=reward_weight_interpolation,json=rewardWeightInterpolation,proto3,enum=petrichor.petrichor.RewardWeightInterpolation" json:"reward_weight_interpolation,omitempty"`
+	// Optional ceiling on the slashed tokens of the asset covered by the insurance fund in each slash. Only
+	// undelegations that are still unbonding are covered, slashed delegations and redelegations are not.
+	// Slashes of the asset are not covered when unset
+	InsuranceCoverageCeiling *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,15,opt,name=insurance_coverage_ceiling,json=insuranceCoverageCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"insurance_coverage_ceiling,omitempty"`
+}
+
+func (m *PetrichorAssetConfig) Reset()         { *m = PetrichorAssetConfig{} }
+func (m *PetrichorAssetConfig) String() string { return proto.CompactTextString(m) }
+func (*PetrichorAssetConfig) ProtoMessage()    {}
+func (*PetrichorAssetConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{32}
+}
+func (m *PetrichorAssetConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PetrichorAssetConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PetrichorAssetConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PetrichorAssetConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PetrichorAssetConfig.Merge(m, src)
+}
+func (m *PetrichorAssetConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PetrichorAssetConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PetrichorAssetConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PetrichorAssetConfig proto.InternalMessageInfo
+
+// MsgBatchUpdatePetrichors creates, updates and deletes several petrichor assets atomically through governance
+type MsgBatchUpdatePetrichors struct {
+	// authority is the address of the governance module account
+	Authority    string                 `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CreateAssets []PetrichorAssetConfig `protobuf:"bytes,2,rep,name=create_assets,json=createAssets,proto3" json:"create_assets"`
+	UpdateAssets []PetrichorAssetConfig `protobuf:"bytes,3,rep,name=update_assets,json=updateAssets,proto3" json:"update_assets"`
+	DeleteDenoms []string               `protobuf:"bytes,4,rep,name=delete_denoms,json=deleteDenoms,proto3" json:"delete_denoms,omitempty"`
+}
+
+func (m *MsgBatchUpdatePetrichors) Reset()         { *m = MsgBatchUpdatePetrichors{} }
+func (m *MsgBatchUpdatePetrichors) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdatePetrichors) ProtoMessage()    {}
+func (*MsgBatchUpdatePetrichors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{33}
+}
+func (m *MsgBatchUpdatePetrichors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchUpdatePetrichors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchUpdatePetrichors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchUpdatePetrichors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdatePetrichors.Merge(m, src)
+}
+func (m *MsgBatchUpdatePetrichors) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchUpdatePetrichors) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdatePetrichors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchUpdatePetrichors proto.InternalMessageInfo
+
+type MsgBatchUpdatePetrichorsResponse struct {
+}
+
+func (m *MsgBatchUpdatePetrichorsResponse) Reset()         { *m = MsgBatchUpdatePetrichorsResponse{} }
+func (m *MsgBatchUpdatePetrichorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchUpdatePetrichorsResponse) ProtoMessage()    {}
+func (*MsgBatchUpdatePetrichorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{34}
+}
+func (m *MsgBatchUpdatePetrichorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchUpdatePetrichorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchUpdatePetrichorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBatchUpdatePetrichorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchUpdatePetrichorsResponse.Merge(m, src)
+}
+func (m *MsgBatchUpdatePetrichorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchUpdatePetrichorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchUpdatePetrichorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchUpdatePetrichorsResponse proto.InternalMessageInfo
+
+// MsgDelistPetrichor winds down a petrichor asset through governance. Delegations are force-undelegated in
+// batches over the following blocks and the asset is removed once no tokens are left
+type MsgDelistPetrichor struct {
+	// authority is the address of the governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgDelistPetrichor) Reset()         { *m = MsgDelistPetrichor{} }
+func (m *MsgDelistPetrichor) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistPetrichor) ProtoMessage()    {}
+func (*MsgDelistPetrichor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{35}
+}
+func (m *MsgDelistPetrichor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistPetrichor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistPetrichor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistPetrichor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistPetrichor.Merge(m, src)
+}
+func (m *MsgDelistPetrichor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistPetrichor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistPetrichor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistPetrichor proto.InternalMessageInfo
+
+type MsgDelistPetrichorResponse struct {
+}
+
+func (m *MsgDelistPetrichorResponse) Reset()         { *m = MsgDelistPetrichorResponse{} }
+func (m *MsgDelistPetrichorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistPetrichorResponse) ProtoMessage()    {}
+func (*MsgDelistPetrichorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{36}
+}
+func (m *MsgDelistPetrichorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistPetrichorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistPetrichorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistPetrichorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistPetrichorResponse.Merge(m, src)
+}
+func (m *MsgDelistPetrichorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistPetrichorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistPetrichorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistPetrichorResponse proto.InternalMessageInfo
+
+// MsgSweepDustDelegations undelegates every delegation of a petrichor asset that holds less than the asset's
+// minimum delegation amount
+type MsgSweepDustDelegations struct {
+	// authority is the address of the governance module account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgSweepDustDelegations) Reset()         { *m = MsgSweepDustDelegations{} }
+func (m *MsgSweepDustDelegations) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepDustDelegations) ProtoMessage()    {}
+func (*MsgSweepDustDelegations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{37}
+}
+func (m *MsgSweepDustDelegations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepDustDelegations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepDustDelegations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepDustDelegations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepDustDelegations.Merge(m, src)
+}
+func (m *MsgSweepDustDelegations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepDustDelegations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepDustDelegations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepDustDelegations proto.InternalMessageInfo
+
+type MsgSweepDustDelegationsResponse struct {
+	// Number of delegations that were undelegated
+	Swept uint64 `protobuf:"varint,1,opt,name=swept,proto3" json:"swept,omitempty"`
+}
+
+func (m *MsgSweepDustDelegationsResponse) Reset()         { *m = MsgSweepDustDelegationsResponse{} }
+func (m *MsgSweepDustDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSweepDustDelegationsResponse) ProtoMessage()    {}
+func (*MsgSweepDustDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{38}
+}
+func (m *MsgSweepDustDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSweepDustDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSweepDustDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSweepDustDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSweepDustDelegationsResponse.Merge(m, src)
+}
+func (m *MsgSweepDustDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSweepDustDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSweepDustDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSweepDustDelegationsResponse proto.InternalMessageInfo
+
+func (m *MsgSweepDustDelegationsResponse) GetSwept() uint64 {
+	if m != nil {
+		return m.Swept
+	}
+	return 0
+}
+
+// MsgSetValidatorDenomFilter sets which petrichor assets a validator accepts delegations in
+type MsgSetValidatorDenomFilter struct {
+	ValidatorAddress string          `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Mode             DenomFilterMode `protobuf:"varint,2,opt,name=mode,proto3,enum=petrichor.petrichor.DenomFilterMode" json:"mode,omitempty"`
+	Denoms           []string        `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+	// What happens to existing delegations in assets that are no longer accepted
+	OptOutPolicy OptOutPolicy `protobuf:"varint,4,opt,name=opt_out_policy,json=optOutPolicy,proto3,enum=petrichor.petrichor.OptOutPolicy" json:"opt_out_policy,omitempty"`
+}
+
+func (m *MsgSetValidatorDenomFilter) Reset()         { *m = MsgSetValidatorDenomFilter{} }
+func (m *MsgSetValidatorDenomFilter) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorDenomFilter) ProtoMessage()    {}
+func (*MsgSetValidatorDenomFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{39}
+}
+func (m *MsgSetValidatorDenomFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorDenomFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorDenomFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorDenomFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorDenomFilter.Merge(m, src)
+}
+func (m *MsgSetValidatorDenomFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorDenomFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorDenomFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorDenomFilter proto.InternalMessageInfo
+
+type MsgSetValidatorDenomFilterResponse struct {
+}
+
+func (m *MsgSetValidatorDenomFilterResponse) Reset()         { *m = MsgSetValidatorDenomFilterResponse{} }
+func (m *MsgSetValidatorDenomFilterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetValidatorDenomFilterResponse) ProtoMessage()    {}
+func (*MsgSetValidatorDenomFilterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{40}
+}
+func (m *MsgSetValidatorDenomFilterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetValidatorDenomFilterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetValidatorDenomFilterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetValidatorDenomFilterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetValidatorDenomFilterResponse.Merge(m, src)
+}
+func (m *MsgSetValidatorDenomFilterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetValidatorDenomFilterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetValidatorDenomFilterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetValidatorDenomFilterResponse proto.InternalMessageInfo
+
+// MsgSetPetrichorCommission sets the commission a validator takes on petrichor rewards. max_rate and
+// max_change_rate are set with the first commission and cannot be changed afterwards
+type MsgSetPetrichorCommission struct {
+	ValidatorAddress string                                  `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	Rate             github_com_cosmos_cosmos_sdk_types.Dec  `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	MaxRate          *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_rate,json=maxRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_rate,omitempty"`
+	MaxChangeRate    *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate,omitempty"`
+}
+
+func (m *MsgSetPetrichorCommission) Reset()         { *m = MsgSetPetrichorCommission{} }
+func (m *MsgSetPetrichorCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPetrichorCommission) ProtoMessage()    {}
+func (*MsgSetPetrichorCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{41}
+}
+func (m *MsgSetPetrichorCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPetrichorCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPetrichorCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPetrichorCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPetrichorCommission.Merge(m, src)
+}
+func (m *MsgSetPetrichorCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPetrichorCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPetrichorCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPetrichorCommission proto.InternalMessageInfo
+
+type MsgSetPetrichorCommissionResponse struct {
+}
+
+func (m *MsgSetPetrichorCommissionResponse) Reset()         { *m = MsgSetPetrichorCommissionResponse{} }
+func (m *MsgSetPetrichorCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPetrichorCommissionResponse) ProtoMessage()    {}
+func (*MsgSetPetrichorCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{42}
+}
+func (m *MsgSetPetrichorCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPetrichorCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPetrichorCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPetrichorCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPetrichorCommissionResponse.Merge(m, src)
+}
+func (m *MsgSetPetrichorCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPetrichorCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPetrichorCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPetrichorCommissionResponse proto.InternalMessageInfo
+
+// MsgWithdrawPetrichorCommission pays the accumulated petrichor commission to the validator operator
+type MsgWithdrawPetrichorCommission struct {
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *MsgWithdrawPetrichorCommission) Reset()         { *m = MsgWithdrawPetrichorCommission{} }
+func (m *MsgWithdrawPetrichorCommission) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPetrichorCommission) ProtoMessage()    {}
+func (*MsgWithdrawPetrichorCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{43}
+}
+func (m *MsgWithdrawPetrichorCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawPetrichorCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawPetrichorCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawPetrichorCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPetrichorCommission.Merge(m, src)
+}
+func (m *MsgWithdrawPetrichorCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawPetrichorCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPetrichorCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawPetrichorCommission proto.InternalMessageInfo
+
+type MsgWithdrawPetrichorCommissionResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawPetrichorCommissionResponse) Reset() {
+	*m = MsgWithdrawPetrichorCommissionResponse{}
+}
+func (m *MsgWithdrawPetrichorCommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPetrichorCommissionResponse) ProtoMessage()    {}
+func (*MsgWithdrawPetrichorCommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83a06f40bda03baf, []int{44}
+}
+func (m *MsgWithdrawPetrichorCommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawPetrichorCommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawPetrichorCommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawPetrichorCommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPetrichorCommissionResponse.Merge(m, src)
+}
+func (m *MsgWithdrawPetrichorCommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawPetrichorCommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPetrichorCommissionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawPetrichorCommissionResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawPetrichorCommissionResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgDelegate)(nil), "petrichor.petrichor.MsgDelegate")
+	proto.RegisterType((*MsgDelegateResponse)(nil), "petrichor.petrichor.MsgDelegateResponse")
+	proto.RegisterType((*MsgUndelegate)(nil), "petrichor.petrichor.MsgUndelegate")
+	proto.RegisterType((*MsgUndelegateResponse)(nil), "petrichor.petrichor.MsgUndelegateResponse")
+	proto.RegisterType((*MsgRedelegate)(nil), "petrichor.petrichor.MsgRedelegate")
+	proto.RegisterType((*MsgRedelegateResponse)(nil), "petrichor.petrichor.MsgRedelegateResponse")
+	proto.RegisterType((*MsgClaimDelegationRewards)(nil), "petrichor.petrichor.MsgClaimDelegationRewards")
+	proto.RegisterType((*MsgClaimDelegationRewardsResponse)(nil), "petrichor.petrichor.MsgClaimDelegationRewardsResponse")
+	proto.RegisterType((*MsgLiquidDelegate)(nil), "petrichor.petrichor.MsgLiquidDelegate")
+	proto.RegisterType((*MsgLiquidDelegateResponse)(nil), "petrichor.petrichor.MsgLiquidDelegateResponse")
+	proto.RegisterType((*MsgRedeemLiquidReceipt)(nil), "petrichor.petrichor.MsgRedeemLiquidReceipt")
+	proto.RegisterType((*MsgRedeemLiquidReceiptResponse)(nil), "petrichor.petrichor.MsgRedeemLiquidReceiptResponse")
+	proto.RegisterType((*MsgClaimLiquidReceiptRewards)(nil), "petrichor.petrichor.MsgClaimLiquidReceiptRewards")
+	proto.RegisterType((*MsgClaimLiquidReceiptRewardsResponse)(nil), "petrichor.petrichor.MsgClaimLiquidReceiptRewardsResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "petrichor.petrichor.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "petrichor.petrichor.MsgSetAutoCompoundResponse")
+	proto.RegisterType((*MsgSetRewardWithdrawAddress)(nil), "petrichor.petrichor.MsgSetRewardWithdrawAddress")
+	proto.RegisterType((*MsgSetRewardWithdrawAddressResponse)(nil), "petrichor.petrichor.MsgSetRewardWithdrawAddressResponse")
+	proto.RegisterType((*MsgSetFallbackValidator)(nil), "petrichor.petrichor.MsgSetFallbackValidator")
+	proto.RegisterType((*MsgSetFallbackValidatorResponse)(nil), "petrichor.petrichor.MsgSetFallbackValidatorResponse")
+	proto.RegisterType((*MsgClaimAllDelegationRewards)(nil), "petrichor.petrichor.MsgClaimAllDelegationRewards")
+	proto.RegisterType((*MsgClaimAllDelegationRewardsResponse)(nil), "petrichor.petrichor.MsgClaimAllDelegationRewardsResponse")
+	proto.RegisterType((*MsgCancelUndelegation)(nil), "petrichor.petrichor.MsgCancelUndelegation")
+	proto.RegisterType((*MsgCancelUndelegationResponse)(nil), "petrichor.petrichor.MsgCancelUndelegationResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "petrichor.petrichor.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "petrichor.petrichor.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreatePetrichor)(nil), "petrichor.petrichor.MsgCreatePetrichor")
+	proto.RegisterType((*MsgCreatePetrichorResponse)(nil), "petrichor.petrichor.MsgCreatePetrichorResponse")
+	proto.RegisterType((*MsgUpdatePetrichor)(nil), "petrichor.petrichor.MsgUpdatePetrichor")
+	proto.RegisterType((*MsgUpdatePetrichorResponse)(nil), "petrichor.petrichor.MsgUpdatePetrichorResponse")
+	proto.RegisterType((*MsgDeletePetrichor)(nil), "petrichor.petrichor.MsgDeletePetrichor")
+	proto.RegisterType((*MsgDeletePetrichorResponse)(nil), "petrichor.petrichor.MsgDeletePetrichorResponse")
+	proto.RegisterType((*PetrichorAssetConfig)(nil), "petrichor.petrichor.PetrichorAssetConfig")
+	proto.RegisterType((*MsgBatchUpdatePetrichors)(nil), "petrichor.petrichor.MsgBatchUpdatePetrichors")
+	proto.RegisterType((*MsgBatchUpdatePetrichorsResponse)(nil), "petrichor.petrichor.MsgBatchUpdatePetrichorsResponse")
+	proto.RegisterType((*MsgDelistPetrichor)(nil), "petrichor.petrichor.MsgDelistPetrichor")
+	proto.RegisterType((*MsgDelistPetrichorResponse)(nil), "petrichor.petrichor.MsgDelistPetrichorResponse")
+	proto.RegisterType((*MsgSweepDustDelegations)(nil), "petrichor.petrichor.MsgSweepDustDelegations")
+	proto.RegisterType((*MsgSweepDustDelegationsResponse)(nil), "petrichor.petrichor.MsgSweepDustDelegationsResponse")
+	proto.RegisterType((*MsgSetValidatorDenomFilter)(nil), "petrichor.petrichor.MsgSetValidatorDenomFilter")
+	proto.RegisterType((*MsgSetValidatorDenomFilterResponse)(nil), "petrichor.petrichor.MsgSetValidatorDenomFilterResponse")
+	proto.RegisterType((*MsgSetPetrichorCommission)(nil), "petrichor.petrichor.MsgSetPetrichorCommission")
+	proto.RegisterType((*MsgSetPetrichorCommissionResponse)(nil), "petrichor.petrichor.MsgSetPetrichorCommissionResponse")
+	proto.RegisterType((*MsgWithdrawPetrichorCommission)(nil), "petrichor.petrichor.MsgWithdrawPetrichorCommission")
+	proto.RegisterType((*MsgWithdrawPetrichorCommissionResponse)(nil), "petrichor.petrichor.MsgWithdrawPetrichorCommissionResponse")
+}
+
+func init() { proto.RegisterFile("petrichor/tx.proto", fileDescriptor_83a06f40bda03baf) }
+
+var fileDescriptor_83a06f40bda03baf = []byte{
+	// 2263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4b, 0x6c, 0x1b, 0xc7,
+	0xf9, 0xd7, 0x52, 0x2f, 0xea, 0x93, 0x48, 0xc9, 0xab, 0x17, 0xb5, 0xca, 0x5f, 0x94, 0x69, 0xc5,
+	0xd6, 0x3f, 0x8d, 0xc9, 0x58, 0x4e, 0x9d, 0x38, 0x2d, 0x50, 0x48, 0x54, 0x53, 0x08, 0x0e, 0x11,
+	0x63, 0x25, 0x3b, 0x68, 0x10, 0x94, 0x58, 0xed, 0x8e, 0x97, 0x5b, 0xed, 0x83, 0xdd, 0x19, 0xea,
+	0x61, 0xa0, 0xe8, 0x13, 0x7d, 0x1c, 0x8a, 0xe6, 0x52, 0xa0, 0x87, 0xa2, 0x4d, 0x0f, 0xbd, 0x14,
+	0x28, 0xd0, 0x43, 0x6e, 0xbd, 0xf5, 0x52, 0xf7, 0x16, 0xe4, 0x54, 0xe4, 0x60, 0x17, 0xf6, 0xa1,
+	0xed, 0xb5, 0x87, 0xa2, 0xc7, 0x62, 0x67, 0x67, 0x87, 0xcb, 0xe5, 0x2e, 0x1f, 0xaa, 0xa8, 0xc4,
+	0xa8, 0x4e, 0xe2, 0xce, 0x7c, 0xdf, 0x6f, 0xbe, 0xd7, 0x7c, 0xdf, 0xbc, 0x04, 0x62, 0x1d, 0x11,
+	0xd7, 0x50, 0x6b, 0x8e, 0x5b, 0x22, 0xc7, 0xc5, 0xba, 0xeb, 0x10, 0x47, 0x9c, 0xe5, 0x6d, 0x45,
+	0xfe, 0x4b, 0x9a, 0xd3, 0x1d, 0xdd, 0xa1, 0xfd, 0x25, 0xef, 0x97, 0x4f, 0x2a, 0x2d, 0xa9, 0x0e,
+	0xb6, 0x1c, 0x5c, 0xf5, 0x3b, 0xfc, 0x8f, 0xa0, 0xab, 0x89, 0x1c, 0xc2, 0xa3, 0x5d, 0x8b, 0x3e,
+	0x61, 0xc9, 0xc2, 0x7a, 0xe9, 0xf0, 0x86, 0xf7, 0x87, 0x75, 0xac, 0xb0, 0x8e, 0x7d, 0x05, 0xa3,
+	0xd2, 0xe1, 0x8d, 0x7d, 0x44, 0x94, 0x1b, 0x25, 0xd5, 0x31, 0x6c, 0xd6, 0x9f, 0xd7, 0x1d, 0x47,
+	0x37, 0x51, 0x89, 0x7e, 0xed, 0x37, 0x1e, 0x94, 0x88, 0x61, 0x21, 0x4c, 0x14, 0xab, 0x1e, 0x00,
+	0x44, 0x09, 0xb4, 0x86, 0xab, 0x10, 0xc3, 0x09, 0x00, 0x16, 0x42, 0x42, 0x29, 0xae, 0x62, 0x05,
+	0xc2, 0x2e, 0x37, 0xdb, 0x35, 0x64, 0x22, 0x9d, 0xf2, 0xb0, 0xce, 0xc2, 0xaf, 0x52, 0x30, 0x59,
+	0xc1, 0xfa, 0xb6, 0xdf, 0x81, 0xc4, 0x2f, 0xc3, 0x25, 0x46, 0xe4, 0xb8, 0x55, 0x45, 0xd3, 0x5c,
+	0x84, 0x71, 0x4e, 0x58, 0x15, 0xd6, 0x27, 0xb6, 0x72, 0x1f, 0x7f, 0x78, 0x7d, 0x8e, 0x99, 0x61,
+	0xd3, 0xef, 0xd9, 0x25, 0xae, 0x61, 0xeb, 0xf2, 0x0c, 0x67, 0x61, 0xed, 0x1e, 0xcc, 0xa1, 0x62,
+	0x1a, 0x5a, 0x0b, 0x4c, 0xaa, 0x1b, 0x0c, 0x67, 0x09, 0x60, 0xf6, 0x61, 0x4c, 0xb1, 0x9c, 0x86,
+	0x4d, 0x72, 0xc3, 0xab, 0xc2, 0xfa, 0xe4, 0xc6, 0x52, 0x91, 0x31, 0x7a, 0x46, 0x2c, 0x32, 0x23,
+	0x16, 0xcb, 0x8e, 0x61, 0x6f, 0x95, 0x1e, 0x3d, 0xce, 0x0f, 0x7d, 0xf2, 0x38, 0x7f, 0x4d, 0x37,
+	0x48, 0xad, 0xb1, 0x5f, 0x54, 0x1d, 0x8b, 0xf9, 0x8c, 0xfd, 0xb9, 0x8e, 0xb5, 0x83, 0x12, 0x39,
+	0xa9, 0x23, 0x4c, 0x19, 0x64, 0x86, 0xfc, 0xc6, 0xca, 0x8f, 0x3e, 0xc8, 0x0f, 0xfd, 0xfd, 0x83,
+	0xfc, 0xd0, 0x77, 0xff, 0xf6, 0xfb, 0x97, 0xda, 0x95, 0x2f, 0xcc, 0xc3, 0x6c, 0xc8, 0x40, 0x32,
+	0xc2, 0x75, 0xc7, 0xc6, 0xa8, 0xf0, 0xeb, 0x14, 0x64, 0x2a, 0x58, 0xbf, 0x67, 0x6b, 0x17, 0xa6,
+	0x4b, 0x32, 0xdd, 0x22, 0xcc, 0xb7, 0x98, 0x88, 0x1b, 0xef, 0x5f, 0xbe, 0xf1, 0x64, 0x74, 0xd6,
+	0xc6, 0x7b, 0x0b, 0xe6, 0x9b, 0xc6, 0xc3, 0xae, 0xda, 0xb3, 0x01, 0x67, 0x39, 0xdb, 0xae, 0xab,
+	0xc6, 0xa2, 0x69, 0x98, 0x70, 0xb4, 0xe1, 0x9e, 0xd1, 0xb6, 0x31, 0x69, 0xf7, 0xc8, 0xc8, 0xa7,
+	0xec, 0x11, 0x19, 0xb5, 0x79, 0xe4, 0x89, 0x00, 0x4b, 0x15, 0xac, 0x97, 0x4d, 0xc5, 0xb0, 0xb6,
+	0x79, 0x96, 0x90, 0xd1, 0x91, 0xe2, 0x6a, 0xf8, 0x33, 0x16, 0xda, 0x73, 0x30, 0xaa, 0x21, 0xdb,
+	0xb1, 0x7c, 0x37, 0xc8, 0xfe, 0x47, 0x57, 0xd5, 0xaf, 0xc0, 0xe5, 0x44, 0x05, 0xb9, 0x19, 0x7e,
+	0x93, 0x82, 0x4b, 0x15, 0xac, 0xbf, 0x65, 0x7c, 0xa3, 0x61, 0x68, 0x17, 0x49, 0x31, 0xd1, 0x98,
+	0xdf, 0xf1, 0xc3, 0xa5, 0xd5, 0x4e, 0x81, 0x15, 0x45, 0x0d, 0xc6, 0x5d, 0xa4, 0x22, 0xa3, 0x4e,
+	0x72, 0xc2, 0x99, 0x8b, 0x18, 0x40, 0x17, 0x3e, 0x11, 0x60, 0x81, 0x05, 0x33, 0xb2, 0x7c, 0x49,
+	0x64, 0xbf, 0x4b, 0xfc, 0x12, 0x64, 0x6b, 0x8e, 0xa9, 0xa1, 0xde, 0xbd, 0x95, 0xf1, 0xe9, 0xdb,
+	0x6d, 0x9c, 0x1a, 0x98, 0x8d, 0x97, 0xc3, 0x36, 0x8e, 0xc8, 0x5b, 0x58, 0x85, 0x95, 0x78, 0xdd,
+	0x9a, 0x05, 0x48, 0x80, 0x17, 0x82, 0x80, 0x8e, 0x50, 0xf8, 0x93, 0xf6, 0xbf, 0x36, 0xc2, 0x15,
+	0xc8, 0x30, 0x5b, 0x57, 0xfd, 0xf9, 0x46, 0x63, 0x55, 0x9e, 0x62, 0x8d, 0xdb, 0x74, 0xda, 0x75,
+	0xd4, 0xe2, 0x2a, 0xac, 0x75, 0x12, 0x91, 0xeb, 0xf2, 0x4f, 0x01, 0xc4, 0x0a, 0xd6, 0x77, 0x11,
+	0xd9, 0x6c, 0x10, 0xa7, 0xec, 0x58, 0x75, 0xa7, 0x61, 0x6b, 0xcf, 0x43, 0xda, 0x11, 0x73, 0x30,
+	0x8e, 0x6c, 0x65, 0xdf, 0x44, 0x1a, 0x4d, 0xeb, 0x69, 0x39, 0xf8, 0xec, 0x3a, 0x87, 0x5e, 0x00,
+	0xa9, 0x5d, 0x67, 0x6e, 0x92, 0x3f, 0x0b, 0xb0, 0xec, 0x77, 0xfb, 0xc6, 0x7a, 0xc7, 0x20, 0x35,
+	0xcd, 0x55, 0x8e, 0x42, 0x4a, 0x9d, 0x85, 0x6d, 0xca, 0x30, 0x73, 0xc4, 0x90, 0x7b, 0x36, 0xcd,
+	0xf4, 0x51, 0xab, 0x2c, 0x5d, 0x35, 0x7d, 0x11, 0xae, 0x74, 0x50, 0x85, 0xab, 0xfc, 0x27, 0x01,
+	0x16, 0x7d, 0xba, 0x37, 0x15, 0xd3, 0xdc, 0x57, 0xd4, 0x83, 0xfb, 0x81, 0x0f, 0x3e, 0x5b, 0xa1,
+	0xd0, 0x55, 0xe1, 0xcb, 0x90, 0x4f, 0x50, 0x84, 0x2b, 0xfb, 0xb3, 0x54, 0x73, 0xfa, 0x6e, 0x9a,
+	0xe6, 0x73, 0x59, 0x73, 0xbd, 0x56, 0xd3, 0xb0, 0x0c, 0x7f, 0x45, 0x93, 0x91, 0xfd, 0x0f, 0x71,
+	0x19, 0x26, 0x30, 0x51, 0x5c, 0x52, 0x3d, 0x40, 0x27, 0xb9, 0xd1, 0x55, 0x61, 0x7d, 0x4a, 0x4e,
+	0xd3, 0x86, 0x3b, 0xe8, 0xa4, 0xab, 0xe9, 0xfe, 0x28, 0xc0, 0x5a, 0x27, 0xbb, 0xf0, 0x22, 0x83,
+	0xbc, 0x22, 0x43, 0x9b, 0x72, 0xc2, 0xea, 0x70, 0xe7, 0x1c, 0xfd, 0x8a, 0x97, 0xa3, 0x7f, 0xfb,
+	0x24, 0xbf, 0xde, 0x63, 0x8e, 0xc6, 0x72, 0x80, 0xed, 0xcd, 0x6f, 0xd5, 0x93, 0x05, 0x69, 0xd4,
+	0x6a, 0x19, 0x39, 0xf8, 0x14, 0x97, 0x20, 0x6d, 0xa3, 0x63, 0x5f, 0xcb, 0x61, 0xaa, 0xe5, 0xb8,
+	0xf7, 0x7d, 0x07, 0x9d, 0x14, 0xfe, 0x9d, 0xa2, 0xeb, 0xac, 0xb2, 0x62, 0xab, 0xc8, 0xe4, 0xeb,
+	0x5f, 0xc3, 0xb1, 0xff, 0xf7, 0x96, 0x12, 0x62, 0x05, 0xa6, 0x55, 0xc7, 0xaa, 0x9b, 0xc8, 0xd3,
+	0xbf, 0xea, 0x6d, 0x6a, 0xd9, 0xfa, 0x57, 0x2a, 0xfa, 0x1b, 0xda, 0x62, 0xb0, 0xa1, 0x2d, 0xee,
+	0x05, 0x3b, 0xde, 0xad, 0xb4, 0x37, 0xda, 0xfb, 0x4f, 0xf2, 0x82, 0x9c, 0x6d, 0x32, 0x7b, 0xdd,
+	0x5d, 0xe3, 0x27, 0x0f, 0xff, 0x17, 0x6b, 0x79, 0x3e, 0xf1, 0x7e, 0x21, 0xc0, 0xb4, 0xb7, 0x2b,
+	0xa9, 0x6b, 0x0a, 0x41, 0x77, 0xe9, 0x46, 0x59, 0xbc, 0x05, 0x13, 0x4a, 0x83, 0xd4, 0x1c, 0xd7,
+	0x20, 0x27, 0x5d, 0xbd, 0xd1, 0x24, 0x15, 0x6f, 0xc3, 0x98, 0xbf, 0xd5, 0x66, 0xcb, 0x84, 0xe5,
+	0x62, 0xcc, 0xf1, 0x42, 0xd1, 0x1f, 0x64, 0x6b, 0xc4, 0xd3, 0x49, 0x66, 0x0c, 0x6f, 0x2c, 0x84,
+	0xf5, 0x68, 0x42, 0x16, 0x96, 0x60, 0x31, 0x22, 0x1d, 0x97, 0xfc, 0xd1, 0x24, 0xad, 0x92, 0x65,
+	0x17, 0x79, 0x7d, 0x01, 0xfc, 0xa9, 0x85, 0xe7, 0x53, 0x3a, 0x15, 0x9e, 0xd2, 0xbb, 0x90, 0xf1,
+	0x43, 0xbf, 0x7a, 0x84, 0x0c, 0xbd, 0x46, 0xd8, 0x5e, 0xa7, 0xc8, 0xdc, 0x7f, 0xb5, 0x07, 0xf7,
+	0x6f, 0x23, 0x55, 0x9e, 0xf2, 0x41, 0xde, 0xa1, 0x18, 0xe2, 0x1d, 0x98, 0x20, 0xca, 0x01, 0xaa,
+	0xba, 0x0a, 0xf1, 0xbd, 0xdf, 0x3f, 0x60, 0xda, 0x03, 0x90, 0xbd, 0xd5, 0xf8, 0x7b, 0x20, 0x32,
+	0x09, 0xd5, 0x9a, 0x62, 0xeb, 0x0c, 0x75, 0xf4, 0x54, 0xa8, 0x33, 0x3e, 0x52, 0x99, 0x02, 0x51,
+	0xf4, 0xaf, 0xc2, 0x42, 0x2b, 0xba, 0x61, 0x13, 0xe4, 0x1e, 0x2a, 0x66, 0x6e, 0x8c, 0x4d, 0x91,
+	0x68, 0xd4, 0x6e, 0xb3, 0x63, 0x18, 0x3f, 0x68, 0x7f, 0xee, 0x05, 0xed, 0x5c, 0x18, 0x76, 0x87,
+	0x01, 0x88, 0x0f, 0x60, 0xc6, 0x52, 0x8e, 0xab, 0xc4, 0x21, 0x8a, 0x59, 0x25, 0xce, 0x01, 0xb2,
+	0x71, 0x6e, 0x9c, 0x8a, 0xfd, 0xc5, 0x47, 0x8f, 0xf3, 0x42, 0x8f, 0x62, 0xef, 0xd8, 0xe4, 0xe3,
+	0x0f, 0xaf, 0x03, 0xf3, 0xee, 0x8e, 0x4d, 0xe4, 0xac, 0xa5, 0x1c, 0xef, 0x79, 0xa0, 0x7b, 0x14,
+	0x53, 0xfc, 0x1a, 0xcc, 0x7a, 0xe3, 0x84, 0x36, 0xc2, 0x35, 0xc5, 0x45, 0xb9, 0x34, 0xb7, 0x90,
+	0xd0, 0x87, 0x85, 0x2e, 0x59, 0xca, 0x31, 0xaf, 0x5e, 0xbb, 0x1e, 0x90, 0x58, 0x87, 0x79, 0xcb,
+	0xb0, 0xab, 0xcd, 0xb9, 0x55, 0x65, 0x49, 0x64, 0xe2, 0x0c, 0x94, 0x99, 0xb5, 0x0c, 0xbb, 0x99,
+	0xf4, 0x37, 0xfd, 0x1c, 0xb2, 0x0b, 0x62, 0x4b, 0x50, 0x56, 0x2d, 0x47, 0x43, 0x39, 0x58, 0x15,
+	0xd6, 0xb3, 0x1b, 0x2f, 0xc6, 0xce, 0x39, 0x39, 0x14, 0x7e, 0x15, 0x47, 0x43, 0x81, 0xa7, 0x9b,
+	0x2d, 0xe2, 0xbb, 0x70, 0xc9, 0x53, 0xa3, 0x35, 0xda, 0x27, 0x4f, 0x65, 0xa4, 0x69, 0xcb, 0xb0,
+	0xc3, 0x23, 0x52, 0x6c, 0xe5, 0x38, 0x82, 0x3d, 0x75, 0x4a, 0x6c, 0xe5, 0xb8, 0x05, 0x5b, 0x86,
+	0x19, 0x3e, 0x99, 0xaa, 0xb8, 0x6e, 0x1a, 0x04, 0xe7, 0x32, 0xb4, 0x02, 0x16, 0x62, 0x4d, 0xb1,
+	0xc7, 0x26, 0xce, 0xae, 0x47, 0xca, 0xb2, 0x50, 0x96, 0x84, 0x1b, 0xb1, 0xf8, 0x75, 0x58, 0x68,
+	0x91, 0xb5, 0x8a, 0xd5, 0x1a, 0xd2, 0x1a, 0x26, 0xca, 0x65, 0x29, 0x72, 0xb1, 0xab, 0x91, 0x77,
+	0x19, 0xc3, 0x5d, 0xc7, 0xb0, 0x83, 0x51, 0xe6, 0xdc, 0x18, 0x02, 0xd1, 0x86, 0xe5, 0xd6, 0xb1,
+	0xe8, 0x0c, 0xab, 0x3b, 0x26, 0xf5, 0x78, 0x6e, 0x9a, 0x7a, 0xb5, 0xfb, 0x80, 0x3b, 0x61, 0x2e,
+	0x79, 0xc9, 0x4d, 0xea, 0x12, 0x1f, 0x82, 0x64, 0xd8, 0xb8, 0xe1, 0x7a, 0x25, 0xa1, 0xaa, 0x3a,
+	0x87, 0xc8, 0x55, 0x74, 0x54, 0x55, 0x91, 0x61, 0x1a, 0xb6, 0x9e, 0x9b, 0x39, 0x83, 0x98, 0xcd,
+	0x71, 0xfc, 0x32, 0x83, 0x2f, 0xfb, 0xe8, 0x89, 0x59, 0xde, 0x5f, 0xfb, 0x47, 0x32, 0x79, 0x34,
+	0xd1, 0xb3, 0x22, 0x70, 0x91, 0xe8, 0x2f, 0x12, 0xfd, 0x45, 0xa2, 0xbf, 0x48, 0xf4, 0x17, 0x89,
+	0xfe, 0xb9, 0x4d, 0xf4, 0x91, 0x4c, 0xce, 0x13, 0xfd, 0x43, 0x9a, 0xe7, 0xbd, 0x59, 0x30, 0xb0,
+	0x3c, 0xdf, 0x45, 0xb2, 0xc8, 0xd8, 0x5c, 0xb2, 0xef, 0x4f, 0xc2, 0x1c, 0x6f, 0xdd, 0xc4, 0x18,
+	0x91, 0xb2, 0x63, 0x3f, 0x30, 0xf4, 0xe6, 0x20, 0x42, 0xc7, 0x62, 0x92, 0x3a, 0xeb, 0x62, 0x32,
+	0x3c, 0x90, 0x62, 0x32, 0x32, 0xf0, 0x62, 0x32, 0x3a, 0x88, 0x62, 0x32, 0x76, 0x7e, 0xc5, 0x64,
+	0x7c, 0xe0, 0xc5, 0x24, 0x7d, 0xbe, 0xc5, 0x64, 0x62, 0x00, 0xc5, 0x04, 0x06, 0x58, 0x4c, 0x26,
+	0x07, 0x57, 0x4c, 0xa6, 0x06, 0x56, 0x4c, 0x32, 0xe7, 0x5d, 0x4c, 0xb2, 0xe7, 0x5b, 0x4c, 0xa6,
+	0x07, 0x5a, 0x4c, 0xd2, 0x41, 0xca, 0x2e, 0xfc, 0x21, 0x05, 0xb9, 0x0a, 0xd6, 0xb7, 0x14, 0xa2,
+	0xd6, 0x22, 0x45, 0xe4, 0xf4, 0xa7, 0x56, 0x7b, 0x90, 0x51, 0xe9, 0xce, 0xa3, 0xaa, 0x60, 0x8c,
+	0x88, 0x77, 0x78, 0xe5, 0x79, 0xeb, 0xff, 0xe3, 0x0f, 0xaf, 0x62, 0x8a, 0x00, 0x73, 0xd4, 0x94,
+	0x8f, 0x42, 0x3b, 0xb0, 0x87, 0xda, 0xa8, 0x6b, 0x21, 0xd4, 0xe1, 0x53, 0xa2, 0xfa, 0x28, 0x0c,
+	0xf5, 0x0a, 0x64, 0x34, 0x5a, 0xa2, 0xfc, 0x2b, 0x28, 0x9c, 0x1b, 0x59, 0x1d, 0xf6, 0xee, 0xa0,
+	0xfc, 0x46, 0x7a, 0x05, 0x95, 0x7c, 0x96, 0x56, 0x80, 0xd5, 0x24, 0xe3, 0xb5, 0x97, 0x60, 0x03,
+	0x93, 0x4f, 0xad, 0x04, 0x87, 0xc7, 0xe6, 0x92, 0x7d, 0xcb, 0xbf, 0x0d, 0x39, 0x42, 0xa8, 0xbe,
+	0xdd, 0xc0, 0xa4, 0x99, 0xde, 0xf0, 0x39, 0x89, 0xf7, 0x1a, 0xe4, 0x13, 0x04, 0xe0, 0x87, 0xf0,
+	0x73, 0x30, 0x8a, 0x8f, 0x10, 0xbb, 0xe7, 0x1d, 0x91, 0xfd, 0x8f, 0xc2, 0x2f, 0x53, 0xc1, 0xd5,
+	0x16, 0xaf, 0x01, 0xd4, 0x53, 0x6f, 0x1a, 0x26, 0x41, 0x6e, 0xfc, 0xe1, 0xb5, 0xd0, 0xf7, 0xe1,
+	0xf5, 0xeb, 0x30, 0x42, 0x13, 0x7a, 0x8a, 0x4e, 0xfd, 0xb5, 0xd8, 0x38, 0x0b, 0x0d, 0x4b, 0xf3,
+	0x39, 0xe5, 0x10, 0x17, 0x60, 0x8c, 0x45, 0xd3, 0x30, 0x8d, 0x26, 0xf6, 0x25, 0x7e, 0x05, 0xb2,
+	0x4e, 0x9d, 0x54, 0x9d, 0x06, 0xa9, 0xd6, 0x1d, 0xd3, 0x50, 0x4f, 0xe8, 0xfa, 0x20, 0xbb, 0x71,
+	0x39, 0x16, 0xfb, 0xed, 0x3a, 0x79, 0xbb, 0x41, 0xee, 0x52, 0x42, 0x79, 0xca, 0x09, 0x7d, 0x45,
+	0x0e, 0xa9, 0xdb, 0x94, 0x2d, 0xac, 0x41, 0x21, 0xd9, 0x3e, 0x3c, 0x00, 0xfe, 0x91, 0xa2, 0x97,
+	0xec, 0xbb, 0xa8, 0x19, 0x1c, 0x65, 0xc7, 0xb2, 0x0c, 0x8c, 0xd9, 0x4d, 0xc2, 0x59, 0x58, 0x71,
+	0x0b, 0x46, 0xe8, 0x4a, 0xe8, 0x74, 0x0b, 0x36, 0xca, 0x2b, 0xee, 0x40, 0x9a, 0xd6, 0xad, 0xd6,
+	0x75, 0x5a, 0x3f, 0xe5, 0x6a, 0xdc, 0x2b, 0x57, 0x1e, 0xd4, 0x7d, 0xf0, 0x2a, 0x57, 0xc2, 0x1a,
+	0xad, 0x1f, 0xc4, 0x8c, 0xa5, 0x1c, 0x37, 0x17, 0x68, 0x5d, 0x3d, 0xe2, 0xbf, 0x0e, 0x89, 0x37,
+	0x35, 0x77, 0xc8, 0x0f, 0x05, 0x7a, 0x2b, 0x1f, 0xdc, 0x5f, 0x0e, 0xce, 0x2b, 0x5d, 0xc5, 0xfd,
+	0x89, 0x00, 0x57, 0x3b, 0x4b, 0xc2, 0xa7, 0xa8, 0xca, 0xef, 0x78, 0x06, 0x70, 0x4d, 0xc6, 0xa0,
+	0x37, 0x7e, 0x37, 0x07, 0xc3, 0x15, 0xac, 0x8b, 0xf7, 0x21, 0xcd, 0x5f, 0xcd, 0xac, 0xc6, 0xce,
+	0x9a, 0xd0, 0x5b, 0x3a, 0x69, 0xbd, 0x1b, 0x05, 0x57, 0xe2, 0x3d, 0x80, 0xd0, 0x63, 0xb1, 0x42,
+	0x12, 0x5f, 0x93, 0x46, 0x7a, 0xa9, 0x3b, 0x4d, 0x18, 0xfd, 0x9e, 0xdd, 0x1d, 0xfd, 0x9e, 0xdd,
+	0x1d, 0xbd, 0xfd, 0xb1, 0x9b, 0xf8, 0x6d, 0x01, 0x16, 0x12, 0xde, 0x55, 0x15, 0x93, 0x60, 0xe2,
+	0xe9, 0xa5, 0x5b, 0xfd, 0xd1, 0x73, 0x11, 0x6a, 0x90, 0x8d, 0x3c, 0x69, 0xba, 0x9a, 0x84, 0xd4,
+	0x4a, 0x27, 0x15, 0x7b, 0xa3, 0xe3, 0x23, 0x1d, 0xc1, 0x6c, 0xdc, 0x83, 0x9c, 0xcf, 0x75, 0xf2,
+	0x46, 0x84, 0x58, 0xba, 0xd9, 0x07, 0x31, 0x1f, 0xf8, 0xc7, 0x02, 0x2c, 0x25, 0xbf, 0x85, 0xb9,
+	0xd1, 0xd1, 0x70, 0x71, 0x2c, 0xd2, 0xed, 0xbe, 0x59, 0xb8, 0x2c, 0x07, 0x30, 0x1d, 0x7d, 0xca,
+	0x72, 0x2d, 0x09, 0x2d, 0x42, 0x28, 0x95, 0x7a, 0x24, 0xe4, 0x83, 0xfd, 0x40, 0x80, 0x5c, 0xe2,
+	0x2b, 0x91, 0x57, 0x3a, 0xa0, 0xc5, 0x72, 0x48, 0xaf, 0xf7, 0xcb, 0xd1, 0xee, 0x81, 0xd8, 0xe7,
+	0x0c, 0x9d, 0x3d, 0x10, 0xc7, 0x22, 0xdd, 0xee, 0x9b, 0x85, 0xcb, 0x42, 0x40, 0x8c, 0xb9, 0x7c,
+	0x4f, 0x9c, 0xb5, 0xed, 0xb4, 0xd2, 0x46, 0xef, 0xb4, 0x7c, 0xd4, 0x7d, 0x98, 0x6a, 0xb9, 0x56,
+	0x5e, 0x4b, 0xcc, 0x12, 0x21, 0x2a, 0xe9, 0xe5, 0x5e, 0xa8, 0xc2, 0xb1, 0x15, 0xbd, 0x00, 0x4e,
+	0x8c, 0xad, 0x08, 0xa1, 0x54, 0xea, 0x91, 0x30, 0x3c, 0x58, 0xf4, 0x12, 0xe2, 0x5a, 0x17, 0x69,
+	0xbb, 0x0f, 0x96, 0x70, 0x18, 0xe6, 0x0d, 0x16, 0x3d, 0x09, 0xbb, 0xd6, 0xa9, 0x40, 0xf4, 0x34,
+	0x58, 0xc2, 0xf9, 0x96, 0xf8, 0x4d, 0x98, 0x8f, 0xdf, 0x54, 0x5d, 0x4f, 0x42, 0x8a, 0x25, 0x97,
+	0x3e, 0xdf, 0x17, 0x79, 0x44, 0xd7, 0x96, 0x2d, 0x47, 0x27, 0x5d, 0xc3, 0x84, 0x52, 0xa9, 0x47,
+	0x42, 0x3e, 0xd8, 0x43, 0x98, 0x8b, 0xdd, 0x45, 0x24, 0x06, 0x5e, 0x1c, 0xb5, 0xf4, 0x6a, 0x3f,
+	0xd4, 0x7c, 0xec, 0xef, 0x09, 0xb0, 0x98, 0xb4, 0x0f, 0xe8, 0x94, 0xea, 0xe2, 0x18, 0xa4, 0xd7,
+	0xfa, 0x64, 0x68, 0x29, 0xc1, 0x09, 0xcb, 0xe8, 0x62, 0x07, 0xcc, 0x18, 0x7a, 0xe9, 0x56, 0x7f,
+	0xf4, 0x5c, 0x84, 0x9f, 0x0a, 0xb0, 0xdc, 0x69, 0xe1, 0x98, 0x58, 0xf4, 0x3a, 0x30, 0x49, 0x5f,
+	0x38, 0x05, 0x53, 0x4b, 0x58, 0xc4, 0x3d, 0xb5, 0x7b, 0xb9, 0x83, 0x86, 0x6d, 0xd4, 0xd2, 0xab,
+	0xfd, 0x50, 0x07, 0x63, 0x6f, 0x55, 0x1e, 0x3d, 0x5d, 0x11, 0x3e, 0x7a, 0xba, 0x22, 0xfc, 0xf5,
+	0xe9, 0x8a, 0xf0, 0xfe, 0xb3, 0x95, 0xa1, 0x8f, 0x9e, 0xad, 0x0c, 0xfd, 0xe5, 0xd9, 0xca, 0xd0,
+	0xbb, 0x37, 0x43, 0x6b, 0x4f, 0x8a, 0x67, 0x23, 0x72, 0xe4, 0xb8, 0x07, 0xcd, 0x7f, 0xb4, 0x29,
+	0x1d, 0x87, 0x7e, 0xd3, 0xc5, 0xe8, 0xfe, 0x18, 0x3d, 0x56, 0xbd, 0xf9, 0x9f, 0x01, 0x00, 0x3e,
+	0xfc, 0x18, 0x51, 0xf2, 0x33, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error)
+	Redelegate(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*MsgRedelegateResponse, error)
+	Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error)
+	ClaimDelegationRewards(ctx context.Context, in *MsgClaimDelegationRewards, opts ...grpc.CallOption) (*MsgClaimDelegationRewardsResponse, error)
+	LiquidDelegate(ctx context.Context, in *MsgLiquidDelegate, opts ...grpc.CallOption) (*MsgLiquidDelegateResponse, error)
+	RedeemLiquidReceipt(ctx context.Context, in *MsgRedeemLiquidReceipt, opts ...grpc.CallOption) (*MsgRedeemLiquidReceiptResponse, error)
+	ClaimLiquidReceiptRewards(ctx context.Context, in *MsgClaimLiquidReceiptRewards, opts ...grpc.CallOption) (*MsgClaimLiquidReceiptRewardsResponse, error)
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
+	SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error)
+	ClaimAllDelegationRewards(ctx context.Context, in *MsgClaimAllDelegationRewards, opts ...grpc.CallOption) (*MsgClaimAllDelegationRewardsResponse, error)
+	CancelUndelegation(ctx context.Context, in *MsgCancelUndelegation, opts ...grpc.CallOption) (*MsgCancelUndelegationResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	CreatePetrichor(ctx context.Context, in *MsgCreatePetrichor, opts ...grpc.CallOption) (*MsgCreatePetrichorResponse, error)
+	UpdatePetrichor(ctx context.Context, in *MsgUpdatePetrichor, opts ...grpc.CallOption) (*MsgUpdatePetrichorResponse, error)
+	DeletePetrichor(ctx context.Context, in *MsgDeletePetrichor, opts ...grpc.CallOption) (*MsgDeletePetrichorResponse, error)
+	BatchUpdatePetrichors(ctx context.Context, in *MsgBatchUpdatePetrichors, opts ...grpc.CallOption) (*MsgBatchUpdatePetrichorsResponse, error)
+	DelistPetrichor(ctx context.Context, in *MsgDelistPetrichor, opts ...grpc.CallOption) (*MsgDelistPetrichorResponse, error)
+	SweepDustDelegations(ctx context.Context, in *MsgSweepDustDelegations, opts ...grpc.CallOption) (*MsgSweepDustDelegationsResponse, error)
+	SetValidatorDenomFilter(ctx context.Context, in *MsgSetValidatorDenomFilter, opts ...grpc.CallOption) (*MsgSetValidatorDenomFilterResponse, error)
+	SetPetrichorCommission(ctx context.Context, in *MsgSetPetrichorCommission, opts ...grpc.CallOption) (*MsgSetPetrichorCommissionResponse, error)
+	WithdrawPetrichorCommission(ctx context.Context, in *MsgWithdrawPetrichorCommission, opts ...grpc.CallOption) (*MsgWithdrawPetrichorCommissionResponse, error)
+	SetFallbackValidator(ctx context.Context, in *MsgSetFallbackValidator, opts ...grpc.CallOption) (*MsgSetFallbackValidatorResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Delegate(ctx context.Context, in *MsgDelegate, opts ...grpc.CallOption) (*MsgDelegateResponse, error) {
+	out := new(MsgDelegateResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/Delegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Redelegate(ctx context.Context, in *MsgRedelegate, opts ...grpc.CallOption) (*MsgRedelegateResponse, error) {
+	out := new(MsgRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/Redelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Undelegate(ctx context.Context, in *MsgUndelegate, opts ...grpc.CallOption) (*MsgUndelegateResponse, error) {
+	out := new(MsgUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/Undelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimDelegationRewards(ctx context.Context, in *MsgClaimDelegationRewards, opts ...grpc.CallOption) (*MsgClaimDelegationRewardsResponse, error) {
+	out := new(MsgClaimDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/ClaimDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LiquidDelegate(ctx context.Context, in *MsgLiquidDelegate, opts ...grpc.CallOption) (*MsgLiquidDelegateResponse, error) {
+	out := new(MsgLiquidDelegateResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/LiquidDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemLiquidReceipt(ctx context.Context, in *MsgRedeemLiquidReceipt, opts ...grpc.CallOption) (*MsgRedeemLiquidReceiptResponse, error) {
+	out := new(MsgRedeemLiquidReceiptResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/RedeemLiquidReceipt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimLiquidReceiptRewards(ctx context.Context, in *MsgClaimLiquidReceiptRewards, opts ...grpc.CallOption) (*MsgClaimLiquidReceiptRewardsResponse, error) {
+	out := new(MsgClaimLiquidReceiptRewardsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/ClaimLiquidReceiptRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRewardWithdrawAddress(ctx context.Context, in *MsgSetRewardWithdrawAddress, opts ...grpc.CallOption) (*MsgSetRewardWithdrawAddressResponse, error) {
+	out := new(MsgSetRewardWithdrawAddressResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/SetRewardWithdrawAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAllDelegationRewards(ctx context.Context, in *MsgClaimAllDelegationRewards, opts ...grpc.CallOption) (*MsgClaimAllDelegationRewardsResponse, error) {
+	out := new(MsgClaimAllDelegationRewardsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/ClaimAllDelegationRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelUndelegation(ctx context.Context, in *MsgCancelUndelegation, opts ...grpc.CallOption) (*MsgCancelUndelegationResponse, error) {
+	out := new(MsgCancelUndelegationResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/CancelUndelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePetrichor(ctx context.Context, in *MsgCreatePetrichor, opts ...grpc.CallOption) (*MsgCreatePetrichorResponse, error) {
+	out := new(MsgCreatePetrichorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/CreatePetrichor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePetrichor(ctx context.Context, in *MsgUpdatePetrichor, opts ...grpc.CallOption) (*MsgUpdatePetrichorResponse, error) {
+	out := new(MsgUpdatePetrichorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/UpdatePetrichor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeletePetrichor(ctx context.Context, in *MsgDeletePetrichor, opts ...grpc.CallOption) (*MsgDeletePetrichorResponse, error) {
+	out := new(MsgDeletePetrichorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/DeletePetrichor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchUpdatePetrichors(ctx context.Context, in *MsgBatchUpdatePetrichors, opts ...grpc.CallOption) (*MsgBatchUpdatePetrichorsResponse, error) {
+	out := new(MsgBatchUpdatePetrichorsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/BatchUpdatePetrichors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelistPetrichor(ctx context.Context, in *MsgDelistPetrichor, opts ...grpc.CallOption) (*MsgDelistPetrichorResponse, error) {
+	out := new(MsgDelistPetrichorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/DelistPetrichor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SweepDustDelegations(ctx context.Context, in *MsgSweepDustDelegations, opts ...grpc.CallOption) (*MsgSweepDustDelegationsResponse, error) {
+	out := new(MsgSweepDustDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/SweepDustDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetValidatorDenomFilter(ctx context.Context, in *MsgSetValidatorDenomFilter, opts ...grpc.CallOption) (*MsgSetValidatorDenomFilterResponse, error) {
+	out := new(MsgSetValidatorDenomFilterResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/SetValidatorDenomFilter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPetrichorCommission(ctx context.Context, in *MsgSetPetrichorCommission, opts ...grpc.CallOption) (*MsgSetPetrichorCommissionResponse, error) {
+	out := new(MsgSetPetrichorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/SetPetrichorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawPetrichorCommission(ctx context.Context, in *MsgWithdrawPetrichorCommission, opts ...grpc.CallOption) (*MsgWithdrawPetrichorCommissionResponse, error) {
+	out := new(MsgWithdrawPetrichorCommissionResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/WithdrawPetrichorCommission", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFallbackValidator(ctx context.Context, in *MsgSetFallbackValidator, opts ...grpc.CallOption) (*MsgSetFallbackValidatorResponse, error) {
+	out := new(MsgSetFallbackValidatorResponse)
+	err := c.cc.Invoke(ctx, "/petrichor.petrichor.Msg/SetFallbackValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Delegate(context.Context, *MsgDelegate) (*MsgDelegateResponse, error)
+	Redelegate(context.Context, *MsgRedelegate) (*MsgRedelegateResponse, error)
+	Undelegate(context.Context, *MsgUndelegate) (*MsgUndelegateResponse, error)
+	ClaimDelegationRewards(context.Context, *MsgClaimDelegationRewards) (*MsgClaimDelegationRewardsResponse, error)
+	LiquidDelegate(context.Context, *MsgLiquidDelegate) (*MsgLiquidDelegateResponse, error)
+	RedeemLiquidReceipt(context.Context, *MsgRedeemLiquidReceipt) (*MsgRedeemLiquidReceiptResponse, error)
+	ClaimLiquidReceiptRewards(context.Context, *MsgClaimLiquidReceiptRewards) (*MsgClaimLiquidReceiptRewardsResponse, error)
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
+	SetRewardWithdrawAddress(context.Context, *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error)
+	ClaimAllDelegationRewards(context.Context, *MsgClaimAllDelegationRewards) (*MsgClaimAllDelegationRewardsResponse, error)
+	CancelUndelegation(context.Context, *MsgCancelUndelegation) (*MsgCancelUndelegationResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CreatePetrichor(context.Context, *MsgCreatePetrichor) (*MsgCreatePetrichorResponse, error)
+	UpdatePetrichor(context.Context, *MsgUpdatePetrichor) (*MsgUpdatePetrichorResponse, error)
+	DeletePetrichor(context.Context, *MsgDeletePetrichor) (*MsgDeletePetrichorResponse, error)
+	BatchUpdatePetrichors(context.Context, *MsgBatchUpdatePetrichors) (*MsgBatchUpdatePetrichorsResponse, error)
+	DelistPetrichor(context.Context, *MsgDelistPetrichor) (*MsgDelistPetrichorResponse, error)
+	SweepDustDelegations(context.Context, *MsgSweepDustDelegations) (*MsgSweepDustDelegationsResponse, error)
+	SetValidatorDenomFilter(context.Context, *MsgSetValidatorDenomFilter) (*MsgSetValidatorDenomFilterResponse, error)
+	SetPetrichorCommission(context.Context, *MsgSetPetrichorCommission) (*MsgSetPetrichorCommissionResponse, error)
+	WithdrawPetrichorCommission(context.Context, *MsgWithdrawPetrichorCommission) (*MsgWithdrawPetrichorCommissionResponse, error)
+	SetFallbackValidator(context.Context, *MsgSetFallbackValidator) (*MsgSetFallbackValidatorResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Delegate(ctx context.Context, req *MsgDelegate) (*MsgDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delegate not implemented")
+}
+func (*UnimplementedMsgServer) Redelegate(ctx context.Context, req *MsgRedelegate) (*MsgRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redelegate not implemented")
+}
+func (*UnimplementedMsgServer) Undelegate(ctx context.Context, req *MsgUndelegate) (*MsgUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Undelegate not implemented")
+}
+func (*UnimplementedMsgServer) ClaimDelegationRewards(ctx context.Context, req *MsgClaimDelegationRewards) (*MsgClaimDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimDelegationRewards not implemented")
+}
+func (*UnimplementedMsgServer) LiquidDelegate(ctx context.Context, req *MsgLiquidDelegate) (*MsgLiquidDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidDelegate not implemented")
+}
+func (*UnimplementedMsgServer) RedeemLiquidReceipt(ctx context.Context, req *MsgRedeemLiquidReceipt) (*MsgRedeemLiquidReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemLiquidReceipt not implemented")
+}
+func (*UnimplementedMsgServer) ClaimLiquidReceiptRewards(ctx context.Context, req *MsgClaimLiquidReceiptRewards) (*MsgClaimLiquidReceiptRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimLiquidReceiptRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
+func (*UnimplementedMsgServer) SetRewardWithdrawAddress(ctx context.Context, req *MsgSetRewardWithdrawAddress) (*MsgSetRewardWithdrawAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRewardWithdrawAddress not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAllDelegationRewards(ctx context.Context, req *MsgClaimAllDelegationRewards) (*MsgClaimAllDelegationRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllDelegationRewards not implemented")
+}
+func (*UnimplementedMsgServer) CancelUndelegation(ctx context.Context, req *MsgCancelUndelegation) (*MsgCancelUndelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUndelegation not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreatePetrichor(ctx context.Context, req *MsgCreatePetrichor) (*MsgCreatePetrichorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePetrichor not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePetrichor(ctx context.Context, req *MsgUpdatePetrichor) (*MsgUpdatePetrichorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePetrichor not implemented")
+}
+func (*UnimplementedMsgServer) DeletePetrichor(ctx context.Context, req *MsgDeletePetrichor) (*MsgDeletePetrichorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePetrichor not implemented")
+}
+func (*UnimplementedMsgServer) BatchUpdatePetrichors(ctx context.Context, req *MsgBatchUpdatePetrichors) (*MsgBatchUpdatePetrichorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdatePetrichors not implemented")
+}
+func (*UnimplementedMsgServer) DelistPetrichor(ctx context.Context, req *MsgDelistPetrichor) (*MsgDelistPetrichorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistPetrichor not implemented")
+}
+func (*UnimplementedMsgServer) SweepDustDelegations(ctx context.Context, req *MsgSweepDustDelegations) (*MsgSweepDustDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SweepDustDelegations not implemented")
+}
+func (*UnimplementedMsgServer) SetValidatorDenomFilter(ctx context.Context, req *MsgSetValidatorDenomFilter) (*MsgSetValidatorDenomFilterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetValidatorDenomFilter not implemented")
+}
+func (*UnimplementedMsgServer) SetPetrichorCommission(ctx context.Context, req *MsgSetPetrichorCommission) (*MsgSetPetrichorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPetrichorCommission not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawPetrichorCommission(ctx context.Context, req *MsgWithdrawPetrichorCommission) (*MsgWithdrawPetrichorCommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPetrichorCommission not implemented")
+}
+func (*UnimplementedMsgServer) SetFallbackValidator(ctx context.Context, req *MsgSetFallbackValidator) (*MsgSetFallbackValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFallbackValidator not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Delegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Delegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/Delegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delegate(ctx, req.(*MsgDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Redelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Redelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/Redelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Redelegate(ctx, req.(*MsgRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Undelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Undelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/Undelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Undelegate(ctx, req.(*MsgUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimDelegationRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/ClaimDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimDelegationRewards(ctx, req.(*MsgClaimDelegationRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LiquidDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLiquidDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LiquidDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/LiquidDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LiquidDelegate(ctx, req.(*MsgLiquidDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemLiquidReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemLiquidReceipt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemLiquidReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/RedeemLiquidReceipt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemLiquidReceipt(ctx, req.(*MsgRedeemLiquidReceipt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimLiquidReceiptRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimLiquidReceiptRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimLiquidReceiptRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/ClaimLiquidReceiptRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimLiquidReceiptRewards(ctx, req.(*MsgClaimLiquidReceiptRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRewardWithdrawAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRewardWithdrawAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/SetRewardWithdrawAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRewardWithdrawAddress(ctx, req.(*MsgSetRewardWithdrawAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAllDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllDelegationRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllDelegationRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/ClaimAllDelegationRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllDelegationRewards(ctx, req.(*MsgClaimAllDelegationRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelUndelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelUndelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelUndelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/CancelUndelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelUndelegation(ctx, req.(*MsgCancelUndelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePetrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePetrichor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePetrichor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/CreatePetrichor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePetrichor(ctx, req.(*MsgCreatePetrichor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePetrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePetrichor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePetrichor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/UpdatePetrichor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePetrichor(ctx, req.(*MsgUpdatePetrichor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeletePetrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeletePetrichor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeletePetrichor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/DeletePetrichor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeletePetrichor(ctx, req.(*MsgDeletePetrichor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchUpdatePetrichors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchUpdatePetrichors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchUpdatePetrichors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/BatchUpdatePetrichors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchUpdatePetrichors(ctx, req.(*MsgBatchUpdatePetrichors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistPetrichor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistPetrichor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistPetrichor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/DelistPetrichor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistPetrichor(ctx, req.(*MsgDelistPetrichor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SweepDustDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSweepDustDelegations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SweepDustDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/SweepDustDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SweepDustDelegations(ctx, req.(*MsgSweepDustDelegations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetValidatorDenomFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetValidatorDenomFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetValidatorDenomFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/SetValidatorDenomFilter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetValidatorDenomFilter(ctx, req.(*MsgSetValidatorDenomFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPetrichorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPetrichorCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPetrichorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/SetPetrichorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPetrichorCommission(ctx, req.(*MsgSetPetrichorCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawPetrichorCommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPetrichorCommission)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawPetrichorCommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/WithdrawPetrichorCommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawPetrichorCommission(ctx, req.(*MsgWithdrawPetrichorCommission))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFallbackValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFallbackValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFallbackValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/petrichor.petrichor.Msg/SetFallbackValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFallbackValidator(ctx, req.(*MsgSetFallbackValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "petrichor.petrichor.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Delegate",
+			Handler:    _Msg_Delegate_Handler,
+		},
+		{
+			MethodName: "Redelegate",
+			Handler:    _Msg_Redelegate_Handler,
+		},
+		{
+			MethodName: "Undelegate",
+			Handler:    _Msg_Undelegate_Handler,
+		},
+		{
+			MethodName: "ClaimDelegationRewards",
+			Handler:    _Msg_ClaimDelegationRewards_Handler,
+		},
+		{
+			MethodName: "LiquidDelegate",
+			Handler:    _Msg_LiquidDelegate_Handler,
+		},
+		{
+			MethodName: "RedeemLiquidReceipt",
+			Handler:    _Msg_RedeemLiquidReceipt_Handler,
+		},
+		{
+			MethodName: "ClaimLiquidReceiptRewards",
+			Handler:    _Msg_ClaimLiquidReceiptRewards_Handler,
+		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
+		{
+			MethodName: "SetRewardWithdrawAddress",
+			Handler:    _Msg_SetRewardWithdrawAddress_Handler,
+		},
+		{
+			MethodName: "ClaimAllDelegationRewards",
+			Handler:    _Msg_ClaimAllDelegationRewards_Handler,
+		},
+		{
+			MethodName: "CancelUndelegation",
+			Handler:    _Msg_CancelUndelegation_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreatePetrichor",
+			Handler:    _Msg_CreatePetrichor_Handler,
+		},
+		{
+			MethodName: "UpdatePetrichor",
+			Handler:    _Msg_UpdatePetrichor_Handler,
+		},
+		{
+			MethodName: "DeletePetrichor",
+			Handler:    _Msg_DeletePetrichor_Handler,
+		},
+		{
+			MethodName: "BatchUpdatePetrichors",
+			Handler:    _Msg_BatchUpdatePetrichors_Handler,
+		},
+		{
+			MethodName: "DelistPetrichor",
+			Handler:    _Msg_DelistPetrichor_Handler,
+		},
+		{
+			MethodName: "SweepDustDelegations",
+			Handler:    _Msg_SweepDustDelegations_Handler,
+		},
+		{
+			MethodName: "SetValidatorDenomFilter",
+			Handler:    _Msg_SetValidatorDenomFilter_Handler,
+		},
+		{
+			MethodName: "SetPetrichorCommission",
+			Handler:    _Msg_SetPetrichorCommission_Handler,
+		},
+		{
+			MethodName: "WithdrawPetrichorCommission",
+			Handler:    _Msg_WithdrawPetrichorCommission_Handler,
+		},
+		{
+			MethodName: "SetFallbackValidator",
+			Handler:    _Msg_SetFallbackValidator_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "petrichor/tx.proto",
+}
+
+func (m *MsgDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDelegationRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDelegationRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDelegationRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLiquidDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLiquidDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLiquidDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Receipt.Size()
+		i -= size
+		if _, err := m.Receipt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemLiquidReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemLiquidReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemLiquidReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.HolderAddress) > 0 {
+		i -= len(m.HolderAddress)
+		copy(dAtA[i:], m.HolderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HolderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemLiquidReceiptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemLiquidReceiptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemLiquidReceiptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimLiquidReceiptRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimLiquidReceiptRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLiquidReceiptRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReceiptDenom) > 0 {
+		i -= len(m.ReceiptDenom)
+		copy(dAtA[i:], m.ReceiptDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReceiptDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HolderAddress) > 0 {
+		i -= len(m.HolderAddress)
+		copy(dAtA[i:], m.HolderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HolderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimLiquidReceiptRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimLiquidReceiptRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimLiquidReceiptRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawAddress) > 0 {
+		i -= len(m.WithdrawAddress)
+		copy(dAtA[i:], m.WithdrawAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.WithdrawAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFallbackValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFallbackValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFallbackValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFallbackValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFallbackValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFallbackValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllDelegationRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllDelegationRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllDelegationRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Claimed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Claimed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUndelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUndelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePetrichor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePetrichor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePetrichor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InsuranceCoverageCeiling != nil {
+		{
+			size := m.InsuranceCoverageCeiling.Size()
+			i -= size
+			if _, err := m.InsuranceCoverageCeiling.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.RewardWeightInterpolation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardWeightInterpolation))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for iNdEx := len(m.RewardWeightSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeightSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for iNdEx := len(m.TakeRateSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RewardWeightMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardWeightMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxValidatorShare != nil {
+		{
+			size := m.MaxValidatorShare.Size()
+			i -= size
+			if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RewardChangeRate.Size()
+		i -= size
+		if _, err := m.RewardChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TakeRate.Size()
+		i -= size
+		if _, err := m.TakeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePetrichorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePetrichorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePetrichorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePetrichor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePetrichor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePetrichor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InsuranceCoverageCeiling != nil {
+		{
+			size := m.InsuranceCoverageCeiling.Size()
+			i -= size
+			if _, err := m.InsuranceCoverageCeiling.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.RewardWeightInterpolation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardWeightInterpolation))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for iNdEx := len(m.RewardWeightSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeightSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for iNdEx := len(m.TakeRateSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.RewardWeightMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardWeightMode))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxValidatorShare != nil {
+		{
+			size := m.MaxValidatorShare.Size()
+			i -= size
+			if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	n11, err11 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RewardChangeRate.Size()
+		i -= size
+		if _, err := m.RewardChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TakeRate.Size()
+		i -= size
+		if _, err := m.TakeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePetrichorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePetrichorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePetrichorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeletePetrichor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeletePetrichor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePetrichor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeletePetrichorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeletePetrichorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeletePetrichorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PetrichorAssetConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PetrichorAssetConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PetrichorAssetConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InsuranceCoverageCeiling != nil {
+		{
+			size := m.InsuranceCoverageCeiling.Size()
+			i -= size
+			if _, err := m.InsuranceCoverageCeiling.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.RewardWeightInterpolation != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardWeightInterpolation))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for iNdEx := len(m.RewardWeightSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardWeightSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for iNdEx := len(m.TakeRateSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TakeRateSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.MaxRewardWeight != nil {
+		{
+			size := m.MaxRewardWeight.Size()
+			i -= size
+			if _, err := m.MaxRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MinRewardWeight != nil {
+		{
+			size := m.MinRewardWeight.Size()
+			i -= size
+			if _, err := m.MinRewardWeight.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RewardWeightMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RewardWeightMode))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MinDelegationAmount != nil {
+		{
+			size := m.MinDelegationAmount.Size()
+			i -= size
+			if _, err := m.MinDelegationAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxValidatorShare != nil {
+		{
+			size := m.MaxValidatorShare.Size()
+			i -= size
+			if _, err := m.MaxValidatorShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxTotalTokens != nil {
+		{
+			size := m.MaxTotalTokens.Size()
+			i -= size
+			if _, err := m.MaxTotalTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardChangeInterval, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTx(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RewardChangeRate.Size()
+		i -= size
+		if _, err := m.RewardChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TakeRate.Size()
+		i -= size
+		if _, err := m.TakeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchUpdatePetrichors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchUpdatePetrichors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchUpdatePetrichors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeleteDenoms) > 0 {
+		for iNdEx := len(m.DeleteDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeleteDenoms[iNdEx])
+			copy(dAtA[i:], m.DeleteDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.DeleteDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UpdateAssets) > 0 {
+		for iNdEx := len(m.UpdateAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UpdateAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CreateAssets) > 0 {
+		for iNdEx := len(m.CreateAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreateAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchUpdatePetrichorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchUpdatePetrichorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchUpdatePetrichorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistPetrichor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistPetrichor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistPetrichor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistPetrichorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistPetrichorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistPetrichorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepDustDelegations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepDustDelegations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepDustDelegations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSweepDustDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSweepDustDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSweepDustDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Swept != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Swept))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorDenomFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorDenomFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorDenomFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptOutPolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OptOutPolicy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Mode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetValidatorDenomFilterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetValidatorDenomFilterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetValidatorDenomFilterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPetrichorCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPetrichorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPetrichorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxChangeRate != nil {
+		{
+			size := m.MaxChangeRate.Size()
+			i -= size
+			if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxRate != nil {
+		{
+			size := m.MaxRate.Size()
+			i -= size
+			if _, err := m.MaxRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPetrichorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPetrichorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPetrichorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPetrichorCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPetrichorCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPetrichorCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPetrichorCommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPetrichorCommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPetrichorCommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimDelegationRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLiquidDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgLiquidDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Receipt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemLiquidReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HolderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRedeemLiquidReceiptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimLiquidReceiptRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HolderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReceiptDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimLiquidReceiptRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.WithdrawAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRewardWithdrawAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFallbackValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetFallbackValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAllDelegationRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimAllDelegationRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Claimed != 0 {
+		n += 1 + sovTx(uint64(m.Claimed))
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelUndelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePetrichor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RewardChangeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxValidatorShare != nil {
+		l = m.MaxValidatorShare.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardWeightMode != 0 {
+		n += 1 + sovTx(uint64(m.RewardWeightMode))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for _, e := range m.TakeRateSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for _, e := range m.RewardWeightSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RewardWeightInterpolation != 0 {
+		n += 1 + sovTx(uint64(m.RewardWeightInterpolation))
+	}
+	if m.InsuranceCoverageCeiling != nil {
+		l = m.InsuranceCoverageCeiling.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreatePetrichorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePetrichor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RewardChangeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxValidatorShare != nil {
+		l = m.MaxValidatorShare.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardWeightMode != 0 {
+		n += 1 + sovTx(uint64(m.RewardWeightMode))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for _, e := range m.TakeRateSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for _, e := range m.RewardWeightSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RewardWeightInterpolation != 0 {
+		n += 1 + sovTx(uint64(m.RewardWeightInterpolation))
+	}
+	if m.InsuranceCoverageCeiling != nil {
+		l = m.InsuranceCoverageCeiling.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePetrichorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeletePetrichor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeletePetrichorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PetrichorAssetConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RewardChangeRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardChangeInterval)
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxTotalTokens != nil {
+		l = m.MaxTotalTokens.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxValidatorShare != nil {
+		l = m.MaxValidatorShare.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinDelegationAmount != nil {
+		l = m.MinDelegationAmount.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RewardWeightMode != 0 {
+		n += 1 + sovTx(uint64(m.RewardWeightMode))
+	}
+	if m.MinRewardWeight != nil {
+		l = m.MinRewardWeight.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxRewardWeight != nil {
+		l = m.MaxRewardWeight.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TakeRateSplits) > 0 {
+		for _, e := range m.TakeRateSplits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RewardWeightSchedule) > 0 {
+		for _, e := range m.RewardWeightSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.RewardWeightInterpolation != 0 {
+		n += 1 + sovTx(uint64(m.RewardWeightInterpolation))
+	}
+	if m.InsuranceCoverageCeiling != nil {
+		l = m.InsuranceCoverageCeiling.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchUpdatePetrichors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CreateAssets) > 0 {
+		for _, e := range m.CreateAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.UpdateAssets) > 0 {
+		for _, e := range m.UpdateAssets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DeleteDenoms) > 0 {
+		for _, s := range m.DeleteDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchUpdatePetrichorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelistPetrichor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelistPetrichorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSweepDustDelegations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSweepDustDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Swept != 0 {
+		n += 1 + sovTx(uint64(m.Swept))
+	}
+	return n
+}
+
+func (m *MsgSetValidatorDenomFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovTx(uint64(m.Mode))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.OptOutPolicy != 0 {
+		n += 1 + sovTx(uint64(m.OptOutPolicy))
+	}
+	return n
+}
+
+func (m *MsgSetValidatorDenomFilterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPetrichorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxRate != nil {
+		l = m.MaxRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxChangeRate != nil {
+		l = m.MaxChangeRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetPetrichorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawPetrichorCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawPetrichorCommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDelegationRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDelegationRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDelegationRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLiquidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLiquidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLiquidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemLiquidReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemLiquidReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemLiquidReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemLiquidReceiptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemLiquidReceiptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemLiquidReceiptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimLiquidReceiptRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLiquidReceiptRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLiquidReceiptRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HolderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HolderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimLiquidReceiptRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimLiquidReceiptRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimLiquidReceiptRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardWithdrawAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRewardWithdrawAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRewardWithdrawAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFallbackValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFallbackValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFallbackValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFallbackValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFallbackValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFallbackValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllDelegationRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllDelegationRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllDelegationRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllDelegationRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			m.Claimed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Claimed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUndelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUndelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUndelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePetrichor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePetrichor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePetrichor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxValidatorShare = &v
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightMode", wireType)
+			}
+			m.RewardWeightMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightMode |= RewardWeightMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateSplits = append(m.TakeRateSplits, TakeRateSplit{})
+			if err := m.TakeRateSplits[len(m.TakeRateSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeightSchedule = append(m.RewardWeightSchedule, RewardWeightSchedulePoint{})
+			if err := m.RewardWeightSchedule[len(m.RewardWeightSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightInterpolation", wireType)
+			}
+			m.RewardWeightInterpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightInterpolation |= RewardWeightInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceCoverageCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.InsuranceCoverageCeiling = &v
+			if err := m.InsuranceCoverageCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePetrichorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePetrichorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePetrichorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePetrichor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePetrichor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePetrichor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxValidatorShare = &v
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightMode", wireType)
+			}
+			m.RewardWeightMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightMode |= RewardWeightMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateSplits = append(m.TakeRateSplits, TakeRateSplit{})
+			if err := m.TakeRateSplits[len(m.TakeRateSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeightSchedule = append(m.RewardWeightSchedule, RewardWeightSchedulePoint{})
+			if err := m.RewardWeightSchedule[len(m.RewardWeightSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightInterpolation", wireType)
+			}
+			m.RewardWeightInterpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightInterpolation |= RewardWeightInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceCoverageCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.InsuranceCoverageCeiling = &v
+			if err := m.InsuranceCoverageCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePetrichorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePetrichorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePetrichorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeletePetrichor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeletePetrichor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeletePetrichor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeletePetrichorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeletePetrichorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeletePetrichorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PetrichorAssetConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PetrichorAssetConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PetrichorAssetConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardChangeInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardChangeInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxTotalTokens = &v
+			if err := m.MaxTotalTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxValidatorShare = &v
+			if err := m.MaxValidatorShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelegationAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinDelegationAmount = &v
+			if err := m.MinDelegationAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightMode", wireType)
+			}
+			m.RewardWeightMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightMode |= RewardWeightMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MinRewardWeight = &v
+			if err := m.MinRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRewardWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRewardWeight = &v
+			if err := m.MaxRewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRateSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TakeRateSplits = append(m.TakeRateSplits, TakeRateSplit{})
+			if err := m.TakeRateSplits[len(m.TakeRateSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardWeightSchedule = append(m.RewardWeightSchedule, RewardWeightSchedulePoint{})
+			if err := m.RewardWeightSchedule[len(m.RewardWeightSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeightInterpolation", wireType)
+			}
+			m.RewardWeightInterpolation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RewardWeightInterpolation |= RewardWeightInterpolation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceCoverageCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.InsuranceCoverageCeiling = &v
+			if err := m.InsuranceCoverageCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchUpdatePetrichors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchUpdatePetrichors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchUpdatePetrichors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreateAssets = append(m.CreateAssets, PetrichorAssetConfig{})
+			if err := m.CreateAssets[len(m.CreateAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateAssets = append(m.UpdateAssets, PetrichorAssetConfig{})
+			if err := m.UpdateAssets[len(m.UpdateAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeleteDenoms = append(m.DeleteDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgBatchUpdatePetrichorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchUpdatePetrichorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchUpdatePetrichorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelistPetrichor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelistPetrichor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelistPetrichor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelistPetrichorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelistPetrichorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelistPetrichorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgClaimDelegationRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepDustDelegations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1015,15 +10799,15 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepDustDelegations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepDustDelegations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1051,11 +10835,11 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1083,40 +10867,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1139,7 +10890,7 @@ func (m *MsgDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSweepDustDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1162,12 +10913,31 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSweepDustDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSweepDustDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swept", wireType)
+			}
+			m.Swept = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Swept |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1189,7 +10959,7 @@ func (m *MsgDelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorDenomFilter) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1212,15 +10982,15 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorDenomFilter: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorDenomFilter: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1248,11 +11018,30 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= DenomFilterMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1280,13 +11069,13 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptOutPolicy", wireType)
 			}
-			var msglen int
+			m.OptOutPolicy = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1296,25 +11085,11 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OptOutPolicy |= OptOutPolicy(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1336,7 +11111,7 @@ func (m *MsgUndelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetValidatorDenomFilterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1359,10 +11134,10 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetValidatorDenomFilterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetValidatorDenomFilterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1386,7 +11161,7 @@ func (m *MsgUndelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPetrichorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1409,15 +11184,15 @@ func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPetrichorCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPetrichorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1445,11 +11220,11 @@ func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1477,11 +11252,13 @@ func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1509,13 +11286,17 @@ func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRate = &v
+			if err := m.MaxRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1525,22 +11306,25 @@ func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxChangeRate = &v
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1565,7 +11349,7 @@ func (m *MsgRedelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPetrichorCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1588,10 +11372,10 @@ func (m *MsgRedelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPetrichorCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPetrichorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1615,7 +11399,7 @@ func (m *MsgRedelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimDelegationRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawPetrichorCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1638,45 +11422,13 @@ func (m *MsgClaimDelegationRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimDelegationRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawPetrichorCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimDelegationRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawPetrichorCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
@@ -1708,38 +11460,6 @@ func (m *MsgClaimDelegationRewards) Unmarshal(dAtA []byte) error {
 			}
 			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1761,7 +11481,7 @@ func (m *MsgClaimDelegationRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawPetrichorCommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1784,12 +11504,46 @@ func (m *MsgClaimDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawPetrichorCommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawPetrichorCommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -18,6 +20,68 @@ func NewPetrichorValidatorInfo() PetrichorValidatorInfo {
 	}
 }
 
+// MaxOptOutUndelegationsPerBlock limits how many delegations are undelegated each block from validators that opted
+// out of an asset so that a large opt out is spread over several blocks
+const MaxOptOutUndelegationsPerBlock = 50
+
+// MaxAutoRedelegationsPerBlock limits how many delegations are moved away from tombstoned validators each block
+const MaxAutoRedelegationsPerBlock = 50
+
+// ValidateDenomFilter checks that the denoms are set, unique and only used with an allowlist or a denylist
+func ValidateDenomFilter(mode DenomFilterMode, denoms []string, policy OptOutPolicy) error {
+	if _, ok := DenomFilterMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("unknown denom filter mode %d", mode)
+	}
+	if _, ok := OptOutPolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("unknown opt out policy %d", policy)
+	}
+	if mode == DenomFilterModeNone && len(denoms) > 0 {
+		return fmt.Errorf("denoms cannot be set without an allowlist or a denylist")
+	}
+	seen := map[string]bool{}
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("denom %s is used more than once", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
+// AcceptsDenom returns true if the validator accepts delegations in the petrichor asset
+func (v PetrichorValidatorInfo) AcceptsDenom(denom string) bool {
+	switch v.DenomFilterMode {
+	case DenomFilterModeAllowlist:
+		return v.isFiltered(denom)
+	case DenomFilterModeDenylist:
+		return !v.isFiltered(denom)
+	default:
+		return true
+	}
+}
+
+func (v PetrichorValidatorInfo) isFiltered(denom string) bool {
+	for _, d := range v.FilteredDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// SplitCommission separates the petrichor commission of the validator from rewards. The commission is truncated so
+// that rounding favours delegators
+func (v PetrichorValidatorInfo) SplitCommission(coins sdk.Coins) (commission sdk.Coins, rewards sdk.Coins) {
+	if v.PetrichorCommission == nil || !v.PetrichorCommission.Rate.IsPositive() {
+		return sdk.NewCoins(), coins
+	}
+	commission, _ = sdk.NewDecCoinsFromCoins(coins...).MulDecTruncate(v.PetrichorCommission.Rate).TruncateDecimal()
+	return commission, coins.Sub(commission...)
+}
+
 func (v *PetrichorValidator) AddShares(delegationShares sdk.DecCoins, validatorShares sdk.DecCoins) {
 	v.TotalDelegatorShares = sdk.DecCoins(v.TotalDelegatorShares).Add(delegationShares...)
 	v.ValidatorShares = sdk.DecCoins(v.ValidatorShares).Add(validatorShares...)